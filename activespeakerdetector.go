@@ -0,0 +1,171 @@
+package mediaserver
+
+import (
+	"sync"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+type activeTrackListener interface {
+	native.ActiveTrackListener
+	deleteActiveTrackListener()
+}
+
+type goActiveTrackListener struct {
+	native.ActiveTrackListener
+}
+
+func (a *goActiveTrackListener) deleteActiveTrackListener() {
+	native.DeleteDirectorActiveTrackListener(a.ActiveTrackListener)
+}
+
+type overwrittenActiveTrackListener struct {
+	p        native.ActiveTrackListener
+	detector *ActiveSpeakerDetector
+}
+
+func (p *overwrittenActiveTrackListener) OnActiveTrackchanged(id uint) {
+	p.detector.onActiveTrackChanged(id)
+}
+
+// ActiveSpeakerDetectorListener called with the new active speaker audio track
+type ActiveSpeakerDetectorListener func(track *IncomingStreamTrack)
+
+// ActiveSpeakerDetector detect the active speaker among several audio tracks using the rtp audio level extension
+type ActiveSpeakerDetector struct {
+	detector native.ActiveSpeakerDetectorFacade
+	listener activeTrackListener
+	tracks   map[uint]*IncomingStreamTrack
+	maxID    uint
+	onChange []ActiveSpeakerDetectorListener
+	sync.Mutex
+}
+
+// NewActiveSpeakerDetector create a new active speaker detector
+func NewActiveSpeakerDetector() *ActiveSpeakerDetector {
+
+	detector := &ActiveSpeakerDetector{}
+	detector.tracks = make(map[uint]*IncomingStreamTrack)
+
+	listener := &overwrittenActiveTrackListener{detector: detector}
+	p := native.NewDirectorActiveTrackListener(listener)
+	listener.p = p
+
+	detector.listener = &goActiveTrackListener{ActiveTrackListener: p}
+	detector.detector = native.NewActiveSpeakerDetectorFacade(detector.listener)
+
+	return detector
+}
+
+// SetMinChangePeriod minimum period in ms between active speaker changes
+func (a *ActiveSpeakerDetector) SetMinChangePeriod(minChangePeriod uint) {
+	a.detector.SetMinChangePeriod(minChangePeriod)
+}
+
+// SetMaxAccumulatedScore maximum score accumulated by a speaker
+func (a *ActiveSpeakerDetector) SetMaxAccumulatedScore(maxAcummulatedScore uint64) {
+	a.detector.SetMaxAccumulatedScore(maxAcummulatedScore)
+}
+
+// SetNoiseGatingThreshold audio levels (in -dBov) above this value are ignored as noise
+func (a *ActiveSpeakerDetector) SetNoiseGatingThreshold(noiseGatingThreshold byte) {
+	a.detector.SetNoiseGatingThreshold(noiseGatingThreshold)
+}
+
+// SetMinActivationScore minimum score to become the active speaker
+func (a *ActiveSpeakerDetector) SetMinActivationScore(minActivationScore uint) {
+	a.detector.SetMinActivationScore(minActivationScore)
+}
+
+// AddSpeaker start detecting the activity of an audio track
+func (a *ActiveSpeakerDetector) AddSpeaker(track *IncomingStreamTrack) {
+
+	encoding := track.GetFirstEncoding()
+	if encoding == nil {
+		return
+	}
+
+	a.Lock()
+	for _, other := range a.tracks {
+		if other == track {
+			a.Unlock()
+			return
+		}
+	}
+	a.maxID++
+	id := a.maxID
+	a.tracks[id] = track
+	a.Unlock()
+
+	a.detector.AddIncomingSourceGroup(encoding.GetSource().SwigGetRTPIncomingMediaStream(), id)
+}
+
+// RemoveSpeaker stop detecting the activity of an audio track
+func (a *ActiveSpeakerDetector) RemoveSpeaker(track *IncomingStreamTrack) {
+
+	a.Lock()
+	found := false
+	for id, other := range a.tracks {
+		if other == track {
+			delete(a.tracks, id)
+			found = true
+		}
+	}
+	a.Unlock()
+
+	if !found || a.detector == nil {
+		return
+	}
+
+	if encoding := track.GetFirstEncoding(); encoding != nil {
+		a.detector.RemoveIncomingSourceGroup(encoding.GetSource().SwigGetRTPIncomingMediaStream())
+	}
+}
+
+// OnActiveSpeakerChanged register a listener of the active speaker changes
+func (a *ActiveSpeakerDetector) OnActiveSpeakerChanged(listener ActiveSpeakerDetectorListener) {
+	a.Lock()
+	defer a.Unlock()
+	a.onChange = append(a.onChange, listener)
+}
+
+func (a *ActiveSpeakerDetector) onActiveTrackChanged(id uint) {
+
+	a.Lock()
+	track := a.tracks[id]
+	listeners := a.onChange
+	a.Unlock()
+
+	if track == nil {
+		return
+	}
+
+	for _, listener := range listeners {
+		listener(track)
+	}
+}
+
+// Stop stop detecting and release the native detector
+func (a *ActiveSpeakerDetector) Stop() {
+
+	if a.detector == nil {
+		return
+	}
+
+	a.Lock()
+	tracks := a.tracks
+	a.tracks = make(map[uint]*IncomingStreamTrack)
+	a.Unlock()
+
+	for _, track := range tracks {
+		if encoding := track.GetFirstEncoding(); encoding != nil {
+			a.detector.RemoveIncomingSourceGroup(encoding.GetSource().SwigGetRTPIncomingMediaStream())
+		}
+	}
+
+	native.DeleteActiveSpeakerDetectorFacade(a.detector)
+	a.listener.deleteActiveTrackListener()
+
+	a.detector = nil
+	a.listener = nil
+}
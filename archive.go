@@ -0,0 +1,184 @@
+package mediaserver
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrArchiveNoSegments is returned by Export when no finished segment overlaps the requested range
+var ErrArchiveNoSegments = errors.New("no archived segments in range")
+
+// Archive keeps the rolling recordings of several streams, one sub directory per stream,
+// and exports time ranges of them as single mp4 files
+type Archive struct {
+	directory string
+	ffmpeg    string
+	segment   time.Duration
+	streams   map[string]*RollingRecorder
+	sync.Mutex
+}
+
+// NewArchive create an archive in directory rotating the recordings every segment duration,
+// ffmpeg is the path of the ffmpeg binary used for the exports, "ffmpeg" if empty
+func NewArchive(directory string, segment time.Duration, ffmpeg string) (*Archive, error) {
+
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return nil, err
+	}
+
+	if ffmpeg == "" {
+		ffmpeg = "ffmpeg"
+	}
+
+	archive := &Archive{
+		directory: directory,
+		ffmpeg:    ffmpeg,
+		segment:   segment,
+		streams:   map[string]*RollingRecorder{},
+	}
+
+	return archive, nil
+}
+
+// Record start archiving an incoming stream, the rolling recorder is returned to set its retention
+func (a *Archive) Record(incoming *IncomingStream) (*RollingRecorder, error) {
+
+	a.Lock()
+	defer a.Unlock()
+
+	if _, ok := a.streams[incoming.GetID()]; ok {
+		return nil, errors.New("stream already archived")
+	}
+
+	recorder, err := NewRollingRecorder(filepath.Join(a.directory, incoming.GetID()), "segment", a.segment)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder.RecordStream(incoming)
+	a.streams[incoming.GetID()] = recorder
+
+	return recorder, nil
+}
+
+// StopRecording stop archiving a stream, its segments are kept
+func (a *Archive) StopRecording(streamID string) {
+
+	a.Lock()
+	recorder := a.streams[streamID]
+	delete(a.streams, streamID)
+	a.Unlock()
+
+	if recorder != nil {
+		recorder.Stop()
+	}
+}
+
+// Query get the segments of a stream overlapping the from-to time range
+func (a *Archive) Query(streamID string, from time.Time, to time.Time) ([]*RecordingSegment, error) {
+
+	a.Lock()
+	recorder := a.streams[streamID]
+	a.Unlock()
+
+	if recorder != nil {
+		return recorder.Query(from, to), nil
+	}
+
+	// Not recording now, read the index left on disk
+	index, err := loadRecordingIndex(filepath.Join(a.directory, streamID, rollingRecorderIndex))
+	if err != nil {
+		return nil, err
+	}
+	return index.query(from, to), nil
+}
+
+// Export stitch the segments of a stream between from and to into a single mp4 file, returns its path.
+// Media is copied, not transcoded, so the file starts at the keyframe preceding from.
+// The segment being recorded is not finalized yet and is left out of the export
+func (a *Archive) Export(streamID string, from time.Time, to time.Time) (string, error) {
+
+	if !from.Before(to) {
+		return "", errors.New("invalid export range")
+	}
+
+	segments, err := a.Query(streamID, from, to)
+	if err != nil {
+		return "", err
+	}
+
+	finished := segments[:0]
+	for _, segment := range segments {
+		if !segment.End.IsZero() {
+			finished = append(finished, segment)
+		}
+	}
+
+	if len(finished) == 0 {
+		return "", ErrArchiveNoSegments
+	}
+
+	exports := filepath.Join(a.directory, "exports")
+	if err := os.MkdirAll(exports, 0755); err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%s-%s-%s", streamID, from.UTC().Format("20060102T150405"), to.UTC().Format("20060102T150405"))
+	list := filepath.Join(exports, name+".ffconcat")
+	output := filepath.Join(exports, name+".mp4")
+
+	if err := ioutil.WriteFile(list, []byte(GenerateConcatList(finished, from, to)), 0644); err != nil {
+		return "", err
+	}
+	defer os.Remove(list)
+
+	cmd := exec.Command(a.ffmpeg, "-hide_banner", "-y", "-f", "concat", "-safe", "0", "-i", list,
+		"-c", "copy", "-movflags", "+faststart", output)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		os.Remove(output)
+		return "", fmt.Errorf("ffmpeg export failed: %v: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return output, nil
+}
+
+// Stop stop archiving all the streams
+func (a *Archive) Stop() {
+
+	a.Lock()
+	streams := a.streams
+	a.streams = map[string]*RollingRecorder{}
+	a.Unlock()
+
+	for _, recorder := range streams {
+		recorder.Stop()
+	}
+}
+
+// GenerateConcatList generate the ffmpeg concat demuxer script playing the segments trimmed to the from-to range
+func GenerateConcatList(segments []*RecordingSegment, from time.Time, to time.Time) string {
+
+	var b strings.Builder
+	b.WriteString("ffconcat version 1.0\n")
+
+	for _, segment := range segments {
+
+		fmt.Fprintf(&b, "file '%s'\n", strings.Replace(segment.Filename, "'", `'\''`, -1))
+
+		if from.After(segment.Start) {
+			fmt.Fprintf(&b, "inpoint %.3f\n", from.Sub(segment.Start).Seconds())
+		}
+		if !segment.End.IsZero() && to.Before(segment.End) {
+			fmt.Fprintf(&b, "outpoint %.3f\n", to.Sub(segment.Start).Seconds())
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package mediaserver
+
+import (
+	"sync"
+)
+
+// AttachResult completion of an asynchronous track attach
+type AttachResult struct {
+	Transponder *Transponder
+	Err         error
+}
+
+// StreamAttachResult completion of an asynchronous stream attach
+type StreamAttachResult struct {
+	Transponders []*Transponder
+	Err          error
+}
+
+// serialQueue run functions one after another in the order they are queued, off the calling goroutine.
+// The zero value is ready to use and no goroutine is left when the queue is empty
+type serialQueue struct {
+	pending []func()
+	running bool
+	sync.Mutex
+}
+
+func (q *serialQueue) run(fn func()) {
+
+	q.Lock()
+	defer q.Unlock()
+
+	q.pending = append(q.pending, fn)
+	if q.running {
+		return
+	}
+	q.running = true
+
+	go q.drain()
+}
+
+func (q *serialQueue) drain() {
+	for {
+		q.Lock()
+		if len(q.pending) == 0 {
+			q.running = false
+			q.Unlock()
+			return
+		}
+		fn := q.pending[0]
+		q.pending = q.pending[1:]
+		q.Unlock()
+
+		fn()
+	}
+}
+
+// AttachToAsync attach the track like AttachToChecked without waiting for the media thread,
+// the asynchronous operations of a track are run in the order they are requested
+func (o *OutgoingStreamTrack) AttachToAsync(incomingTrack *IncomingStreamTrack) <-chan *AttachResult {
+
+	done := make(chan *AttachResult, 1)
+
+	o.async.run(func() {
+		transponder, err := o.AttachToChecked(incomingTrack)
+		done <- &AttachResult{Transponder: transponder, Err: err}
+	})
+
+	return done
+}
+
+// DetachAsync detach the track like Detach without waiting for the media thread, the channel is closed when done
+func (o *OutgoingStreamTrack) DetachAsync() <-chan struct{} {
+
+	done := make(chan struct{})
+
+	o.async.run(func() {
+		o.Detach()
+		close(done)
+	})
+
+	return done
+}
+
+// AttachToAsync attach the stream like AttachToChecked without waiting for the media thread,
+// the asynchronous operations of a stream are run in the order they are requested
+func (o *OutgoingStream) AttachToAsync(incomingStream *IncomingStream) <-chan *StreamAttachResult {
+
+	done := make(chan *StreamAttachResult, 1)
+
+	o.async.run(func() {
+		transponders, err := o.AttachToChecked(incomingStream)
+		done <- &StreamAttachResult{Transponders: transponders, Err: err}
+	})
+
+	return done
+}
+
+// DetachAsync detach the stream like Detach without waiting for the media thread, the channel is closed when done
+func (o *OutgoingStream) DetachAsync() <-chan struct{} {
+
+	done := make(chan struct{})
+
+	o.async.run(func() {
+		o.Detach()
+		close(done)
+	})
+
+	return done
+}
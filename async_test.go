@@ -0,0 +1,29 @@
+package mediaserver
+
+import (
+	"sync"
+	"testing"
+)
+
+func Test_SerialQueue(t *testing.T) {
+
+	var queue serialQueue
+	var wg sync.WaitGroup
+	order := []int{}
+
+	wg.Add(100)
+	for i := 0; i < 100; i++ {
+		i := i
+		queue.run(func() {
+			order = append(order, i)
+			wg.Done()
+		})
+	}
+	wg.Wait()
+
+	for i, value := range order {
+		if i != value {
+			t.Fatal("expected the functions run in order", order)
+		}
+	}
+}
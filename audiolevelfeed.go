@@ -0,0 +1,171 @@
+package mediaserver
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// SilentAudioLevel audio level in -dBov of a track with no audio level received
+const SilentAudioLevel = 127
+
+// SpeakerLevel loudest audio level of a track during the last feed interval
+type SpeakerLevel struct {
+	TrackID string `json:"id"`
+	// Level in -dBov, 0 is the loudest and 127 silence
+	Level int `json:"level"`
+}
+
+// AudioLevelFeedListener called every interval with the loudest tracks first
+type AudioLevelFeedListener func(levels []SpeakerLevel)
+
+// AudioLevelFeed read the rtp audio level extension of many audio tracks and report only the top N speakers periodically,
+// so a single message can be broadcast to the participants, ie over a datachannel, see EncodeSpeakerLevels
+type AudioLevelFeed struct {
+	topN      int
+	threshold int
+	meters    map[*IncomingStreamTrack]native.AudioLevelMeter
+	listeners []AudioLevelFeedListener
+	ticker    *time.Ticker
+	done      chan struct{}
+	sync.Mutex
+}
+
+// NewAudioLevelFeed create a feed of the topN loudest tracks every interval
+func NewAudioLevelFeed(topN int, interval time.Duration) *AudioLevelFeed {
+
+	feed := &AudioLevelFeed{
+		topN:      topN,
+		threshold: SilentAudioLevel,
+		meters:    map[*IncomingStreamTrack]native.AudioLevelMeter{},
+		ticker:    time.NewTicker(interval),
+		done:      make(chan struct{}),
+	}
+
+	go feed.run()
+
+	return feed
+}
+
+// SetNoiseGatingThreshold levels in -dBov at or above this value are not reported, 127 by default
+func (a *AudioLevelFeed) SetNoiseGatingThreshold(threshold int) {
+	a.Lock()
+	a.threshold = threshold
+	a.Unlock()
+}
+
+// AddTrack start reading the audio levels of an audio track, it is removed when the track stops
+func (a *AudioLevelFeed) AddTrack(track *IncomingStreamTrack) {
+
+	a.Lock()
+	defer a.Unlock()
+
+	if _, ok := a.meters[track]; ok || track.GetMedia() != "audio" {
+		return
+	}
+
+	a.meters[track] = native.NewAudioLevelMeter(track.GetFirstEncoding().GetSource())
+
+	track.OnStop(func() {
+		a.RemoveTrack(track)
+	})
+}
+
+// RemoveTrack stop reading the audio levels of a track
+func (a *AudioLevelFeed) RemoveTrack(track *IncomingStreamTrack) {
+
+	a.Lock()
+	defer a.Unlock()
+
+	if meter, ok := a.meters[track]; ok {
+		meter.Stop()
+		native.DeleteAudioLevelMeter(meter)
+		delete(a.meters, track)
+	}
+}
+
+// OnLevels register a listener of the top speaker levels
+func (a *AudioLevelFeed) OnLevels(listener AudioLevelFeedListener) {
+	a.Lock()
+	a.listeners = append(a.listeners, listener)
+	a.Unlock()
+}
+
+// Stop stop the feed and release the tracks
+func (a *AudioLevelFeed) Stop() {
+
+	a.Lock()
+	defer a.Unlock()
+
+	if a.ticker == nil {
+		return
+	}
+
+	a.ticker.Stop()
+	close(a.done)
+	a.ticker = nil
+
+	for track, meter := range a.meters {
+		meter.Stop()
+		native.DeleteAudioLevelMeter(meter)
+		delete(a.meters, track)
+	}
+}
+
+func (a *AudioLevelFeed) run() {
+	for {
+		select {
+		case <-a.ticker.C:
+			a.broadcast()
+		case <-a.done:
+			return
+		}
+	}
+}
+
+func (a *AudioLevelFeed) broadcast() {
+
+	a.Lock()
+	levels := []SpeakerLevel{}
+	for track, meter := range a.meters {
+		level := int(meter.ReadLevel())
+		if level < a.threshold {
+			levels = append(levels, SpeakerLevel{TrackID: track.GetID(), Level: level})
+		}
+	}
+	listeners := a.listeners
+	topN := a.topN
+	a.Unlock()
+
+	levels = topSpeakerLevels(levels, topN)
+
+	for _, listener := range listeners {
+		listener(levels)
+	}
+}
+
+// topSpeakerLevels keep the n loudest levels, loudest first, ties ordered by track id
+func topSpeakerLevels(levels []SpeakerLevel, n int) []SpeakerLevel {
+
+	sort.Slice(levels, func(i, j int) bool {
+		if levels[i].Level != levels[j].Level {
+			return levels[i].Level < levels[j].Level
+		}
+		return levels[i].TrackID < levels[j].TrackID
+	})
+
+	if n > 0 && len(levels) > n {
+		levels = levels[:n]
+	}
+
+	return levels
+}
+
+// EncodeSpeakerLevels encode the levels as a compact json array to send to the participants
+func EncodeSpeakerLevels(levels []SpeakerLevel) []byte {
+	data, _ := json.Marshal(levels)
+	return data
+}
@@ -0,0 +1,106 @@
+package mediaserver
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/notedit/sdp"
+)
+
+// static payload types of the telephony codecs
+var telephonyPayloadTypes = map[string]int{
+	"pcmu": 0,
+	"pcma": 8,
+	"g722": 9,
+}
+
+// payload type of the opus output of the transcoder
+const transcoderOpusPayloadType = 111
+
+// AudioTranscoder transcode a G.711 or G.722 audio track, ie from a SIP gateway, to opus with ffmpeg
+// so browsers can subscribe to it
+type AudioTranscoder struct {
+	process     *FFmpegProcess
+	transponder *Transponder
+}
+
+// NewAudioTranscoder create a transcoder of the incoming track to opus at bitrate kbps, 32 if zero,
+// ffmpeg is the path of the ffmpeg binary, "ffmpeg" if empty
+func NewAudioTranscoder(incoming *IncomingStreamTrack, ffmpeg string, bitrate int) (*AudioTranscoder, error) {
+
+	if bitrate == 0 {
+		bitrate = 32
+	}
+
+	codec := ""
+	for _, name := range incoming.GetCodecs() {
+		if _, ok := telephonyPayloadTypes[name]; ok {
+			codec = name
+			break
+		}
+	}
+
+	// Tracks not created from a negotiated media have no codec list, assume pcmu
+	if codec == "" && len(incoming.GetCodecs()) == 0 {
+		codec = "pcmu"
+	}
+
+	if codec == "" {
+		return nil, fmt.Errorf("track codecs %v are not telephony codecs", incoming.GetCodecs())
+	}
+
+	// The session receives the telephony codec from the track and the opus output of ffmpeg
+	media := sdp.NewMediaInfo("audio", "audio")
+	media.AddCodec(sdp.NewCodecInfo(codec, telephonyPayloadTypes[codec]))
+	media.AddCodec(sdp.NewCodecInfo("opus", transcoderOpusPayloadType))
+
+	process, err := NewFFmpegProcess(FFmpegConfig{
+		Path:    ffmpeg,
+		Medias:  []*sdp.MediaInfo{media},
+		Restart: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	process.config.OutputArgs = []string{"-vn", "-c:a", "libopus", "-ar", "48000", "-ac", "2", "-application", "voip",
+		"-b:a", strconv.Itoa(bitrate) + "k", "-payload_type", strconv.Itoa(transcoderOpusPayloadType),
+		"-f", "rtp", fmt.Sprintf("rtp://127.0.0.1:%d", process.GetLocalPort("audio"))}
+
+	transponder, err := process.GetOutgoingStreamTrack("audio").AttachToChecked(incoming)
+	if err != nil {
+		process.Stop()
+		return nil, err
+	}
+
+	if err := process.Start(); err != nil {
+		process.Stop()
+		return nil, err
+	}
+
+	transcoder := &AudioTranscoder{
+		process:     process,
+		transponder: transponder,
+	}
+
+	return transcoder, nil
+}
+
+// GetIncomingStreamTrack get the opus track, attach it to the OutgoingStreamTrack of the subscribers
+func (a *AudioTranscoder) GetIncomingStreamTrack() *IncomingStreamTrack {
+	return a.process.GetIncomingStreamTrack("audio")
+}
+
+// OnExit register a listener called each time ffmpeg exits, it is restarted until Stop
+func (a *AudioTranscoder) OnExit(listener FFmpegExitListener) {
+	a.process.OnExit(listener)
+}
+
+// Stop stop transcoding
+func (a *AudioTranscoder) Stop() {
+	if a.transponder != nil {
+		a.transponder.Stop()
+		a.transponder = nil
+	}
+	a.process.Stop()
+}
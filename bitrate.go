@@ -0,0 +1,135 @@
+package mediaserver
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// BitrateWindow smoothing applied to the bitrates returned by GetStatsWithWindow
+// A zero Duration keeps the native bitrate, accumulated over a fixed 1s window
+type BitrateWindow struct {
+	// Duration averaging window, or time constant of the EWMA
+	Duration time.Duration
+	// EWMA use an exponentially weighted moving average instead of a sliding window
+	EWMA bool
+}
+
+var (
+	// BitrateWindowNative native 1s accumulator
+	BitrateWindowNative = BitrateWindow{}
+	// BitrateWindow1s average over the last second
+	BitrateWindow1s = BitrateWindow{Duration: time.Second}
+	// BitrateWindow5s average over the last 5 seconds
+	BitrateWindow5s = BitrateWindow{Duration: 5 * time.Second}
+	// BitrateWindowEWMA exponentially weighted moving average with a 5 seconds time constant
+	BitrateWindowEWMA = BitrateWindow{Duration: 5 * time.Second, EWMA: true}
+)
+
+// bitrateHistory max age of the byte counter samples kept by a meter
+const bitrateHistory = 60 * time.Second
+
+type bitrateSample struct {
+	timestamp int64
+	bytes     uint
+}
+
+// bitrateMeter compute bitrates over any window from the native byte counters
+// Samples are taken each time the stats are refreshed, so the precision depends on how often they are queried
+type bitrateMeter struct {
+	samples []bitrateSample
+}
+
+// update add a sample of the total bytes at timestamp in nanoseconds
+func (m *bitrateMeter) update(timestamp int64, bytes uint) {
+
+	if len(m.samples) > 0 {
+		last := m.samples[len(m.samples)-1]
+		if last.timestamp >= timestamp {
+			return
+		}
+		// native counters are 32 bits
+		if bytes < last.bytes {
+			m.samples = m.samples[:0]
+		}
+	}
+
+	m.samples = append(m.samples, bitrateSample{timestamp: timestamp, bytes: bytes})
+
+	oldest := 0
+	for oldest < len(m.samples)-2 && timestamp-m.samples[oldest+1].timestamp >= int64(bitrateHistory) {
+		oldest++
+	}
+	m.samples = m.samples[oldest:]
+}
+
+// bitrate in bps over the window, 0 until there are two samples
+func (m *bitrateMeter) bitrate(window BitrateWindow) uint {
+
+	if len(m.samples) < 2 {
+		return 0
+	}
+
+	last := m.samples[len(m.samples)-1]
+
+	if window.EWMA {
+		ewma := 0.0
+		for i := 1; i < len(m.samples); i++ {
+			elapsed := float64(m.samples[i].timestamp - m.samples[i-1].timestamp)
+			rate := float64(m.samples[i].bytes-m.samples[i-1].bytes) * 8 * float64(time.Second) / elapsed
+			if i == 1 {
+				ewma = rate
+				continue
+			}
+			alpha := 1 - math.Exp(-elapsed/float64(window.Duration))
+			ewma += alpha * (rate - ewma)
+		}
+		return uint(ewma)
+	}
+
+	// newest sample at least one window old, or the oldest one
+	first := m.samples[0]
+	for _, sample := range m.samples[:len(m.samples)-1] {
+		if last.timestamp-sample.timestamp < int64(window.Duration) {
+			break
+		}
+		first = sample
+	}
+
+	return uint(float64(last.bytes-first.bytes) * 8 * float64(time.Second) / float64(last.timestamp-first.timestamp))
+}
+
+// bitrateMeters meters of the sources of a track, by encoding and source kind
+type bitrateMeters struct {
+	meters map[string]*bitrateMeter
+	sync.Mutex
+}
+
+func (b *bitrateMeters) update(key string, timestamp int64, bytes uint) {
+
+	b.Lock()
+	defer b.Unlock()
+
+	if b.meters == nil {
+		b.meters = map[string]*bitrateMeter{}
+	}
+
+	meter := b.meters[key]
+	if meter == nil {
+		meter = &bitrateMeter{}
+		b.meters[key] = meter
+	}
+
+	meter.update(timestamp, bytes)
+}
+
+func (b *bitrateMeters) bitrate(key string, window BitrateWindow) uint {
+
+	b.Lock()
+	defer b.Unlock()
+
+	if meter := b.meters[key]; meter != nil {
+		return meter.bitrate(window)
+	}
+	return 0
+}
@@ -0,0 +1,38 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_BitrateMeter(t *testing.T) {
+
+	meter := &bitrateMeter{}
+
+	// 1000 bytes per second for 5 seconds then 2000 bytes per second
+	bytes := uint(0)
+	for i := 0; i <= 10; i++ {
+		meter.update(int64(i)*int64(time.Second), bytes)
+		if i < 5 {
+			bytes += 1000
+		} else {
+			bytes += 2000
+		}
+	}
+
+	if bitrate := meter.bitrate(BitrateWindow1s); bitrate != 16000 {
+		t.Error("unexpected 1s bitrate", bitrate)
+	}
+
+	if bitrate := meter.bitrate(BitrateWindow5s); bitrate != 16000 {
+		t.Error("unexpected 5s bitrate", bitrate)
+	}
+
+	if bitrate := meter.bitrate(BitrateWindow{Duration: 10 * time.Second}); bitrate != 12000 {
+		t.Error("unexpected 10s bitrate", bitrate)
+	}
+
+	if bitrate := meter.bitrate(BitrateWindowEWMA); bitrate <= 8000 || bitrate >= 16000 {
+		t.Error("unexpected ewma bitrate", bitrate)
+	}
+}
@@ -0,0 +1,103 @@
+package mediaserver
+
+import (
+	"errors"
+	"time"
+)
+
+// TargetBitrateListener called with the bitrate in bps estimated by the sender side bandwidth estimation
+type TargetBitrateListener func(bitrate uint)
+
+// ProbingConfig bandwidth probing parameters, see Transport.SetProbing
+type ProbingConfig struct {
+	// Enabled send padding only RTX packets to probe bitrate beyond the current sent one
+	Enabled bool
+	// MaxBitrate maximum bitrate of the probing padding
+	MaxBitrate uint
+	// BitrateLimit no probing is sent while the total sent bitrate is over it, zero keeps the native default
+	BitrateLimit uint
+}
+
+// EnableSenderSideEstimation enable or disable the sender side bandwidth estimation of this transport, it is enabled by default.
+// The native estimator runs on the transport-wide-cc feedback of the remote peer; when disabled the target bitrate
+// is not reported to the OnTargetBitrate listeners and bandwidth probing is paused, as nothing would consume its result
+func (t *Transport) EnableSenderSideEstimation(enable bool) {
+	t.Lock()
+	t.senderSideEstimation = enable
+	probe := enable && t.probing.Enabled
+	t.Unlock()
+	t.transport.SetBandwidthProbing(probe)
+}
+
+// IsSenderSideEstimationEnabled check if the sender side bandwidth estimation is enabled
+func (t *Transport) IsSenderSideEstimationEnabled() bool {
+	t.Lock()
+	defer t.Unlock()
+	return t.senderSideEstimation
+}
+
+// SetProbing set all the bandwidth probing parameters at once
+func (t *Transport) SetProbing(config ProbingConfig) {
+	t.Lock()
+	t.probing = config
+	probe := config.Enabled && t.senderSideEstimation
+	t.Unlock()
+
+	t.transport.SetMaxProbingBitrate(config.MaxBitrate)
+	if config.BitrateLimit > 0 {
+		t.transport.SetProbingBitrateLimit(config.BitrateLimit)
+	}
+	t.transport.SetBandwidthProbing(probe)
+}
+
+// GetProbing get the bandwidth probing parameters
+func (t *Transport) GetProbing() ProbingConfig {
+	t.Lock()
+	defer t.Unlock()
+	return t.probing
+}
+
+// DumpBWEStats record the internals of the sender side bandwidth estimation to a csv file, one line per packet acknowledged
+// by the transport-cc feedback with its send and receive deltas, the estimates and the losses. The format is the one of the
+// native library, read by the medooze bwe analysis tools. The file is closed when the transport is stopped
+func (t *Transport) DumpBWEStats(filename string) error {
+
+	if t.transport == nil {
+		return errors.New("transport stopped")
+	}
+
+	if t.transport.DumpBWEStats(filename) == 0 {
+		return errors.New("can not dump bwe stats to " + filename)
+	}
+	return nil
+}
+
+// OnTargetBitrate register a listener of the target bitrate estimated by the sender side bandwidth estimation,
+// subscribers can use it to select the simulcast layers sent instead of relying only on the REMB of the remote peer.
+// It is called from the native thread so it must not block
+func (t *Transport) OnTargetBitrate(listener TargetBitrateListener) ListenerOff {
+	return t.targetBitrateListeners.on(listener)
+}
+
+// GetTargetBitrate get the last target bitrate estimated, zero if there is no estimation yet
+func (t *Transport) GetTargetBitrate() uint {
+	t.Lock()
+	defer t.Unlock()
+	return t.targetBitrate
+}
+
+func (t *Transport) onTargetBitrate(bitrate uint) {
+
+	t.Lock()
+	t.onFeedback(time.Now())
+	if !t.senderSideEstimation {
+		t.Unlock()
+		return
+	}
+	t.targetBitrate = bitrate
+	t.Unlock()
+
+	t.targetBitrateListeners.emit(func(listener interface{}) {
+		listener.(TargetBitrateListener)(bitrate)
+	})
+}
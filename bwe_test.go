@@ -0,0 +1,69 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_TargetBitrate(t *testing.T) {
+
+	transport := &Transport{senderSideEstimation: true}
+
+	bitrates := []uint{}
+	transport.OnTargetBitrate(func(bitrate uint) {
+		bitrates = append(bitrates, bitrate)
+	})
+
+	transport.onTargetBitrate(300000)
+	transport.onTargetBitrate(500000)
+
+	transport.Lock()
+	transport.senderSideEstimation = false
+	transport.Unlock()
+
+	transport.onTargetBitrate(800000)
+
+	if len(bitrates) != 2 || bitrates[0] != 300000 || bitrates[1] != 500000 {
+		t.Fatalf("unexpected target bitrates %v", bitrates)
+	}
+
+	if transport.GetTargetBitrate() != 500000 {
+		t.Fatalf("expected last target bitrate 500000, got %d", transport.GetTargetBitrate())
+	}
+}
+
+func Test_FeedbackInterval(t *testing.T) {
+
+	transport := &Transport{}
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		transport.onFeedback(start.Add(time.Duration(i) * 100 * time.Millisecond))
+	}
+
+	stats := transport.GetTransportCCStats()
+	if stats.Feedbacks != 10 || stats.FeedbackInterval != 100*time.Millisecond {
+		t.Fatalf("unexpected feedback stats %+v", stats)
+	}
+}
+
+func Test_MaxOutgoingBitrate(t *testing.T) {
+
+	transport := &Transport{maxOutgoingBitrate: 1000000}
+
+	if bitrate := transport.capPacerBitrate(2500000); bitrate != 1000000 {
+		t.Fatalf("expected pacer bitrate capped to 1000000, got %d", bitrate)
+	}
+	if bitrate := transport.capPacerBitrate(600000); bitrate != 600000 {
+		t.Fatalf("expected pacer bitrate 600000, got %d", bitrate)
+	}
+
+	if share := transport.maxVideoTrackBitrate(); share != 1000000 {
+		t.Fatalf("expected the whole cap for a single video track, got %d", share)
+	}
+
+	transport.maxOutgoingBitrate = 0
+	if share := transport.maxVideoTrackBitrate(); share != 0 {
+		t.Fatalf("expected no cap, got %d", share)
+	}
+}
@@ -0,0 +1,99 @@
+package mediaserver
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Caption a timed text event, ie from a speech to text processor
+type Caption struct {
+	Start time.Time
+	End   time.Time
+	Text  string
+}
+
+// WebVTTWriter write captions as a WebVTT file, cue times are relative to the writer origin
+type WebVTTWriter struct {
+	file   *os.File
+	writer *bufio.Writer
+	origin time.Time
+	sync.Mutex
+}
+
+// NewWebVTTWriter create the WebVTT file, origin is the time of the 00:00:00.000 cue time
+func NewWebVTTWriter(filename string, origin time.Time) (*WebVTTWriter, error) {
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &WebVTTWriter{
+		file:   file,
+		writer: bufio.NewWriter(file),
+		origin: origin,
+	}
+
+	if _, err := writer.writer.WriteString("WEBVTT\n\n"); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+func formatWebVTTTime(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+// WriteCaption append a cue, captions before the origin are clamped to it
+func (w *WebVTTWriter) WriteCaption(caption *Caption) error {
+
+	if !caption.End.After(caption.Start) {
+		return errors.New("caption end must be after its start")
+	}
+
+	// a blank line would end the cue
+	text := strings.TrimSpace(strings.ReplaceAll(caption.Text, "\n\n", "\n"))
+
+	w.Lock()
+	defer w.Unlock()
+
+	if w.file == nil {
+		return errors.New("webvtt writer closed")
+	}
+
+	_, err := fmt.Fprintf(w.writer, "%s --> %s\n%s\n\n",
+		formatWebVTTTime(caption.Start.Sub(w.origin)), formatWebVTTTime(caption.End.Sub(w.origin)), text)
+	if err != nil {
+		return err
+	}
+	return w.writer.Flush()
+}
+
+// Close flush and close the file
+func (w *WebVTTWriter) Close() error {
+
+	w.Lock()
+	defer w.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+
+	err := w.writer.Flush()
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	w.file = nil
+	return err
+}
@@ -0,0 +1,71 @@
+package mediaserver
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// ErrCertificateLoad the native DTLS context could not be initialized with the certificate
+var ErrCertificateLoad = errors.New("could not load dtls certificate")
+
+// SetCertificate use the PEM certificate and private key files for DTLS instead of the self signed certificate generated at startup.
+// It can be called again at runtime to rotate the certificate: established transports keep their DTLS session, transports created after
+// advertise the new fingerprint. Transports created before but not connected yet advertised the old fingerprint and will fail the handshake
+func SetCertificate(certFile, keyFile string) error {
+
+	// check they are readable and match before replacing the native context
+	if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+		return err
+	}
+
+	if !native.MediaServerSetCertificate(certFile, keyFile) {
+		return ErrCertificateLoad
+	}
+	return nil
+}
+
+// GetFingerprint get the sha-256 fingerprint of the current DTLS certificate
+func GetFingerprint() string {
+	return native.MediaServerGetFingerprint()
+}
+
+// CertificateFingerprint get the sha-256 fingerprint of the first certificate of a PEM file,
+// so the fingerprint of the next certificate can be published before rotating to it with SetCertificate
+func CertificateFingerprint(certFile string) (string, error) {
+
+	data, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return "", err
+	}
+	return PEMCertificateFingerprint(data)
+}
+
+// PEMCertificateFingerprint get the sha-256 fingerprint of the first certificate of the PEM data, in the sdp format
+func PEMCertificateFingerprint(data []byte) (string, error) {
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return "", errors.New("no certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return formatFingerprint(sha256.Sum256(block.Bytes)), nil
+		}
+	}
+}
+
+func formatFingerprint(digest [sha256.Size]byte) string {
+	hex := make([]string, len(digest))
+	for i, b := range digest {
+		hex[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(hex, ":")
+}
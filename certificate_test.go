@@ -0,0 +1,29 @@
+package mediaserver
+
+import (
+	"crypto/sha256"
+	"encoding/pem"
+	"strings"
+	"testing"
+)
+
+func Test_PEMCertificateFingerprint(t *testing.T) {
+
+	der := []byte("not a real certificate")
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")})
+	data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+
+	fingerprint, err := PEMCertificateFingerprint(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256(der)
+	if fingerprint != formatFingerprint(digest) || len(strings.Split(fingerprint, ":")) != sha256.Size {
+		t.Error("unexpected fingerprint", fingerprint)
+	}
+
+	if _, err := PEMCertificateFingerprint([]byte("garbage")); err == nil {
+		t.Error("fingerprint of data without certificate")
+	}
+}
@@ -0,0 +1,87 @@
+package mediaserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/notedit/sdp"
+)
+
+// CodecMismatchError is returned by AttachToChecked when the remote peer of the outgoing track can not decode the incoming track codec
+type CodecMismatchError struct {
+	// Media "audio" or "video"
+	Media string
+	// Codec received from the publisher, "" if no media has been received yet
+	Codec string
+	// Incoming codecs negotiated by the publisher
+	Incoming []string
+	// Compatible codecs supported by the subscriber, the publisher would have to send one of them
+	Compatible []string
+}
+
+func (e *CodecMismatchError) Error() string {
+	if e.Codec != "" {
+		return fmt.Sprintf("%s codec %s not supported by subscriber, compatible codecs %v", e.Media, e.Codec, e.Compatible)
+	}
+	return fmt.Sprintf("%s codecs %v not all supported by subscriber, compatible codecs %v", e.Media, e.Incoming, e.Compatible)
+}
+
+// isMediaCodec filter out retransmission and protection payloads
+func isMediaCodec(codec string) bool {
+	switch strings.ToLower(codec) {
+	case "rtx", "red", "ulpfec", "flexfec-03", "telephone-event", "cn":
+		return false
+	}
+	return true
+}
+
+func getMediaCodecs(media *sdp.MediaInfo) []string {
+
+	codecs := []string{}
+	if media == nil {
+		return codecs
+	}
+
+	for _, codec := range media.GetCodecs() {
+		if isMediaCodec(codec.GetCodec()) {
+			codecs = append(codecs, strings.ToLower(codec.GetCodec()))
+		}
+	}
+	return codecs
+}
+
+func hasCodec(codecs []string, codec string) bool {
+	for _, other := range codecs {
+		if codec == other {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCodecs returns a *CodecMismatchError if the subscriber can not decode the codec received from the publisher,
+// before any media is received all the incoming codecs must be supported as the publisher may send any of them.
+// Unknown supported codecs are not checked
+func checkCodecs(media string, codec string, incoming []string, supported []string) error {
+
+	if len(supported) == 0 {
+		return nil
+	}
+
+	required := incoming
+	if codec != "" {
+		required = []string{codec}
+	}
+
+	for _, other := range required {
+		if !hasCodec(supported, other) {
+			return &CodecMismatchError{
+				Media:      media,
+				Codec:      codec,
+				Incoming:   incoming,
+				Compatible: supported,
+			}
+		}
+	}
+	return nil
+}
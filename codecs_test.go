@@ -0,0 +1,64 @@
+package mediaserver
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/notedit/sdp"
+)
+
+func Test_GetMediaCodecs(t *testing.T) {
+
+	video := sdp.NewMediaInfo("video", "video")
+	video.AddCodec(sdp.NewCodecInfo("VP8", 96))
+	video.AddCodec(sdp.NewCodecInfo("rtx", 97))
+	video.AddCodec(sdp.NewCodecInfo("H264", 102))
+	video.AddCodec(sdp.NewCodecInfo("ulpfec", 127))
+
+	codecs := getMediaCodecs(video)
+	sort.Strings(codecs)
+	if len(codecs) != 2 || codecs[0] != "h264" || codecs[1] != "vp8" {
+		t.Error("unexpected media codecs", codecs)
+	}
+
+	if codecs := getMediaCodecs(nil); len(codecs) != 0 {
+		t.Error("expected no codecs", codecs)
+	}
+}
+
+func Test_CheckCodecs(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		codec     string
+		incoming  []string
+		supported []string
+		mismatch  bool
+	}{
+		{"payload supported", "vp8", []string{"vp8", "h264"}, []string{"vp8"}, false},
+		{"payload not supported", "h264", []string{"vp8", "h264"}, []string{"vp8"}, true},
+		{"payload not negotiated but supported", "vp9", []string{"vp8"}, []string{"vp9"}, false},
+		{"no media all supported", "", []string{"vp8", "h264"}, []string{"h264", "vp8", "vp9"}, false},
+		{"no media one not supported", "", []string{"vp8", "h264"}, []string{"vp8"}, true},
+		{"no media no incoming codecs", "", nil, []string{"vp8"}, false},
+		{"unknown supported codecs", "h264", []string{"h264"}, nil, false},
+	}
+
+	for _, test := range tests {
+		err := checkCodecs("video", test.codec, test.incoming, test.supported)
+		if !test.mismatch {
+			if err != nil {
+				t.Error(test.name, "unexpected error", err)
+			}
+			continue
+		}
+		mismatch, ok := err.(*CodecMismatchError)
+		if !ok {
+			t.Error(test.name, "expected codec mismatch error", err)
+			continue
+		}
+		if mismatch.Media != "video" || mismatch.Codec != test.codec || len(mismatch.Compatible) != len(test.supported) {
+			t.Error(test.name, "unexpected codec mismatch error", mismatch)
+		}
+	}
+}
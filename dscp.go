@@ -0,0 +1,46 @@
+package mediaserver
+
+import (
+	"errors"
+)
+
+// DSCP code points commonly used for real time media, see RFC 8837
+const (
+	DSCPDefault = 0
+	DSCPAF41    = 34
+	DSCPAF42    = 36
+	DSCPEF      = 46
+)
+
+// ErrInvalidDSCP is returned when the DSCP code point does not fit in 6 bits
+var ErrInvalidDSCP = errors.New("invalid dscp, must be between 0 and 63")
+
+// SetDSCP mark all the packets sent by the endpoint with the DSCP code point, ie DSCPEF, so QoS can be applied in managed networks.
+// The native library sends audio and video on the same socket and has no per packet marking, so the value applies to both,
+// use an endpoint per media class to mark them differently. It is applied again if a Watchdog restarts the endpoint
+func (e *Endpoint) SetDSCP(dscp int) error {
+
+	if dscp < 0 || dscp > 63 {
+		return ErrInvalidDSCP
+	}
+
+	e.Lock()
+	defer e.Unlock()
+
+	if e.bundle == nil {
+		return errors.New("endpoint stopped")
+	}
+
+	if err := setSocketTOS(e.candidate.GetAddress(), e.bundle.GetLocalPort(), dscp<<2); err != nil {
+		return err
+	}
+	e.dscp = dscp
+	return nil
+}
+
+// GetDSCP get the DSCP code point of the packets sent by the endpoint
+func (e *Endpoint) GetDSCP() int {
+	e.Lock()
+	defer e.Unlock()
+	return e.dscp
+}
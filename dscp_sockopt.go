@@ -0,0 +1,78 @@
+//go:build !windows
+// +build !windows
+
+package mediaserver
+
+import (
+	"errors"
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// setSocketTOS set the TOS of the udp socket bound to the address and port, the traffic class for an ipv6 socket
+func setSocketTOS(ip string, port int, tos int) error {
+	fd, inet6, err := endpointSocket(ip, port)
+	if err != nil {
+		return err
+	}
+	if inet6 {
+		// a dual stack socket also sends ipv4, which only has the TOS
+		syscall.SetsockoptInt(fd, syscall.IPPROTO_IP, syscall.IP_TOS, tos)
+		return syscall.SetsockoptInt(fd, syscall.IPPROTO_IPV6, syscall.IPV6_TCLASS, tos)
+	}
+	return syscall.SetsockoptInt(fd, syscall.IPPROTO_IP, syscall.IP_TOS, tos)
+}
+
+// endpointSocket find the udp socket bound to the address and port, the native bundle does not expose its socket
+// so it is looked up among the open file descriptors. A socket bound to the wildcard address matches any ip
+func endpointSocket(ip string, port int) (int, bool, error) {
+
+	local := net.ParseIP(ip)
+
+	dir, err := os.Open("/dev/fd")
+	if err != nil {
+		return -1, false, err
+	}
+	names, err := dir.Readdirnames(-1)
+	dir.Close()
+	if err != nil {
+		return -1, false, err
+	}
+
+	for _, name := range names {
+		fd, err := strconv.Atoi(name)
+		if err != nil {
+			continue
+		}
+		if typ, err := syscall.GetsockoptInt(fd, syscall.SOL_SOCKET, syscall.SO_TYPE); err != nil || typ != syscall.SOCK_DGRAM {
+			continue
+		}
+		addr, err := syscall.Getsockname(fd)
+		if err != nil {
+			continue
+		}
+		var bound net.IP
+		inet6 := false
+		switch inet := addr.(type) {
+		case *syscall.SockaddrInet4:
+			if inet.Port != port {
+				continue
+			}
+			bound = net.IP(inet.Addr[:])
+		case *syscall.SockaddrInet6:
+			if inet.Port != port {
+				continue
+			}
+			bound, inet6 = net.IP(inet.Addr[:]), true
+		default:
+			continue
+		}
+		if bound.IsUnspecified() || bound.Equal(local) {
+			return fd, inet6, nil
+		}
+	}
+
+	return -1, false, errors.New("endpoint socket not found")
+}
@@ -0,0 +1,99 @@
+//go:build !windows
+// +build !windows
+
+package mediaserver
+
+import (
+	"net"
+	"syscall"
+	"testing"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+func Test_SetSocketTOS(t *testing.T) {
+
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	port := conn.LocalAddr().(*net.UDPAddr).Port
+	if err := setSocketTOS("127.0.0.1", port, DSCPEF<<2); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tos := 0
+	raw.Control(func(fd uintptr) {
+		tos, err = syscall.GetsockoptInt(int(fd), syscall.IPPROTO_IP, syscall.IP_TOS)
+	})
+	if err != nil || tos != DSCPEF<<2 {
+		t.Fatalf("expected tos %d, got %d %v", DSCPEF<<2, tos, err)
+	}
+}
+
+func Test_ReplaceBundleReportsTOSError(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+
+	// the socket of the new bundle can not be found, the bundle is replaced anyway
+	endpoint.Lock()
+	endpoint.dscp = DSCPEF
+	bundle := native.NewRTPBundleTransport()
+	err := endpoint.replaceBundle(bundle, nil)
+	endpoint.Unlock()
+
+	if err == nil || endpoint.bundle != bundle {
+		t.Fatal("expected the bundle replaced with the tos error", err)
+	}
+}
+
+func Test_EndpointSocketAddress(t *testing.T) {
+
+	other, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 2)})
+	if err != nil {
+		t.Skip("no loopback alias", err)
+	}
+	defer other.Close()
+
+	port := other.LocalAddr().(*net.UDPAddr).Port
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: port})
+	if err != nil {
+		t.Skip("port taken", err)
+	}
+	defer conn.Close()
+
+	// the socket on the same port bound to another address is not the endpoint one
+	if err := setSocketTOS("127.0.0.1", port, DSCPEF<<2); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, udp := range []*net.UDPConn{conn, other} {
+		raw, err := udp.SyscallConn()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tos := 0
+		raw.Control(func(fd uintptr) {
+			tos, err = syscall.GetsockoptInt(int(fd), syscall.IPPROTO_IP, syscall.IP_TOS)
+		})
+		expected := 0
+		if udp == conn {
+			expected = DSCPEF << 2
+		}
+		if err != nil || tos != expected {
+			t.Errorf("expected tos %d on %s, got %d %v", expected, udp.LocalAddr(), tos, err)
+		}
+	}
+
+	if _, _, err := endpointSocket("127.0.0.3", port); err == nil {
+		t.Error("expected no socket bound to the address")
+	}
+}
@@ -0,0 +1,9 @@
+package mediaserver
+
+import (
+	"errors"
+)
+
+func setSocketTOS(ip string, port int, tos int) error {
+	return errors.New("dscp marking not supported on windows")
+}
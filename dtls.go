@@ -0,0 +1,132 @@
+package mediaserver
+
+import (
+	"errors"
+
+	"github.com/notedit/sdp"
+)
+
+// ErrInvalidDTLSSetup the DTLS setup role is not active, passive or actpass
+var ErrInvalidDTLSSetup = errors.New("invalid dtls setup")
+
+// ErrDTLSRenegotiation the native transport refused the new DTLS parameters
+var ErrDTLSRenegotiation = errors.New("dtls renegotiation failed")
+
+// ErrDTLSFailed the DTLS handshake failed
+var ErrDTLSFailed = errors.New("dtls handshake failed")
+
+// DTLSRenegotiatedListener called when the DTLS handshake completes again after a renegotiation or rekey
+type DTLSRenegotiatedListener func()
+
+// native DTLSConnection states
+var dtlsStates = []string{"new", "connecting", "connected", "closed", "failed"}
+
+func dtlsStateName(state uint) string {
+	if int(state) < len(dtlsStates) {
+		return dtlsStates[state]
+	}
+	return "unknown"
+}
+
+// remoteSetupFor get the remote setup to configure in the native transport so the local role is the given one
+func remoteSetupFor(local sdp.Setup, remote sdp.Setup) sdp.Setup {
+	switch local {
+	case sdp.SETUPACTIVE, sdp.SETUPPASSIVE:
+		return local.Reverse()
+	}
+	return remote
+}
+
+// GetDTLSSetup get the local DTLS setup role
+func (t *Transport) GetDTLSSetup() sdp.Setup {
+	t.Lock()
+	defer t.Unlock()
+	return t.localDtls.GetSetup()
+}
+
+// SetDTLSSetup set the local DTLS setup role and restart the DTLS handshake with it
+// Use sdp.SETUPACTIVE to make the server the DTLS client, as required by some gateways
+func (t *Transport) SetDTLSSetup(setup sdp.Setup) error {
+
+	switch setup {
+	case sdp.SETUPACTIVE, sdp.SETUPPASSIVE, sdp.SETUPACTPASS:
+	default:
+		return ErrInvalidDTLSSetup
+	}
+
+	t.Lock()
+	t.localDtls.SetSetup(setup)
+	t.Unlock()
+
+	return t.RenegotiateDTLS()
+}
+
+// SetRemoteDTLS update the remote DTLS info, ie after a renegotiation, and restart the DTLS handshake
+func (t *Transport) SetRemoteDTLS(remoteDtls *sdp.DTLSInfo) error {
+
+	t.Lock()
+	t.remoteDtls = remoteDtls.Clone()
+	if t.localDtls.GetSetup() == sdp.SETUPACTPASS {
+		t.localDtls.SetSetup(remoteDtls.GetSetup().Reverse())
+	}
+	t.Unlock()
+
+	return t.RenegotiateDTLS()
+}
+
+// RenegotiateDTLS restart the DTLS handshake with the current remote DTLS info, which rekeys SRTP
+// OnDTLSRenegotiated listeners are called once the new handshake completes
+func (t *Transport) RenegotiateDTLS() error {
+
+	t.Lock()
+	setup := remoteSetupFor(t.localDtls.GetSetup(), t.remoteDtls.GetSetup())
+	hash := t.remoteDtls.GetHash()
+	fingerprint := t.remoteDtls.GetFingerprint()
+	t.Unlock()
+
+	if t.transport.SetRemoteCryptoDTLS(setup.String(), hash, fingerprint) == 0 {
+		return ErrDTLSRenegotiation
+	}
+	return nil
+}
+
+// OnDTLSRenegotiated register a listener called when a DTLS renegotiation or rekey completes
+func (t *Transport) OnDTLSRenegotiated(listener DTLSRenegotiatedListener) ListenerOff {
+	return t.onDTLSRenegotiatedListeners.on(listener)
+}
+
+func (t *Transport) onDTLSStateChange(state uint) {
+
+	name := dtlsStateName(state)
+
+	t.Lock()
+	t.dtlsState = name
+	renegotiated := name == "connected" && t.dtlsConnected
+	if name == "connected" {
+		t.dtlsConnected = true
+		t.dtlsError = nil
+	}
+	if name == "failed" {
+		if len(t.srtpProfiles) > 0 {
+			t.dtlsError = &SRTPNegotiationError{Profiles: t.srtpProfiles}
+		} else {
+			t.dtlsError = ErrDTLSFailed
+		}
+	}
+	listener := t.outDTLSStateListener
+	t.Unlock()
+
+	if listener != nil {
+		listener(name)
+	}
+
+	t.dtlsStateListeners.emit(func(listener interface{}) {
+		listener.(DTLSStateListener)(name)
+	})
+
+	if renegotiated {
+		t.onDTLSRenegotiatedListeners.emit(func(listener interface{}) {
+			listener.(DTLSRenegotiatedListener)()
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package mediaserver
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/rand"
+	"sync"
+
+	"github.com/notedit/media-server-go/packetizer"
+	"github.com/notedit/sdp"
+)
+
+const (
+	elementaryReaderQueue = 256
+	elementaryWriterMTU   = 1200
+)
+
+// ErrReaderClosed the elementary stream reader was closed
+var ErrReaderClosed = errors.New("reader closed")
+
+// TrackReader io.Reader of the elementary stream of an incoming track
+// Frames are dropped if the reader does not keep up
+type TrackReader struct {
+	multiplexer *MediaFrameMultiplexer
+	frames      chan []byte
+	pending     []byte
+	closed      chan struct{}
+	once        sync.Once
+}
+
+func newTrackReader(track *IncomingStreamTrack, header []byte, convert func(frame []byte, timestamp uint64) []byte) *TrackReader {
+
+	reader := &TrackReader{
+		frames: make(chan []byte, elementaryReaderQueue),
+		closed: make(chan struct{}),
+	}
+
+	if len(header) > 0 {
+		reader.frames <- header
+	}
+
+	reader.multiplexer = NewMediaFrameMultiplexer(track)
+	reader.multiplexer.SetMediaFrameListener(func(frame []byte, timestamp uint64) {
+		data := convert(frame, timestamp)
+		if data == nil {
+			return
+		}
+		select {
+		case reader.frames <- data:
+		default:
+		}
+	})
+
+	track.OnStop(func() {
+		reader.Close()
+	})
+
+	return reader
+}
+
+// NewH264Reader read the incoming h264 track as an Annex-B byte stream, one access unit per frame
+func NewH264Reader(track *IncomingStreamTrack) *TrackReader {
+	return newTrackReader(track, nil, func(frame []byte, timestamp uint64) []byte {
+		annexb, err := annexbConvert(frame)
+		if err != nil {
+			return nil
+		}
+		return annexb
+	})
+}
+
+// NewOpusReader read the incoming opus track as an Ogg Opus stream
+func NewOpusReader(track *IncomingStreamTrack) *TrackReader {
+
+	ogg := newOggOpusWriter()
+	header := ogg.header()
+
+	return newTrackReader(track, header, func(frame []byte, timestamp uint64) []byte {
+		return ogg.packet(frame, timestamp)
+	})
+}
+
+// Read read the elementary stream, returns io.EOF once closed
+func (r *TrackReader) Read(p []byte) (int, error) {
+
+	if len(r.pending) == 0 {
+		select {
+		case frame := <-r.frames:
+			r.pending = frame
+		case <-r.closed:
+			return 0, io.EOF
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// Close stop reading the track
+func (r *TrackReader) Close() error {
+	r.once.Do(func() {
+		r.multiplexer.Stop()
+		close(r.closed)
+	})
+	return nil
+}
+
+// oggOpusWriter minimal Ogg encapsulation of opus packets, RFC 7845
+type oggOpusWriter struct {
+	serial   uint32
+	sequence uint32
+	first    uint64
+	hasFirst bool
+	granule  uint64
+	crcTable [256]uint32
+}
+
+func newOggOpusWriter() *oggOpusWriter {
+
+	w := &oggOpusWriter{serial: rand.Uint32()}
+
+	// ogg crc32, polynomial 0x04c11db7 without reflection
+	for i := range w.crcTable {
+		r := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = (r << 1) ^ 0x04c11db7
+			} else {
+				r <<= 1
+			}
+		}
+		w.crcTable[i] = r
+	}
+	return w
+}
+
+func (w *oggOpusWriter) page(packet []byte, headerType byte, granule uint64) []byte {
+
+	segments := len(packet)/255 + 1
+
+	page := make([]byte, 27+segments+len(packet))
+	copy(page, "OggS")
+	page[5] = headerType
+	binary.LittleEndian.PutUint64(page[6:], granule)
+	binary.LittleEndian.PutUint32(page[14:], w.serial)
+	binary.LittleEndian.PutUint32(page[18:], w.sequence)
+	page[26] = byte(segments)
+
+	for i := 0; i < segments-1; i++ {
+		page[27+i] = 255
+	}
+	page[27+segments-1] = byte(len(packet) % 255)
+	copy(page[27+segments:], packet)
+
+	var crc uint32
+	for _, b := range page {
+		crc = (crc << 8) ^ w.crcTable[byte(crc>>24)^b]
+	}
+	binary.LittleEndian.PutUint32(page[22:], crc)
+
+	w.sequence++
+	return page
+}
+
+func (w *oggOpusWriter) header() []byte {
+
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8] = 1
+	head[9] = 2
+	binary.LittleEndian.PutUint32(head[12:], 48000)
+
+	vendor := "media-server-go"
+	tags := make([]byte, 8+4+len(vendor)+4)
+	copy(tags, "OpusTags")
+	binary.LittleEndian.PutUint32(tags[8:], uint32(len(vendor)))
+	copy(tags[12:], vendor)
+
+	return append(w.page(head, 0x02, 0), w.page(tags, 0, 0)...)
+}
+
+// packet wrap one opus packet in a page, the granule position is taken from the 48khz rtp timestamp
+func (w *oggOpusWriter) packet(packet []byte, timestamp uint64) []byte {
+
+	if !w.hasFirst {
+		w.first = timestamp
+		w.hasFirst = true
+	}
+
+	if timestamp >= w.first {
+		w.granule = timestamp - w.first
+	}
+
+	return w.page(packet, 0, w.granule)
+}
+
+// TrackWriter io.Writer feeding encoded frames to an incoming track, that can be attached to any OutgoingStreamTrack
+// Each Write must contain exactly one frame: an Annex-B access unit for h264 or one opus packet
+type TrackWriter struct {
+	session     *MediaFrameSession
+	packetizer  packetizer.Packetizer
+	payloadType byte
+	ssrc        uint32
+	sequence    uint16
+	timestamp   uint32
+	duration    uint32
+	sync.Mutex
+}
+
+func newTrackWriter(mediaType string, codec string, payloadType int, packetizer packetizer.Packetizer, duration uint32) *TrackWriter {
+
+	media := sdp.NewMediaInfo(mediaType, mediaType)
+	media.AddCodec(sdp.NewCodecInfo(codec, payloadType))
+
+	return &TrackWriter{
+		session:     NewMediaFrameSession(media),
+		packetizer:  packetizer,
+		payloadType: byte(payloadType),
+		ssrc:        uint32(NextSSRC()),
+		sequence:    uint16(rand.Uint32()),
+		timestamp:   rand.Uint32(),
+		duration:    duration,
+	}
+}
+
+// NewH264Writer create a writer of h264 Annex-B access units at a constant frame rate
+func NewH264Writer(fps int) (*TrackWriter, error) {
+	if fps <= 0 {
+		return nil, errors.New("invalid frame rate")
+	}
+	return newTrackWriter("video", "h264", 96, &packetizer.H264Packetier{}, uint32(90000/fps)), nil
+}
+
+// NewOpusWriter create a writer of opus packets of 20ms
+func NewOpusWriter() *TrackWriter {
+	return newTrackWriter("audio", "opus", 111, &packetizer.OpusPacketier{}, 960)
+}
+
+// GetIncomingStreamTrack get the track fed by this writer
+func (w *TrackWriter) GetIncomingStreamTrack() *IncomingStreamTrack {
+	return w.session.GetIncomingStreamTrack()
+}
+
+// Write send one frame
+func (w *TrackWriter) Write(frame []byte) (int, error) {
+	return w.writeFrame(frame, w.duration)
+}
+
+// writeFrame send one frame lasting duration in rtp clock units
+func (w *TrackWriter) writeFrame(frame []byte, duration uint32) (int, error) {
+
+	w.Lock()
+	defer w.Unlock()
+
+	if w.session.session == nil {
+		return 0, io.ErrClosedPipe
+	}
+
+	payloads := w.packetizer.Packetize(frame, elementaryWriterMTU)
+
+	for i, payload := range payloads {
+		packet := make([]byte, 12+len(payload))
+		packet[0] = 0x80
+		packet[1] = w.payloadType
+		if i == len(payloads)-1 {
+			packet[1] |= 0x80
+		}
+		binary.BigEndian.PutUint16(packet[2:], w.sequence)
+		binary.BigEndian.PutUint32(packet[4:], w.timestamp)
+		binary.BigEndian.PutUint32(packet[8:], w.ssrc)
+		copy(packet[12:], payload)
+
+		w.session.Push(packet)
+		w.sequence++
+	}
+
+	w.timestamp += duration
+
+	return len(frame), nil
+}
+
+// Close stop the writer and its track
+func (w *TrackWriter) Close() error {
+	w.Lock()
+	defer w.Unlock()
+	w.session.Stop()
+	return nil
+}
@@ -0,0 +1,106 @@
+package mediaserver
+
+import (
+	"log"
+	"runtime/debug"
+	"sync"
+)
+
+// ListenerOff remove the listener it was returned for, calling it again does nothing
+type ListenerOff func()
+
+// emitter the listeners of an event of a stream, track or transport.
+// The module supports go 1.13 so it is not generic: listeners are stored as interface{} and emit asserts their type.
+// A listener registered with once is removed before it is called, a panicking listener is logged and does not
+// prevent the next ones from being called
+type emitter struct {
+	l         sync.Mutex
+	next      uint64
+	listeners []emitterListener
+}
+
+type emitterListener struct {
+	id       uint64
+	listener interface{}
+	once     bool
+}
+
+// on register a listener called on each emit
+func (e *emitter) on(listener interface{}) ListenerOff {
+	return e.add(listener, false)
+}
+
+// once register a listener called on the next emit only
+func (e *emitter) once(listener interface{}) ListenerOff {
+	return e.add(listener, true)
+}
+
+func (e *emitter) add(listener interface{}, once bool) ListenerOff {
+
+	e.l.Lock()
+	e.next++
+	id := e.next
+	e.listeners = append(e.listeners, emitterListener{id: id, listener: listener, once: once})
+	e.l.Unlock()
+
+	return func() {
+		e.remove(id)
+	}
+}
+
+func (e *emitter) remove(id uint64) {
+
+	e.l.Lock()
+	defer e.l.Unlock()
+
+	for i, entry := range e.listeners {
+		if entry.id == id {
+			// a new slice, emit may be iterating the current one
+			listeners := make([]emitterListener, 0, len(e.listeners)-1)
+			listeners = append(listeners, e.listeners[:i]...)
+			e.listeners = append(listeners, e.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+// len get the number of listeners registered
+func (e *emitter) len() int {
+	e.l.Lock()
+	defer e.l.Unlock()
+	return len(e.listeners)
+}
+
+// emit call each listener with call in the order they were registered, listeners added meanwhile are called on the next emit
+func (e *emitter) emit(call func(listener interface{})) {
+
+	e.l.Lock()
+	listeners := e.listeners
+	for _, entry := range listeners {
+		if entry.once {
+			e.listeners = make([]emitterListener, 0, len(listeners))
+			for _, entry := range listeners {
+				if !entry.once {
+					e.listeners = append(e.listeners, entry)
+				}
+			}
+			break
+		}
+	}
+	e.l.Unlock()
+
+	for _, entry := range listeners {
+		callListener(call, entry.listener)
+	}
+}
+
+func callListener(call func(listener interface{}), listener interface{}) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("mediaserver: listener panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	call(listener)
+}
@@ -0,0 +1,80 @@
+package mediaserver
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+)
+
+func Test_Emitter(t *testing.T) {
+
+	var e emitter
+	calls := []string{}
+
+	call := func(listener interface{}) {
+		listener.(func())()
+	}
+
+	e.on(func() { calls = append(calls, "on") })
+	e.once(func() { calls = append(calls, "once") })
+	off := e.on(func() { calls = append(calls, "off") })
+
+	e.emit(call)
+	off()
+	off()
+	e.emit(call)
+
+	expected := []string{"on", "once", "off", "on"}
+	if len(calls) != len(expected) {
+		t.Fatal("unexpected calls", calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Fatal("unexpected calls", calls)
+		}
+	}
+	if e.len() != 1 {
+		t.Error("expected one listener left", e.len())
+	}
+}
+
+func Test_EmitterRemoveWhileEmitting(t *testing.T) {
+
+	var e emitter
+	var second ListenerOff
+	calls := 0
+
+	e.on(func() {
+		second()
+		e.on(func() { calls += 10 })
+	})
+	second = e.on(func() { calls++ })
+
+	// the listeners are the ones registered when emit was called
+	e.emit(func(listener interface{}) {
+		listener.(func())()
+	})
+	if calls != 1 || e.len() != 2 {
+		t.Error("unexpected listeners", calls, e.len())
+	}
+}
+
+func Test_EmitterPanic(t *testing.T) {
+
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	var e emitter
+	called := false
+
+	e.on(func() { panic("listener") })
+	e.on(func() { called = true })
+
+	e.emit(func(listener interface{}) {
+		listener.(func())()
+	})
+	if !called {
+		t.Error("expected the listener after the panicking one called")
+	}
+}
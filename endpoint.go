@@ -1,6 +1,8 @@
 package mediaserver
 
 import (
+	"errors"
+	"math/rand"
 	"sync"
 
 	native "github.com/notedit/media-server-go/wrapper"
@@ -9,14 +11,24 @@ import (
 
 // Endpoint is an endpoint represent an UDP server socket.
 // The endpoint will process STUN requests in order to be able to associate the remote ip:port with the registered Transport and forward any further data comming from that Transport.
-// Being a server it is ICE-lite.
+// Being a server it is ICE-lite by default, see CreateTransportWithICEMode.
+// All the transports of an endpoint share its single UDP port, packets are demuxed by ICE username and then remote address,
+// so one endpoint created with NewEndpointWithPort is enough behind a load balancer forwarding a single port.
 type Endpoint struct {
 	ip              string
 	bundle          native.RTPBundleTransport
 	candidate       *sdp.CandidateInfo
+	relay           *sdp.CandidateInfo
+	mdns            *MDNSResolver
 	mirroredStreams map[string]*IncomingStream
 	mirroredTracks  map[string]*IncomingStreamTrack
-	fingerprint     string
+	srtpProfiles    []string
+	isolated        bool
+	minPort         int
+	maxPort         int
+	dscp            int
+	iface           string
+	transports      map[*Transport]bool
 	sync.Mutex
 }
 
@@ -25,7 +37,6 @@ func NewEndpoint(ip string) *Endpoint {
 	endpoint := &Endpoint{}
 	endpoint.bundle = native.NewRTPBundleTransport()
 	endpoint.bundle.Init()
-	endpoint.fingerprint = native.MediaServerGetFingerprint()
 	endpoint.mirroredStreams = make(map[string]*IncomingStream)
 	endpoint.mirroredTracks = make(map[string]*IncomingStreamTrack)
 	endpoint.candidate = sdp.NewCandidateInfo("1", 1, "UDP", 33554431, ip, endpoint.bundle.GetLocalPort(), "host", "", 0)
@@ -37,11 +48,56 @@ func NewEndpointWithPort(ip string, port int) *Endpoint {
 	endpoint := &Endpoint{}
 	endpoint.bundle = native.NewRTPBundleTransport()
 	endpoint.bundle.Init(port)
-	endpoint.fingerprint = native.MediaServerGetFingerprint()
+	endpoint.mirroredStreams = make(map[string]*IncomingStream)
+	endpoint.mirroredTracks = make(map[string]*IncomingStreamTrack)
 	endpoint.candidate = sdp.NewCandidateInfo("1", 1, "UDP", 33554431, ip, endpoint.bundle.GetLocalPort(), "host", "", 0)
 	return endpoint
 }
 
+// NewEndpointWithPortRange create a new endpoint bound to a free port between minPort and maxPort, both included,
+// so the firewall only needs to open that range. The port is kept in the range if a Watchdog restarts the endpoint
+func NewEndpointWithPortRange(ip string, minPort, maxPort int) (*Endpoint, error) {
+
+	if minPort <= 0 || maxPort > 65535 || minPort > maxPort {
+		return nil, errors.New("invalid port range")
+	}
+
+	bundle := initBundleInRange(minPort, maxPort)
+	if bundle == nil {
+		return nil, errors.New("no free port in range")
+	}
+
+	endpoint := &Endpoint{}
+	endpoint.bundle = bundle
+	endpoint.minPort = minPort
+	endpoint.maxPort = maxPort
+	endpoint.mirroredStreams = make(map[string]*IncomingStream)
+	endpoint.mirroredTracks = make(map[string]*IncomingStreamTrack)
+	endpoint.candidate = sdp.NewCandidateInfo("1", 1, "UDP", 33554431, ip, bundle.GetLocalPort(), "host", "", 0)
+	return endpoint, nil
+}
+
+// initBundleInRange try the ports of the range from a random one, so endpoints created together do not race for the same port
+func initBundleInRange(minPort, maxPort int) native.RTPBundleTransport {
+
+	size := maxPort - minPort + 1
+	start := rand.Intn(size)
+
+	for i := 0; i < size; i++ {
+		bundle := native.NewRTPBundleTransport()
+		if bundle.Init(minPort+(start+i)%size) != 0 {
+			return bundle
+		}
+		native.DeleteRTPBundleTransport(bundle)
+	}
+	return nil
+}
+
+// GetPortRange get the port range of the endpoint, zero if it was not created with NewEndpointWithPortRange
+func (e *Endpoint) GetPortRange() (int, int) {
+	return e.minPort, e.maxPort
+}
+
 //SetAffinity Set cpu affinity
 func (e *Endpoint) SetAffinity(cpu int) {
 	e.bundle.SetAffinity(cpu)
@@ -51,54 +107,115 @@ func (e *Endpoint) SetAffinity(cpu int) {
 // disableSTUNKeepAlive - Disable ICE/STUN keep alives, required for server to server transports, set this to false if you do not how to use it
 func (e *Endpoint) CreateTransport(remoteSdp *sdp.SDPInfo, localSdp *sdp.SDPInfo, options ...bool) *Transport {
 
+	disableSTUNKeepAlive := false
+
+	if len(options) > 0 {
+		disableSTUNKeepAlive = options[0]
+	}
+
+	return e.createTransport(remoteSdp, localSdp, ICELite, disableSTUNKeepAlive)
+}
+
+// CreateTransportWithICEMode create a new Transport object using the given ICE mode
+// In ICEFull mode the local ICE info is not advertised as lite and STUN connectivity checks are sent to the remote candidates,
+// so it can peer with other ICE-lite servers. Remote candidates must be known, either in the remote sdp or using Transport.AddRemoteCandidate.
+// The native ICE agent has no role setting, so the controlling role (ICE-CONTROLLING and USE-CANDIDATE nomination) needed to
+// cascade with full ICE agents can not be selected
+func (e *Endpoint) CreateTransportWithICEMode(remoteSdp *sdp.SDPInfo, localSdp *sdp.SDPInfo, mode ICEMode) *Transport {
+	return e.createTransport(remoteSdp, localSdp, mode, false)
+}
+
+func (e *Endpoint) createTransport(remoteSdp *sdp.SDPInfo, localSdp *sdp.SDPInfo, mode ICEMode, disableSTUNKeepAlive bool) *Transport {
+
 	var localIce *sdp.ICEInfo
 	var localDtls *sdp.DTLSInfo
 	var localCandidates []*sdp.CandidateInfo
 
+	// SDES keyed peers do not send any DTLS info
+	remoteDtls := sdp.NewDTLSInfo(sdp.SETUPINACTIVE, "", "")
+	if remoteSdp.GetDTLS() != nil {
+		remoteDtls = remoteSdp.GetDTLS().Clone()
+	}
+
 	if localSdp == nil {
 		localIce = sdp.ICEInfoGenerate(true)
-		localDtls = sdp.NewDTLSInfo(remoteSdp.GetDTLS().GetSetup().Reverse(), "sha-256", e.fingerprint)
-		localCandidates = []*sdp.CandidateInfo{e.candidate}
+		localDtls = sdp.NewDTLSInfo(remoteDtls.GetSetup().Reverse(), "sha-256", e.GetDTLSFingerprint())
+		localCandidates = e.GetLocalCandidates()
 	} else {
 		localIce = localSdp.GetICE().Clone()
-		localDtls = localSdp.GetDTLS().Clone()
+		localDtls = sdp.NewDTLSInfo(remoteDtls.GetSetup().Reverse(), "sha-256", e.GetDTLSFingerprint())
+		if localSdp.GetDTLS() != nil {
+			localDtls = localSdp.GetDTLS().Clone()
+		}
 		localCandidates = localSdp.GetCandidates()
 	}
 
 	remoteIce := remoteSdp.GetICE().Clone()
-	remoteDtls := remoteSdp.GetDTLS().Clone()
-	remoteCandidates := remoteSdp.GetCandidates()
-
-	localIce.SetLite(true)
-	localIce.SetEndOfCandidate(true)
+	remoteCandidates := DefaultOfferLimits.SanitizeCandidates(remoteSdp.GetCandidates())
 
-	disableSTUNKeepAlive := false
-
-	if len(options) > 0 {
-		disableSTUNKeepAlive = options[0]
+	if max := DefaultOfferLimits.MaxCandidates; max > 0 && len(remoteCandidates) > max {
+		remoteCandidates = remoteCandidates[:max]
 	}
 
+	localIce.SetLite(mode == ICELite)
+	localIce.SetEndOfCandidate(true)
+
 	transport := NewTransport(e.bundle, remoteIce, remoteDtls, remoteCandidates,
 		localIce, localDtls, localCandidates, disableSTUNKeepAlive)
 
+	transport.iceMode = mode
+	transport.setSRTPProtectionProfiles(e.GetSRTPProtectionProfiles())
+
+	e.Lock()
+	resolver := e.mdns
+	if e.transports == nil {
+		e.transports = make(map[*Transport]bool)
+	}
+	e.transports[transport] = true
+	transport.endpoint = e
+	e.Unlock()
+	if resolver != nil {
+		transport.SetMDNSResolver(resolver)
+	}
+
 	return transport
 }
 
 // GetLocalCandidates Get local ICE candidates for this endpoint. It will be shared by all the Transport associated to this endpoint.
+// The relay candidate, if any, is advertised after the host one
 func (e *Endpoint) GetLocalCandidates() []*sdp.CandidateInfo {
+	if e.relay != nil {
+		return []*sdp.CandidateInfo{e.candidate, e.relay}
+	}
 	return []*sdp.CandidateInfo{e.candidate}
 }
 
+// SetRelayCandidate advertise a relayed address forwarding to the endpoint port, ie a TURN allocation or a static port forward,
+// for clients that can not reach the host candidate. Its priority is lower so the host candidate is preferred when reachable.
+// Transports created before are not updated, an empty ip removes it
+func (e *Endpoint) SetRelayCandidate(ip string, port int) {
+
+	e.Lock()
+	defer e.Unlock()
+
+	if ip == "" {
+		e.relay = nil
+		return
+	}
+	e.relay = sdp.NewCandidateInfo("2", 1, "UDP", 16777215, ip, port, "relay", e.candidate.GetAddress(), e.candidate.GetPort())
+}
+
 // GetDTLSFingerprint Get local DTLS fingerprint for this endpoint. It will be shared by all the Transport associated to this endpoint
+// It is the one of the current certificate, see SetCertificate
 func (e *Endpoint) GetDTLSFingerprint() string {
-	return e.fingerprint
+	return GetFingerprint()
 }
 
 // CreateOffer  create offer based on audio and video capability
 // It generates a random ICE username and password and gets endpoint fingerprint
 func (e *Endpoint) CreateOffer(video *sdp.Capability, audio *sdp.Capability) *sdp.SDPInfo {
 
-	dtls := sdp.NewDTLSInfo(sdp.SETUPACTPASS, "sha-256", e.fingerprint)
+	dtls := sdp.NewDTLSInfo(sdp.SETUPACTPASS, "sha-256", e.GetDTLSFingerprint())
 
 	ice := sdp.GenerateICEInfo(true)
 
@@ -117,6 +234,108 @@ func (e *Endpoint) CreateOffer(video *sdp.Capability, audio *sdp.Capability) *sd
 	return sdp.Create(ice, dtls, candidates, capabilities)
 }
 
+// IsIsolated check if the endpoint was stopped by a Watchdog because its loop stalled
+func (e *Endpoint) IsIsolated() bool {
+	e.Lock()
+	defer e.Unlock()
+	return e.isolated
+}
+
+// unregisterTransport forget a stopped transport
+func (e *Endpoint) unregisterTransport(transport *Transport) {
+	e.Lock()
+	delete(e.transports, transport)
+	e.Unlock()
+}
+
+// takeTransports get the transports of the current bundle and forget them, called locked
+func (e *Endpoint) takeTransports() []*Transport {
+	transports := make([]*Transport, 0, len(e.transports))
+	for transport := range e.transports {
+		transports = append(transports, transport)
+	}
+	e.transports = nil
+	return transports
+}
+
+// releaseBundle stop the transports of the bundle and then end it, in the background as a stalled loop may never be joined.
+// The transports use the native objects owned by the bundle, so it is only deleted once they are all stopped
+func releaseBundle(bundle native.RTPBundleTransport, transports []*Transport, done func()) {
+	go func() {
+		for _, transport := range transports {
+			transport.Stop()
+		}
+		bundle.End()
+		native.DeleteRTPBundleTransport(bundle)
+		if done != nil {
+			done()
+		}
+	}()
+}
+
+func (e *Endpoint) isolate(done func()) {
+
+	e.Lock()
+	defer e.Unlock()
+
+	if e.bundle == nil {
+		return
+	}
+
+	releaseBundle(e.bundle, e.takeTransports(), done)
+	e.bundle = nil
+	e.isolated = true
+}
+
+// restart replace the bundle with a new one, the local candidate changes to the new port.
+// The error is the first one of the socket options applied again, the endpoint is restarted anyway
+func (e *Endpoint) restart() (bool, error) {
+
+	e.Lock()
+	defer e.Unlock()
+
+	if e.bundle == nil {
+		return false, nil
+	}
+
+	var bundle native.RTPBundleTransport
+	if e.maxPort > 0 {
+		bundle = initBundleInRange(e.minPort, e.maxPort)
+	} else {
+		bundle = native.NewRTPBundleTransport()
+		if bundle.Init() == 0 {
+			native.DeleteRTPBundleTransport(bundle)
+			bundle = nil
+		}
+	}
+	if bundle == nil {
+		return false, nil
+	}
+
+	return true, e.replaceBundle(bundle, nil)
+}
+
+// replaceBundle release the current bundle with its transports and use the new one, called locked
+func (e *Endpoint) replaceBundle(bundle native.RTPBundleTransport, done func()) error {
+
+	releaseBundle(e.bundle, e.takeTransports(), done)
+	e.bundle = bundle
+	e.candidate = sdp.NewCandidateInfo("1", 1, "UDP", 33554431, e.candidate.GetAddress(), bundle.GetLocalPort(), "host", "", 0)
+	if e.relay != nil {
+		e.relay = sdp.NewCandidateInfo("2", 1, "UDP", 16777215, e.relay.GetAddress(), e.relay.GetPort(), "relay", e.candidate.GetAddress(), e.candidate.GetPort())
+	}
+	var err error
+	if e.dscp > 0 {
+		err = setSocketTOS(e.candidate.GetAddress(), bundle.GetLocalPort(), e.dscp<<2)
+	}
+	if e.iface != "" {
+		if bindErr := bindSocketToDevice(e.candidate.GetAddress(), bundle.GetLocalPort(), e.iface); err == nil {
+			err = bindErr
+		}
+	}
+	return err
+}
+
 // Stop stop the endpoint UDP server and terminate any associated Transport
 func (e *Endpoint) Stop() {
 
@@ -124,6 +343,14 @@ func (e *Endpoint) Stop() {
 		return
 	}
 
+	e.Lock()
+	transports := e.takeTransports()
+	e.Unlock()
+
+	for _, transport := range transports {
+		transport.Stop()
+	}
+
 	e.bundle.End()
 
 	native.DeleteRTPBundleTransport(e.bundle)
@@ -0,0 +1,56 @@
+package mediaserver
+
+import (
+	"sync"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// Feature optional capability of the native build
+type Feature string
+
+// Optional features
+const (
+	FeatureAV1     Feature = "av1"
+	FeatureFlexFEC Feature = "flexfec"
+	FeatureSCTP    Feature = "sctp"
+	FeatureNVENC   Feature = "nvenc"
+	FeatureSRT     Feature = "srt"
+)
+
+var (
+	features     map[Feature]bool
+	featuresOnce sync.Once
+)
+
+// SupportsCodec check if the linked native build knows the rtp codec, ie "vp9" or "av1"
+func SupportsCodec(codec string) bool {
+	return native.MediaServerSupportsCodec(codec)
+}
+
+// Features report which optional capabilities the linked native build supports,
+// so the application can adapt the offers instead of failing at negotiation time.
+// Datachannels, hardware encoding and SRT are not exposed by this binding and always reported as unsupported
+func Features() map[Feature]bool {
+
+	featuresOnce.Do(func() {
+		features = map[Feature]bool{
+			FeatureAV1:     SupportsCodec("AV1"),
+			FeatureFlexFEC: SupportsCodec("flexfec-03"),
+			FeatureSCTP:    false,
+			FeatureNVENC:   false,
+			FeatureSRT:     false,
+		}
+	})
+
+	supported := make(map[Feature]bool, len(features))
+	for feature, ok := range features {
+		supported[feature] = ok
+	}
+	return supported
+}
+
+// HasFeature check if the linked native build supports the feature
+func HasFeature(feature Feature) bool {
+	return Features()[feature]
+}
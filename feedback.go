@@ -0,0 +1,66 @@
+package mediaserver
+
+// FeedbackStats RTCP feedback counters, used to diagnose retransmission storms and misbehaving clients
+// PLIs and NACKs are sent by us for the incoming tracks, PLIs and REMBs are received from the remote peer for the outgoing tracks
+// FIRs are counted as PLIs, NACKs received are handled natively and not counted
+type FeedbackStats struct {
+	PLIsSent      uint
+	NACKsSent     uint
+	PLIsReceived  uint
+	REMBsReceived uint
+	// Remb last REMB bitrate received
+	Remb uint
+}
+
+func (f *FeedbackStats) add(other *FeedbackStats) {
+	f.PLIsSent += other.PLIsSent
+	f.NACKsSent += other.NACKsSent
+	f.PLIsReceived += other.PLIsReceived
+	f.REMBsReceived += other.REMBsReceived
+	if other.Remb > 0 {
+		f.Remb = other.Remb
+	}
+}
+
+// GetFeedbackStats get the RTCP feedback sent for all the encodings of the track
+func (i *IncomingStreamTrack) GetFeedbackStats() *FeedbackStats {
+
+	feedback := &FeedbackStats{}
+
+	for _, stats := range i.GetStats() {
+		feedback.PLIsSent += stats.Media.TotalPLIs
+		feedback.NACKsSent += stats.Media.TotalNACKs
+	}
+	return feedback
+}
+
+// GetFeedbackStats get the RTCP feedback received for the track since it was created
+func (o *OutgoingStreamTrack) GetFeedbackStats() *FeedbackStats {
+
+	feedback := &FeedbackStats{}
+	feedback.add(&o.feedback)
+
+	if o.transpoder != nil {
+		feedback.add(o.transpoder.getFeedbackStats())
+	}
+	return feedback
+}
+
+// GetFeedbackStats get the RTCP feedback sent and received by all the tracks of the transport
+func (t *Transport) GetFeedbackStats() *FeedbackStats {
+
+	feedback := &FeedbackStats{}
+
+	for _, stream := range t.GetIncomingStreams() {
+		for _, track := range stream.GetTracks() {
+			feedback.add(track.GetFeedbackStats())
+		}
+	}
+
+	for _, stream := range t.GetOutgoingStreams() {
+		for _, track := range stream.GetTracks() {
+			feedback.add(track.GetFeedbackStats())
+		}
+	}
+	return feedback
+}
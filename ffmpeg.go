@@ -0,0 +1,328 @@
+package mediaserver
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/notedit/sdp"
+)
+
+// FFmpegConfig configuration of a supervised ffmpeg process reading the media sent by the server
+type FFmpegConfig struct {
+	// Path of the ffmpeg binary, "ffmpeg" if empty
+	Path string
+	// Medias to send to ffmpeg, one rtp session is created for each of them
+	Medias []*sdp.MediaInfo
+	// InputArgs replace the generated sdp input, for processes producing media from another source
+	InputArgs []string
+	// OutputArgs ffmpeg arguments placed after the generated input, ie []string{"-c", "copy", "-f", "flv", "rtmp://..."}
+	OutputArgs []string
+	// Restart ffmpeg when it exits until Stop is called
+	Restart bool
+	// RestartDelay wait before restarting, 1s if zero
+	RestartDelay time.Duration
+}
+
+// FFmpegExitListener called each time the ffmpeg process exits
+type FFmpegExitListener func(err error)
+
+// FFmpegProcess launch and supervise an ffmpeg process fed with rtp sessions on the loopback interface
+type FFmpegProcess struct {
+	config   FFmpegConfig
+	sessions map[string]*StreamerSession
+	ports    map[string]int
+	sdpFile  string
+	cmd      *exec.Cmd
+	stopped  bool
+	done     chan struct{}
+	onExit   []FFmpegExitListener
+	sync.Mutex
+}
+
+// clock rates of the codecs ffmpeg can not guess from the payload type
+var ffmpegClockRates = map[string]string{
+	"opus": "48000/2",
+	"pcmu": "8000",
+	"pcma": "8000",
+	"g722": "8000",
+	"vp8":  "90000",
+	"vp9":  "90000",
+	"h264": "90000",
+	"av1":  "90000",
+}
+
+// freeUDPPort get a free local udp port for ffmpeg to listen on
+func freeUDPPort() (int, error) {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).Port, nil
+}
+
+// GenerateFFmpegSDP generate the sdp describing the rtp sent to ffmpeg on the loopback interface
+// ports maps the media type to the port ffmpeg listens on
+func GenerateFFmpegSDP(medias []*sdp.MediaInfo, ports map[string]int) (string, error) {
+
+	var b strings.Builder
+	b.WriteString("v=0\r\no=- 0 0 IN IP4 127.0.0.1\r\ns=media-server-go\r\nc=IN IP4 127.0.0.1\r\nt=0 0\r\n")
+
+	for _, media := range medias {
+
+		mediaType := strings.ToLower(media.GetType())
+		codecs := media.GetCodecs()
+
+		types := []int{}
+		for pt, codec := range codecs {
+			if isMediaCodec(codec.GetCodec()) {
+				types = append(types, pt)
+			}
+		}
+		if len(types) == 0 {
+			return "", fmt.Errorf("no codec for %s media", mediaType)
+		}
+		sort.Ints(types)
+
+		fmt.Fprintf(&b, "m=%s %d RTP/AVP", mediaType, ports[mediaType])
+		for _, pt := range types {
+			fmt.Fprintf(&b, " %d", pt)
+		}
+		b.WriteString("\r\n")
+
+		for _, pt := range types {
+			codec := codecs[pt]
+			name := strings.ToLower(codec.GetCodec())
+			rate, ok := ffmpegClockRates[name]
+			if !ok {
+				return "", fmt.Errorf("unknown clock rate for codec %s", codec.GetCodec())
+			}
+			fmt.Fprintf(&b, "a=rtpmap:%d %s/%s\r\n", pt, codec.GetCodec(), rate)
+
+			params := []string{}
+			for key, value := range codec.GetParams() {
+				params = append(params, key+"="+value)
+			}
+			if len(params) > 0 {
+				sort.Strings(params)
+				fmt.Fprintf(&b, "a=fmtp:%d %s\r\n", pt, strings.Join(params, ";"))
+			}
+		}
+	}
+
+	return b.String(), nil
+}
+
+// NewFFmpegProcess create the rtp sessions and the sdp file, call Start to launch ffmpeg
+func NewFFmpegProcess(config FFmpegConfig) (*FFmpegProcess, error) {
+
+	if len(config.Medias) == 0 {
+		return nil, errors.New("ffmpeg process needs at least one media")
+	}
+
+	if config.Path == "" {
+		config.Path = "ffmpeg"
+	}
+
+	if config.RestartDelay == 0 {
+		config.RestartDelay = time.Second
+	}
+
+	process := &FFmpegProcess{
+		config:   config,
+		sessions: make(map[string]*StreamerSession),
+		ports:    make(map[string]int),
+	}
+
+	for _, media := range config.Medias {
+		mediaType := strings.ToLower(media.GetType())
+		if _, ok := process.sessions[mediaType]; ok {
+			process.Stop()
+			return nil, fmt.Errorf("duplicated %s media in ffmpeg process", mediaType)
+		}
+		port, err := freeUDPPort()
+		if err != nil {
+			process.Stop()
+			return nil, err
+		}
+		session := NewStreamerSession(media)
+		session.SetRemotePort("127.0.0.1", port)
+		process.sessions[mediaType] = session
+		process.ports[mediaType] = port
+	}
+
+	description, err := GenerateFFmpegSDP(config.Medias, process.ports)
+	if err != nil {
+		process.Stop()
+		return nil, err
+	}
+
+	file, err := ioutil.TempFile("", "media-server-go-*.sdp")
+	if err != nil {
+		process.Stop()
+		return nil, err
+	}
+	process.sdpFile = file.Name()
+
+	_, err = file.WriteString(description)
+	file.Close()
+	if err != nil {
+		process.Stop()
+		return nil, err
+	}
+
+	return process, nil
+}
+
+// GetSDPFile get the path of the generated sdp file
+func (f *FFmpegProcess) GetSDPFile() string {
+	return f.sdpFile
+}
+
+// GetOutgoingStreamTrack get the track sending the media to ffmpeg, attach it to the track to process
+func (f *FFmpegProcess) GetOutgoingStreamTrack(media string) *OutgoingStreamTrack {
+	if session, ok := f.sessions[strings.ToLower(media)]; ok {
+		return session.GetOutgoingStreamTrack()
+	}
+	return nil
+}
+
+// GetIncomingStreamTrack get the track receiving rtp on the session local port, when ffmpeg output is sent back to it
+func (f *FFmpegProcess) GetIncomingStreamTrack(media string) *IncomingStreamTrack {
+	if session, ok := f.sessions[strings.ToLower(media)]; ok {
+		return session.GetIncomingStreamTrack()
+	}
+	return nil
+}
+
+// GetLocalPort get the session local port of the media, to use as rtp output of ffmpeg
+func (f *FFmpegProcess) GetLocalPort(media string) int {
+	if session, ok := f.sessions[strings.ToLower(media)]; ok {
+		return session.GetLocalPort()
+	}
+	return 0
+}
+
+// OnExit register a listener called each time the ffmpeg process exits
+func (f *FFmpegProcess) OnExit(listener FFmpegExitListener) {
+	f.Lock()
+	defer f.Unlock()
+	f.onExit = append(f.onExit, listener)
+}
+
+// Start launch ffmpeg and supervise it
+func (f *FFmpegProcess) Start() error {
+
+	f.Lock()
+	defer f.Unlock()
+
+	if f.stopped {
+		return errors.New("ffmpeg process stopped")
+	}
+
+	if f.done != nil {
+		return errors.New("ffmpeg process already started")
+	}
+
+	if err := f.launch(); err != nil {
+		return err
+	}
+
+	f.done = make(chan struct{})
+	go f.supervise(f.cmd, f.done)
+
+	return nil
+}
+
+// launch must be called with the lock held
+func (f *FFmpegProcess) launch() error {
+
+	args := []string{"-hide_banner", "-protocol_whitelist", "file,udp,rtp", "-i", f.sdpFile}
+	if len(f.config.InputArgs) > 0 {
+		args = append([]string{"-hide_banner"}, f.config.InputArgs...)
+	}
+	args = append(args, f.config.OutputArgs...)
+
+	cmd := exec.Command(f.config.Path, args...)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	f.cmd = cmd
+	return nil
+}
+
+func (f *FFmpegProcess) supervise(cmd *exec.Cmd, done chan struct{}) {
+
+	defer close(done)
+
+	for {
+		err := cmd.Wait()
+
+		f.Lock()
+		listeners := f.onExit
+		f.Unlock()
+
+		for _, listener := range listeners {
+			listener(err)
+		}
+
+		if !f.config.Restart {
+			return
+		}
+
+		time.Sleep(f.config.RestartDelay)
+
+		f.Lock()
+		if f.stopped {
+			f.Unlock()
+			return
+		}
+		if err := f.launch(); err != nil {
+			f.Unlock()
+			for _, listener := range listeners {
+				listener(err)
+			}
+			return
+		}
+		cmd = f.cmd
+		f.Unlock()
+	}
+}
+
+// Stop kill ffmpeg, stop the rtp sessions and remove the sdp file
+func (f *FFmpegProcess) Stop() {
+
+	f.Lock()
+	if f.stopped {
+		f.Unlock()
+		return
+	}
+	f.stopped = true
+	cmd := f.cmd
+	done := f.done
+	f.Unlock()
+
+	if cmd != nil && cmd.Process != nil {
+		cmd.Process.Kill()
+	}
+
+	if done != nil {
+		<-done
+	}
+
+	for _, session := range f.sessions {
+		session.Stop()
+	}
+
+	if f.sdpFile != "" {
+		os.Remove(f.sdpFile)
+	}
+}
@@ -0,0 +1,82 @@
+package mediaserver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/notedit/sdp"
+)
+
+func Test_GenerateFFmpegSDP(t *testing.T) {
+
+	audio := sdp.NewMediaInfo("audio", "audio")
+	opus := sdp.NewCodecInfo("opus", 111)
+	opus.AddParam("useinbandfec", "1")
+	audio.AddCodec(opus)
+
+	video := sdp.NewMediaInfo("video", "video")
+	video.AddCodec(sdp.NewCodecInfo("VP8", 96))
+	video.AddCodec(sdp.NewCodecInfo("rtx", 97))
+
+	description, err := GenerateFFmpegSDP([]*sdp.MediaInfo{audio, video}, map[string]int{"audio": 5000, "video": 5002})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{
+		"m=audio 5000 RTP/AVP 111\r\n",
+		"a=rtpmap:111 opus/48000/2\r\n",
+		"a=fmtp:111 useinbandfec=1\r\n",
+		"m=video 5002 RTP/AVP 96\r\n",
+		"a=rtpmap:96 VP8/90000\r\n",
+	} {
+		if !strings.Contains(description, line) {
+			t.Errorf("missing %q in\n%s", line, description)
+		}
+	}
+
+	video.AddCodec(sdp.NewCodecInfo("unknown", 98))
+	if _, err := GenerateFFmpegSDP([]*sdp.MediaInfo{video}, map[string]int{"video": 5002}); err == nil {
+		t.Error("expected unknown clock rate error")
+	}
+}
+
+func Test_GenerateConcatList(t *testing.T) {
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	segments := []*RecordingSegment{
+		{Filename: "/archive/a.mp4", Start: start, End: start.Add(time.Hour)},
+		{Filename: "/archive/it's.mp4", Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)},
+	}
+
+	list := GenerateConcatList(segments, start.Add(30*time.Minute), start.Add(90*time.Minute))
+
+	expected := "ffconcat version 1.0\n" +
+		"file '/archive/a.mp4'\n" +
+		"inpoint 1800.000\n" +
+		"file '/archive/it'\\''s.mp4'\n" +
+		"outpoint 1800.000\n"
+
+	if list != expected {
+		t.Error("unexpected concat list", list)
+	}
+}
+
+func Test_FormatCountdown(t *testing.T) {
+
+	cases := map[time.Duration]string{
+		-time.Second:                            "00:00",
+		0:                                       "00:00",
+		1500 * time.Millisecond:                 "00:02",
+		59 * time.Minute:                        "59:00",
+		time.Hour + 2*time.Minute + time.Second: "01:02:01",
+	}
+
+	for left, expected := range cases {
+		if text := FormatCountdown(left); text != expected {
+			t.Error("unexpected countdown", left, text, expected)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package mediaserver
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// FrameOverrun is emitted when a media frame listener exceeds its latency budget
+type FrameOverrun struct {
+	// Timestamp rtp timestamp of the frame being handled
+	Timestamp uint64
+	Budget    time.Duration
+	// Skipped frames not handed to the listener since the previous overrun because it was still busy
+	Skipped uint64
+}
+
+// FrameOverrunListener listener of the latency budget overruns
+type FrameOverrunListener func(*FrameOverrun)
+
+type frameJob struct {
+	buffer    []byte
+	timestamp uint64
+	done      chan struct{}
+}
+
+// frameBudget run the media frame listener in its own goroutine and stop waiting for it after the budget,
+// frames arriving while it is still busy are not handed to it so the media path never waits
+type frameBudget struct {
+	budget    time.Duration
+	listener  func([]byte, uint64)
+	onOverrun FrameOverrunListener
+	jobs      chan *frameJob
+	busy      int32
+	skipped   uint64
+	overruns  uint64
+	stopped   bool
+	sync.Mutex
+}
+
+func newFrameBudget(budget time.Duration, listener func([]byte, uint64), onOverrun FrameOverrunListener) *frameBudget {
+
+	b := &frameBudget{
+		budget:    budget,
+		listener:  listener,
+		onOverrun: onOverrun,
+		jobs:      make(chan *frameJob, 1),
+	}
+
+	go b.work()
+
+	return b
+}
+
+func (b *frameBudget) work() {
+	for job := range b.jobs {
+		b.listener(job.buffer, job.timestamp)
+		atomic.StoreInt32(&b.busy, 0)
+		close(job.done)
+	}
+}
+
+// handle give the frame to the listener and wait for it at most the budget
+func (b *frameBudget) handle(buffer []byte, timestamp uint64) {
+
+	if !atomic.CompareAndSwapInt32(&b.busy, 0, 1) {
+		atomic.AddUint64(&b.skipped, 1)
+		return
+	}
+
+	job := &frameJob{buffer: buffer, timestamp: timestamp, done: make(chan struct{})}
+
+	b.Lock()
+	if b.stopped {
+		b.Unlock()
+		return
+	}
+	b.jobs <- job
+	b.Unlock()
+
+	timer := time.NewTimer(b.budget)
+	defer timer.Stop()
+
+	select {
+	case <-job.done:
+	case <-timer.C:
+		atomic.AddUint64(&b.overruns, 1)
+		if b.onOverrun != nil {
+			b.onOverrun(&FrameOverrun{
+				Timestamp: timestamp,
+				Budget:    b.budget,
+				Skipped:   atomic.SwapUint64(&b.skipped, 0),
+			})
+		}
+	}
+}
+
+func (b *frameBudget) getOverruns() uint64 {
+	return atomic.LoadUint64(&b.overruns)
+}
+
+func (b *frameBudget) stop() {
+	b.Lock()
+	defer b.Unlock()
+	if b.stopped {
+		return
+	}
+	b.stopped = true
+	close(b.jobs)
+}
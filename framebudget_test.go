@@ -0,0 +1,98 @@
+package mediaserver
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_FrameBudget(t *testing.T) {
+
+	release := make(chan struct{})
+	handled := make(chan uint64, 10)
+	overruns := make(chan *FrameOverrun, 10)
+
+	budget := newFrameBudget(10*time.Millisecond, func(buffer []byte, timestamp uint64) {
+		if timestamp == 2 {
+			<-release
+		}
+		handled <- timestamp
+	}, func(overrun *FrameOverrun) {
+		overruns <- overrun
+	})
+	defer budget.stop()
+
+	budget.handle(nil, 1)
+	if timestamp := <-handled; timestamp != 1 {
+		t.Error("expected the first frame handled", timestamp)
+	}
+
+	// The slow frame overruns and the next ones are skipped while it is busy
+	start := time.Now()
+	budget.handle(nil, 2)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Error("expected handle to return after the budget", elapsed)
+	}
+	budget.handle(nil, 3)
+	budget.handle(nil, 4)
+
+	close(release)
+	if timestamp := <-handled; timestamp != 2 {
+		t.Error("expected the slow frame handled", timestamp)
+	}
+
+	budget.handle(nil, 5)
+	if timestamp := <-handled; timestamp != 5 {
+		t.Error("expected frames handled again once the listener is done", timestamp)
+	}
+
+	overrun := <-overruns
+	if overrun.Timestamp != 2 || budget.getOverruns() != 1 {
+		t.Error("unexpected overrun", overrun, budget.getOverruns())
+	}
+
+	budget.handle(nil, 6)
+	<-handled
+	// The skipped frames are reported with the next overrun
+	if skipped := atomic.LoadUint64(&budget.skipped); skipped != 2 {
+		t.Error("expected 2 skipped frames", skipped)
+	}
+}
+
+func Test_MediaFrameMultiplexerReplaceListener(t *testing.T) {
+
+	multiplexer := &MediaFrameMultiplexer{}
+	listener := &overwrittenMediaFrameListener{multiplexer: multiplexer}
+
+	frames := make(chan uint64, 1)
+	multiplexer.setFrameListener(func(timestamp uint64, clockRate uint) {
+		select {
+		case frames <- timestamp:
+		default:
+		}
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			listener.OnMediaFrame(nil)
+		}
+	}()
+
+	// Replaced while the native thread delivers frames
+	for i := 0; i < 100; i++ {
+		multiplexer.SetMediaFrameListenerWithBudget(func([]byte, uint64) {}, time.Millisecond, nil)
+		multiplexer.SetMediaFrameListener(nil)
+	}
+	<-done
+
+	if _, _, budget := multiplexer.getListeners(); budget != nil {
+		t.Error("expected the budget removed with the listener")
+	}
+	select {
+	case <-frames:
+	default:
+		t.Error("expected the frame listener called")
+	}
+}
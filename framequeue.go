@@ -0,0 +1,121 @@
+package mediaserver
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OverflowPolicy what a FrameQueue does with a frame when it is full
+type OverflowPolicy int
+
+const (
+	// OverflowDropOldest discard the oldest queued frame to make room for the new one
+	OverflowDropOldest OverflowPolicy = iota
+	// OverflowDropNewest discard the new frame
+	OverflowDropNewest
+	// OverflowBlock wait up to the deadline for room, then discard the new frame
+	OverflowBlock
+)
+
+// Frame a depacketized media frame with its rtp timestamp
+type Frame struct {
+	Data      []byte
+	Timestamp uint64
+}
+
+// FrameQueue deliver media frames through a bounded channel instead of calling back for each of them
+type FrameQueue struct {
+	frames    chan *Frame
+	policy    OverflowPolicy
+	deadline  time.Duration
+	delivered uint64
+	dropped   uint64
+	closed    bool
+	sync.Mutex
+}
+
+// NewFrameQueue create a queue of size frames, deadline is only used by OverflowBlock
+func NewFrameQueue(size int, policy OverflowPolicy, deadline time.Duration) *FrameQueue {
+
+	if size < 1 {
+		size = 1
+	}
+
+	return &FrameQueue{
+		frames:   make(chan *Frame, size),
+		policy:   policy,
+		deadline: deadline,
+	}
+}
+
+// C get the channel to receive the frames from, it is closed when the queue is closed
+func (q *FrameQueue) C() <-chan *Frame {
+	return q.frames
+}
+
+// Push queue a frame applying the overflow policy, it can be used as a media frame listener
+func (q *FrameQueue) Push(data []byte, timestamp uint64) {
+
+	q.Lock()
+	defer q.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	frame := &Frame{Data: data, Timestamp: timestamp}
+
+	select {
+	case q.frames <- frame:
+		atomic.AddUint64(&q.delivered, 1)
+		return
+	default:
+	}
+
+	switch q.policy {
+	case OverflowDropOldest:
+		// The reader may empty the queue meanwhile, so the oldest is only dropped if still there
+		select {
+		case <-q.frames:
+			atomic.AddUint64(&q.dropped, 1)
+		default:
+		}
+		q.frames <- frame
+		atomic.AddUint64(&q.delivered, 1)
+	case OverflowBlock:
+		timer := time.NewTimer(q.deadline)
+		defer timer.Stop()
+		select {
+		case q.frames <- frame:
+			atomic.AddUint64(&q.delivered, 1)
+		case <-timer.C:
+			atomic.AddUint64(&q.dropped, 1)
+		}
+	default:
+		atomic.AddUint64(&q.dropped, 1)
+	}
+}
+
+// GetDelivered get the number of frames queued, including the ones later discarded by OverflowDropOldest
+func (q *FrameQueue) GetDelivered() uint64 {
+	return atomic.LoadUint64(&q.delivered)
+}
+
+// GetDropped get the number of frames discarded by the overflow policy
+func (q *FrameQueue) GetDropped() uint64 {
+	return atomic.LoadUint64(&q.dropped)
+}
+
+// Close stop queueing and close the channel once the queued frames are read
+func (q *FrameQueue) Close() {
+
+	q.Lock()
+	defer q.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	close(q.frames)
+}
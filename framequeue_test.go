@@ -0,0 +1,53 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_FrameQueue(t *testing.T) {
+
+	oldest := NewFrameQueue(2, OverflowDropOldest, 0)
+	newest := NewFrameQueue(2, OverflowDropNewest, 0)
+	block := NewFrameQueue(2, OverflowBlock, 10*time.Millisecond)
+
+	for i := uint64(1); i <= 4; i++ {
+		oldest.Push(nil, i)
+		newest.Push(nil, i)
+		block.Push(nil, i)
+	}
+
+	if frame := <-oldest.C(); frame.Timestamp != 3 || oldest.GetDropped() != 2 {
+		t.Error("expected the oldest frames dropped", frame.Timestamp, oldest.GetDropped())
+	}
+
+	if frame := <-newest.C(); frame.Timestamp != 1 || newest.GetDropped() != 2 {
+		t.Error("expected the newest frames dropped", frame.Timestamp, newest.GetDropped())
+	}
+
+	if frame := <-block.C(); frame.Timestamp != 1 || block.GetDropped() != 2 {
+		t.Error("expected the frames dropped after the deadline", frame.Timestamp, block.GetDropped())
+	}
+
+	// A blocked push goes through once the reader makes room
+	go func() {
+		time.Sleep(time.Millisecond)
+		<-block.C()
+	}()
+	block.deadline = time.Second
+	block.Push(nil, 5)
+	block.Push(nil, 6)
+	if block.GetDropped() != 2 || block.GetDelivered() != 4 {
+		t.Error("expected the blocked frame queued", block.GetDropped(), block.GetDelivered())
+	}
+
+	block.Close()
+	block.Push(nil, 7)
+	count := 0
+	for range block.C() {
+		count++
+	}
+	if count != 2 {
+		t.Error("expected the queued frames read after close", count)
+	}
+}
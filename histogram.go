@@ -0,0 +1,132 @@
+package mediaserver
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// HistogramStats percentiles of the samples in the histogram window
+type HistogramStats struct {
+	Count int
+	Min   float64
+	Max   float64
+	Mean  float64
+	P50   float64
+	P95   float64
+	P99   float64
+}
+
+// Histogram keep the last samples of a value to compute its percentiles, so tail problems are not hidden by averages
+type Histogram struct {
+	samples []float64
+	next    int
+	full    bool
+	sync.Mutex
+}
+
+// NewHistogram create a histogram keeping the last window samples
+func NewHistogram(window int) *Histogram {
+
+	if window <= 0 {
+		window = 1
+	}
+
+	return &Histogram{
+		samples: make([]float64, window),
+	}
+}
+
+// Add add a sample, the oldest one is dropped when the window is full
+func (h *Histogram) Add(value float64) {
+
+	h.Lock()
+	defer h.Unlock()
+
+	h.samples[h.next] = value
+	h.next++
+	if h.next == len(h.samples) {
+		h.next = 0
+		h.full = true
+	}
+}
+
+// Reset drop all the samples
+func (h *Histogram) Reset() {
+	h.Lock()
+	h.next = 0
+	h.full = false
+	h.Unlock()
+}
+
+// GetStats compute the percentiles of the current samples
+func (h *Histogram) GetStats() *HistogramStats {
+
+	h.Lock()
+	count := h.next
+	if h.full {
+		count = len(h.samples)
+	}
+	sorted := make([]float64, count)
+	copy(sorted, h.samples[:count])
+	h.Unlock()
+
+	stats := &HistogramStats{Count: count}
+
+	if count == 0 {
+		return stats
+	}
+
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, value := range sorted {
+		sum += value
+	}
+
+	stats.Min = sorted[0]
+	stats.Max = sorted[count-1]
+	stats.Mean = sum / float64(count)
+	stats.P50 = percentile(sorted, 0.50)
+	stats.P95 = percentile(sorted, 0.95)
+	stats.P99 = percentile(sorted, 0.99)
+
+	return stats
+}
+
+// percentile nearest rank percentile of sorted samples
+func percentile(sorted []float64, p float64) float64 {
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// interArrivalJitter RFC 3550 interarrival jitter of the frames, from their arrival time and rtp timestamp deltas
+type interArrivalJitter struct {
+	arrival   time.Time
+	timestamp uint32
+	jitter    float64
+}
+
+// update add a frame and get the jitter in milliseconds, false for the first frame
+func (j *interArrivalJitter) update(arrival time.Time, timestamp uint64, clockRate uint) (float64, bool) {
+
+	previous, last := j.arrival, j.timestamp
+	j.arrival, j.timestamp = arrival, uint32(timestamp)
+
+	if previous.IsZero() || clockRate == 0 {
+		return 0, false
+	}
+
+	// the rtp timestamp wraps around
+	elapsed := float64(int32(uint32(timestamp)-last)) / float64(clockRate)
+	transit := arrival.Sub(previous).Seconds() - elapsed
+
+	j.jitter += (math.Abs(transit) - j.jitter) / 16
+
+	return j.jitter * 1000, true
+}
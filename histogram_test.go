@@ -0,0 +1,54 @@
+package mediaserver
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func Test_Histogram(t *testing.T) {
+
+	histogram := NewHistogram(100)
+
+	if stats := histogram.GetStats(); stats.Count != 0 {
+		t.Error("expected empty histogram", stats)
+	}
+
+	for i := 1; i <= 200; i++ {
+		histogram.Add(float64(i))
+	}
+
+	stats := histogram.GetStats()
+
+	if stats.Count != 100 || stats.Min != 101 || stats.Max != 200 {
+		t.Error("expected the last 100 samples", stats)
+	}
+
+	if stats.P50 != 150 || stats.P95 != 195 || stats.P99 != 199 {
+		t.Error("unexpected percentiles", stats)
+	}
+}
+
+func Test_InterArrivalJitter(t *testing.T) {
+
+	var jitter interArrivalJitter
+	start := time.Now()
+
+	// frames arriving at their rtp pace have no jitter, even across the rtp timestamp wrap
+	timestamp := uint64(math.MaxUint32 - 1500)
+	if _, ok := jitter.update(start, timestamp, 90000); ok {
+		t.Error("expected no jitter for the first frame")
+	}
+	for i := 1; i <= 10; i++ {
+		value, _ := jitter.update(start.Add(time.Duration(i)*40*time.Millisecond), timestamp+uint64(i)*3600, 90000)
+		if value > 0.001 {
+			t.Fatal("unexpected jitter", i, value)
+		}
+	}
+
+	// a frame 16ms late adds 1ms
+	value, _ := jitter.update(start.Add(456*time.Millisecond), timestamp+11*3600, 90000)
+	if math.Abs(value-1) > 0.001 {
+		t.Error("unexpected jitter", value)
+	}
+}
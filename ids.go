@@ -0,0 +1,97 @@
+package mediaserver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/gofrs/uuid"
+	"github.com/notedit/sdp"
+)
+
+const maxIDLength = 256
+
+var (
+	// ErrInvalidID stream or track id is empty, too long or contains spaces or control chars
+	ErrInvalidID = errors.New("invalid id")
+	// ErrDuplicateID stream or track id already used in the Transport
+	ErrDuplicateID = errors.New("duplicated id")
+)
+
+// NormalizeID trim the spaces around a stream or track id
+func NormalizeID(id string) string {
+	return strings.TrimSpace(id)
+}
+
+// ValidateID check a stream or track id is usable, returns an error wrapping ErrInvalidID if not
+func ValidateID(id string) error {
+
+	if id == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidID)
+	}
+
+	if len(id) > maxIDLength {
+		return fmt.Errorf("%w: longer than %d", ErrInvalidID, maxIDLength)
+	}
+
+	for _, r := range id {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return fmt.Errorf("%w: %q", ErrInvalidID, id)
+		}
+	}
+	return nil
+}
+
+func generateID() string {
+	return uuid.Must(uuid.NewV4()).String()
+}
+
+// trackInfoWithID clone the track Info with a new id
+func trackInfoWithID(track *sdp.TrackInfo, id string) *sdp.TrackInfo {
+
+	cloned := sdp.NewTrackInfo(id, track.GetMedia())
+	cloned.SetMediaID(track.GetMediaID())
+
+	for _, ssrc := range track.GetSSRCS() {
+		cloned.AddSSRC(ssrc)
+	}
+
+	for _, group := range track.GetSourceGroupS() {
+		cloned.AddSourceGroup(group.Clone())
+	}
+
+	for _, alternatives := range track.GetEncodings() {
+		encodings := []*sdp.TrackEncodingInfo{}
+		for _, encoding := range alternatives {
+			encodings = append(encodings, encoding.Clone())
+		}
+		cloned.AddAlternativeEncodings(encodings)
+	}
+
+	return cloned
+}
+
+// normalizeStreamInfo normalize the stream and track ids, replacing the invalid or taken ones with generated UUIDs
+func normalizeStreamInfo(info *sdp.StreamInfo, streamTaken func(id string) bool, trackTaken func(id string) bool) *sdp.StreamInfo {
+
+	streamID := NormalizeID(info.GetID())
+	if ValidateID(streamID) != nil || streamTaken(streamID) {
+		streamID = generateID()
+	}
+
+	normalized := sdp.NewStreamInfo(streamID)
+	used := map[string]bool{}
+
+	for _, track := range info.GetTracks() {
+		trackID := NormalizeID(track.GetID())
+		if ValidateID(trackID) != nil || trackTaken(trackID) || used[trackID] {
+			trackID = generateID()
+		}
+		used[trackID] = true
+
+		normalized.AddTrack(trackInfoWithID(track, trackID))
+	}
+
+	return normalized
+}
@@ -0,0 +1,57 @@
+package mediaserver
+
+import (
+	"errors"
+	"net"
+
+	"github.com/notedit/sdp"
+)
+
+// BindToInterface only send and receive the endpoint packets through the named network interface, ie "eth1",
+// so a multi-homed server can terminate each customer traffic on its own NIC, an empty name removes the restriction.
+// All the transports of an endpoint share its socket, so use an endpoint per interface and WithPublicIP to advertise
+// a different address per transport. It needs linux and CAP_NET_RAW on kernels older than 5.7,
+// and it is applied again if a Watchdog restarts the endpoint
+func (e *Endpoint) BindToInterface(name string) error {
+
+	if name != "" {
+		if _, err := net.InterfaceByName(name); err != nil {
+			return err
+		}
+	}
+
+	e.Lock()
+	defer e.Unlock()
+
+	if e.bundle == nil {
+		return errors.New("endpoint stopped")
+	}
+
+	if err := bindSocketToDevice(e.candidate.GetAddress(), e.bundle.GetLocalPort(), name); err != nil {
+		return err
+	}
+	e.iface = name
+	return nil
+}
+
+// GetInterface get the network interface the endpoint is bound to, empty if none
+func (e *Endpoint) GetInterface() string {
+	e.Lock()
+	defer e.Unlock()
+	return e.iface
+}
+
+// withPublicIP the candidates with the address of the host ones replaced by ip, the others are kept
+func withPublicIP(candidates []*sdp.CandidateInfo, ip string) []*sdp.CandidateInfo {
+
+	replaced := make([]*sdp.CandidateInfo, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.GetType() != "host" {
+			replaced = append(replaced, candidate)
+			continue
+		}
+		replaced = append(replaced, sdp.NewCandidateInfo(candidate.GetFoundation(), candidate.GetComponentID(), candidate.GetTransport(),
+			candidate.GetPriority(), ip, candidate.GetPort(), candidate.GetType(), candidate.GetRelAddr(), candidate.GetRelPort()))
+	}
+	return replaced
+}
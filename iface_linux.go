@@ -0,0 +1,17 @@
+//go:build linux
+// +build linux
+
+package mediaserver
+
+import (
+	"syscall"
+)
+
+// bindSocketToDevice restrict the udp socket bound to the address and port to a network interface, an empty name removes the restriction
+func bindSocketToDevice(ip string, port int, name string) error {
+	fd, _, err := endpointSocket(ip, port)
+	if err != nil {
+		return err
+	}
+	return syscall.BindToDevice(fd, name)
+}
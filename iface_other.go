@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package mediaserver
+
+import (
+	"errors"
+)
+
+func bindSocketToDevice(ip string, port int, name string) error {
+	return errors.New("binding to an interface is only supported on linux")
+}
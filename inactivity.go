@@ -0,0 +1,108 @@
+package mediaserver
+
+import (
+	"time"
+)
+
+// InactivityConfig timeouts after which a remote peer is considered gone, zero disables a timeout
+type InactivityConfig struct {
+	// ConsentTimeout time without any ICE check or response from the remote peer, browsers send consent checks every 5s
+	ConsentTimeout time.Duration
+	// MediaTimeout time without any rtp packet received on the incoming tracks, only for transports receiving media
+	MediaTimeout time.Duration
+	// StopOnTimeout stop the transport after calling the listener
+	StopOnTimeout bool
+}
+
+// InactivityReason which timeout expired
+type InactivityReason string
+
+// Inactivity reasons
+const (
+	InactivityConsent InactivityReason = "consent"
+	InactivityMedia   InactivityReason = "media"
+)
+
+// InactivityEvent is emitted once each time the remote peer becomes inactive
+type InactivityEvent struct {
+	Reason InactivityReason
+	// Inactive time since the last activity
+	Inactive time.Duration
+}
+
+// InactivityListener called when the remote peer becomes inactive
+type InactivityListener func(event *InactivityEvent)
+
+type inactivityTimer struct {
+	config    InactivityConfig
+	listener  InactivityListener
+	packets   uint
+	lastMedia time.Time
+	fired     bool
+}
+
+// SetInactivityTimeout call the listener when the remote peer stops sending ICE checks or media for the configured time,
+// so abandoned sessions can be cleaned up. It is called again only after the peer has been active again
+func (t *Transport) SetInactivityTimeout(config InactivityConfig, listener InactivityListener) {
+	monitor := t.getStateMonitor()
+	monitor.Lock()
+	monitor.inactivity = &inactivityTimer{
+		config:    config,
+		listener:  listener,
+		lastMedia: time.Now(),
+	}
+	monitor.Unlock()
+}
+
+// incomingPackets total rtp packets received on the incoming tracks, only its changes are meaningful
+func (t *Transport) incomingPackets() uint {
+	packets := uint(0)
+	for _, stream := range t.GetIncomingStreams() {
+		for _, track := range stream.GetTracks() {
+			for _, stats := range track.GetStats() {
+				packets += stats.Media.NumPackets
+			}
+		}
+	}
+	return packets
+}
+
+// check the timeouts on each poll, called with the monitor locked. It returns the event to emit, if any
+func (i *inactivityTimer) check(transport *Transport, lastActivity time.Time, now time.Time) *InactivityEvent {
+
+	if i.config.MediaTimeout > 0 {
+		if packets := transport.incomingPackets(); packets != i.packets {
+			i.packets = packets
+			i.lastMedia = now
+		}
+	}
+
+	var event *InactivityEvent
+	if consent := now.Sub(lastActivity); i.config.ConsentTimeout > 0 && consent >= i.config.ConsentTimeout {
+		event = &InactivityEvent{Reason: InactivityConsent, Inactive: consent}
+	} else if media := now.Sub(i.lastMedia); i.config.MediaTimeout > 0 && media >= i.config.MediaTimeout {
+		event = &InactivityEvent{Reason: InactivityMedia, Inactive: media}
+	}
+
+	if event == nil {
+		i.fired = false
+		return nil
+	}
+	if i.fired {
+		return nil
+	}
+	i.fired = true
+	return event
+}
+
+// fire call the listener and stop the transport if configured so
+func (i *inactivityTimer) fire(transport *Transport, event *InactivityEvent) {
+
+	if i.listener != nil {
+		i.listener(event)
+	}
+	if i.config.StopOnTimeout {
+		// in background, Stop waits for the poll calling us to end
+		go transport.Stop()
+	}
+}
@@ -19,17 +19,21 @@ type IncomingStream struct {
 	Receiver                          native.RTPReceiverFacade
 	Tracks                            map[string]*IncomingStreamTrack
 	OnStreamAddIncomingTrackListeners []func(*IncomingStreamTrack)
+	owner                             *Transport
+	onStop                            emitter
 	l                                 sync.Mutex
+	metadata
 }
 
 // NewIncomingStream  Create new incoming stream
 // TODO: make this public
-func newIncomingStream(transport native.DTLSICETransport, receiver native.RTPReceiverFacade, info *sdp.StreamInfo) *IncomingStream {
+func newIncomingStream(transport native.DTLSICETransport, receiver native.RTPReceiverFacade, info *sdp.StreamInfo, owner *Transport) *IncomingStream {
 	stream := &IncomingStream{}
 	stream.Id = info.GetID()
 	stream.Transport = transport
 	stream.Receiver = receiver
 	stream.Tracks = make(map[string]*IncomingStreamTrack)
+	stream.owner = owner
 
 	stream.OnStreamAddIncomingTrackListeners = make([]func(*IncomingStreamTrack), 0)
 
@@ -60,7 +64,7 @@ func (i *IncomingStream) GetStats() map[string]map[string]*IncomingAllStats {
 
 	stats := map[string]map[string]*IncomingAllStats{}
 
-	for _, track := range i.Tracks {
+	for _, track := range i.GetTracks() {
 		stats[track.GetID()] = track.GetStats()
 	}
 
@@ -111,15 +115,32 @@ func (i *IncomingStream) GetVideoTracks() []*IncomingStreamTrack {
 	return videoTracks
 }
 
+func (i *IncomingStream) getLimits() Limits {
+	if i.owner != nil {
+		return i.owner.GetLimits()
+	}
+	return DefaultLimits
+}
+
 // AddTrack Adds an incoming stream track created using the Transpocnder.CreateIncomingStreamTrack to this stream
 func (i *IncomingStream) AddTrack(track *IncomingStreamTrack) error {
 
+	limits := i.getLimits()
+
 	i.l.Lock()
 	defer i.l.Unlock()
 	if _, ok := i.Tracks[track.GetID()]; ok {
 		return errors.New("Track Id already present in stream")
 	}
 
+	if err := checkLimit("tracks", limits.MaxTracksPerStream, len(i.Tracks)+1); err != nil {
+		return err
+	}
+
+	if err := checkLimit("encodings", limits.MaxEncodingsPerTrack, len(track.GetEncodings())); err != nil {
+		return err
+	}
+
 	i.Tracks[track.GetID()] = track
 	return nil
 }
@@ -129,17 +150,105 @@ func (i *IncomingStream) RemoveTrack(track *IncomingStreamTrack) error {
 	i.l.Lock()
 	defer i.l.Unlock()
 
-	delete(i.Tracks, track.GetID())
+	if i.Tracks[track.GetID()] == track {
+		delete(i.Tracks, track.GetID())
+		if i.owner != nil {
+			i.owner.unregisterIncomingTrack(track)
+		}
+	}
 	return nil
 }
 
+// ReplaceTrack Create a new track from the TrackInfo and rebind the transponders attached to the old track to it, then stop the old track
+// Used when the publisher restarts a source with new ssrcs or mid, so subscribers do not need to renegotiate
+func (i *IncomingStream) ReplaceTrack(oldID string, trackInfo *sdp.TrackInfo) (*IncomingStreamTrack, error) {
+
+	old := i.GetTrack(oldID)
+	if old == nil {
+		return nil, errors.New("Track not found in stream")
+	}
+
+	if old.GetMedia() != trackInfo.GetMedia() {
+		return nil, errors.New("Track media does not match")
+	}
+
+	// the new track may reuse the old id
+	i.RemoveTrack(old)
+
+	track := i.CreateTrack(trackInfo)
+	if track == nil {
+		i.restoreTrack(old)
+		return nil, errors.New("Could not create track")
+	}
+
+	moved := []*Transponder{}
+	for _, transponder := range old.GetTransponders() {
+		if err := transponder.SetIncomingTrack(track); err != nil {
+			// only a closed transponder fails, the ones just moved can go back
+			for _, transponder := range moved {
+				transponder.SetIncomingTrack(old)
+			}
+			i.RemoveTrack(track)
+			i.stopTrack(track)
+			i.restoreTrack(old)
+			return nil, err
+		}
+		moved = append(moved, transponder)
+	}
+
+	i.stopTrack(old)
+
+	return track, nil
+}
+
+// restoreTrack add back a track removed with RemoveTrack, the limits are not checked again
+func (i *IncomingStream) restoreTrack(track *IncomingStreamTrack) {
+
+	i.l.Lock()
+	i.Tracks[track.GetID()] = track
+	i.l.Unlock()
+
+	if i.owner != nil {
+		i.owner.registerIncomingTrack(track)
+	}
+}
+
+// stopTrack remove the track sources from the native transport and stop it
+func (i *IncomingStream) stopTrack(track *IncomingStreamTrack) {
+
+	for _, encoding := range track.GetEncodings() {
+		i.Transport.RemoveIncomingSourceGroup(encoding.GetSource())
+	}
+
+	track.Stop()
+}
+
 // CreateTrack Create new track from a TrackInfo object and add it to this stream
+// Returns nil if the track id is invalid or already used in the Transport, or the stream limits are exceeded
 func (i *IncomingStream) CreateTrack(track *sdp.TrackInfo) *IncomingStreamTrack {
 
 	if _, ok := i.Tracks[track.GetID()]; ok {
 		return nil
 	}
 
+	if ValidateID(track.GetID()) != nil {
+		return nil
+	}
+
+	if i.owner != nil && i.owner.isIncomingTrackIDTaken(track.GetID()) {
+		return nil
+	}
+
+	limits := i.getLimits()
+
+	if checkLimit("tracks", limits.MaxTracksPerStream, len(i.Tracks)+1) != nil {
+		return nil
+	}
+
+	if limits.CheckTrackInfo(track) != nil {
+		return nil
+	}
+
 	var mediaType native.MediaFrameType = 0
 	if track.GetMedia() == "video" {
 		mediaType = 1
@@ -267,6 +376,11 @@ func (i *IncomingStream) CreateTrack(track *sdp.TrackInfo) *IncomingStreamTrack
 
 	incomingTrack := NewIncomingStreamTrack(track.GetMedia(), track.GetID(), i.Receiver, sources)
 
+	if i.owner != nil {
+		incomingTrack.codecs = i.owner.getRemoteCodecs
+		i.owner.registerIncomingTrack(incomingTrack)
+	}
+
 	i.l.Lock()
 	i.Tracks[track.GetID()] = incomingTrack
 	i.l.Unlock()
@@ -282,14 +396,30 @@ func (i *IncomingStream) Stop() {
 	}
 
 	i.l.Lock()
-	defer i.l.Unlock()
 
 	for k, track := range i.Tracks {
 		track.Stop()
 		delete(i.Tracks, k)
+		if i.owner != nil {
+			i.owner.unregisterIncomingTrack(track)
+		}
 	}
 
 	native.DeleteRTPReceiverFacade(i.Receiver) // other module maybe need delete
 	i.Receiver = nil
 	i.Transport = nil
+	i.l.Unlock()
+
+	if i.owner != nil {
+		i.owner.removeIncomingStream(i)
+	}
+
+	i.onStop.emit(func(listener interface{}) {
+		listener.(func())()
+	})
+}
+
+// OnStop register a listener called once the stream and its tracks are stopped
+func (i *IncomingStream) OnStop(stop func()) ListenerOff {
+	return i.onStop.once(stop)
 }
@@ -0,0 +1,53 @@
+package mediaserver
+
+import (
+	"testing"
+
+	"github.com/notedit/sdp"
+)
+
+func newTestTrackInfo(id string, ssrc uint) *sdp.TrackInfo {
+	track := sdp.NewTrackInfo(id, "video")
+	track.AddSSRC(ssrc)
+	return track
+}
+
+func Test_ReplaceTrack(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+
+	info := sdp.NewStreamInfo("stream")
+	info.AddTrack(newTestTrackInfo("video", 1))
+	stream := transport.CreateIncomingStream(info)
+	old := stream.GetTrack("video")
+
+	outgoing := transport.CreateOutgoingStreamWithID("out", false, true).GetVideoTracks()[0]
+	transponder := outgoing.AttachTo(old)
+
+	// the new track can not be created, the old one is kept in the stream and the transport
+	if _, err := stream.ReplaceTrack("video", newTestTrackInfo("bad id", 2)); err == nil {
+		t.Error("expected replace error")
+	}
+	if stream.GetTrack("video") != old || !transport.isIncomingTrackIDTaken("video") {
+		t.Error("expected the old track restored")
+	}
+	if transponder.GetIncomingTrack() != old {
+		t.Error("expected the transponder left on the old track")
+	}
+
+	track, err := stream.ReplaceTrack("video", newTestTrackInfo("video", 2))
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if stream.GetTrack("video") != track || transport.incomingStreamTracks["video"] != track {
+		t.Error("expected the new track registered")
+	}
+	if transponder.GetIncomingTrack() != track {
+		t.Error("expected the transponder moved to the new track")
+	}
+	if old.receiver != nil {
+		t.Error("expected the old track stopped")
+	}
+}
@@ -3,6 +3,9 @@ package mediaserver
 import (
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	native "github.com/notedit/media-server-go/wrapper"
@@ -32,6 +35,9 @@ type Encoding struct {
 	id           string
 	source       native.RTPIncomingSourceGroup
 	depacketizer native.StreamTrackDepacketizer
+	lastPLI      time.Time
+	keyframes    native.KeyframeCache
+	payload      native.PayloadCodecMonitor
 }
 
 // GetID encoding Id
@@ -62,9 +68,19 @@ type IncomingStreamTrack struct {
 	trackInfo             *sdp.TrackInfo
 	stats                 map[string]*IncomingAllStats
 	mediaframeMultiplexer *MediaFrameMultiplexer
-	onStopListeners       []func()
-	onAttachedListeners   []func()
-	onDetachedListeners   []func()
+	jitter                *Histogram
+	bitrates              bitrateMeters
+	frameInterval         *Histogram
+	codecs                func(media string) []string
+	transponders          map[*Transponder]bool
+	onStop                emitter
+	onAttached            emitter
+	onDetached            emitter
+	l                     sync.Mutex
+	statsLock             sync.Mutex
+	soak                  *soakSentinel
+	keyframeMaxAge        time.Duration
+	metadata
 }
 
 // IncomingStats Info
@@ -94,7 +110,12 @@ type IncomingAllStats struct {
 	Total        uint
 	Remb         uint
 	SimulcastIdx int
-	timestamp    int64
+	// Jitter and FrameInterval in milliseconds, only for the first encoding when timing stats are enabled.
+	// Jitter is the RFC 3550 interarrival jitter of the depacketized frames
+	Jitter        *HistogramStats   `json:",omitempty"`
+	FrameInterval *HistogramStats   `json:",omitempty"`
+	Metadata      map[string]string `json:",omitempty"`
+	timestamp     int64
 }
 
 // ActiveEncoding Info
@@ -179,18 +200,23 @@ func NewIncomingStreamTrack(media string, id string, receiver native.RTPReceiver
 	track.receiver = receiver
 	track.counter = 0
 	track.encodings = make([]*Encoding, 0)
+	track.transponders = make(map[*Transponder]bool)
 
 	track.trackInfo = sdp.NewTrackInfo(id, media)
+	track.soak = soakTrack(soakIncomingSourceGroups, int64(len(sources)))
 
 	for k, source := range sources {
 		encoding := &Encoding{
 			id:           k,
 			source:       source,
 			depacketizer: native.NewStreamTrackDepacketizer(source),
+			payload:      native.NewPayloadCodecMonitor(source),
 		}
 
 		track.encodings = append(track.encodings, encoding)
 
+		atomic.AddInt64(&numIncomingSourceGroups, 1)
+
 		//Add ssrcs to track Info
 		if source.GetMedia().GetSsrc() > 0 {
 			track.trackInfo.AddSSRC(source.GetMedia().GetSsrc())
@@ -227,10 +253,6 @@ func NewIncomingStreamTrack(media string, id string, receiver native.RTPReceiver
 		}
 	}
 
-	track.onAttachedListeners = make([]func(), 0)
-	track.onDetachedListeners = make([]func(), 0)
-	track.onStopListeners = make([]func(), 0)
-
 	sort.SliceStable(track.encodings, func(i, j int) bool {
 		return track.encodings[i].id < track.encodings[j].id
 	})
@@ -253,6 +275,27 @@ func (i *IncomingStreamTrack) GetTrackInfo() *sdp.TrackInfo {
 	return i.trackInfo
 }
 
+// GetCodecs get the Media codecs negotiated with the remote peer, nil if unknown
+func (i *IncomingStreamTrack) GetCodecs() []string {
+	if i.codecs == nil {
+		return nil
+	}
+	return i.codecs(i.Media)
+}
+
+// GetPayloadCodec get the codec of the last RTP packet received, "" if no media has been received yet
+func (i *IncomingStreamTrack) GetPayloadCodec() string {
+	for _, encoding := range i.encodings {
+		if encoding.payload == nil {
+			continue
+		}
+		if codec := encoding.payload.GetCodec(); codec != "" {
+			return strings.ToLower(codec)
+		}
+	}
+	return ""
+}
+
 // GetSSRCs get all RTPIncomingSource include "Media" "rtx" "fec"
 func (i *IncomingStreamTrack) GetSSRCs() []map[string]native.RTPIncomingSource {
 
@@ -269,8 +312,12 @@ func (i *IncomingStreamTrack) GetSSRCs() []map[string]native.RTPIncomingSource {
 }
 
 // GetStats Get stats for all encodings
+// The returned stats are a snapshot owned by the caller, they are not modified by later calls
 func (i *IncomingStreamTrack) GetStats() map[string]*IncomingAllStats {
 
+	i.statsLock.Lock()
+	defer i.statsLock.Unlock()
+
 	if i.stats == nil {
 		i.stats = map[string]*IncomingAllStats{}
 	}
@@ -295,8 +342,19 @@ func (i *IncomingStreamTrack) GetStats() map[string]*IncomingAllStats {
 				Fec:         fec,
 				Bitrate:     media.Bitrate,
 				Total:       media.Bitrate + fec.Bitrate + rtx.Bitrate,
+				Metadata:    i.GetAllMetadata(),
 				timestamp:   time.Now().UnixNano(),
 			}
+
+			timestamp := i.stats[encoding.id].timestamp
+			i.bitrates.update(encoding.id+".media", timestamp, media.TotalBytes)
+			i.bitrates.update(encoding.id+".rtx", timestamp, rtx.TotalBytes)
+			i.bitrates.update(encoding.id+".fec", timestamp, fec.TotalBytes)
+
+			if encoding == i.GetFirstEncoding() && i.jitter != nil {
+				i.stats[encoding.id].Jitter = i.jitter.GetStats()
+				i.stats[encoding.id].FrameInterval = i.frameInterval.GetStats()
+			}
 		}
 	}
 
@@ -323,7 +381,79 @@ func (i *IncomingStreamTrack) GetStats() map[string]*IncomingAllStats {
 		}
 	}
 
-	return i.stats
+	snapshot := make(map[string]*IncomingAllStats, len(i.stats))
+	for id, state := range i.stats {
+		snapshot[id] = state.clone()
+	}
+	return snapshot
+}
+
+func (s *IncomingStats) clone() *IncomingStats {
+
+	if s == nil {
+		return nil
+	}
+
+	copied := *s
+	copied.Layers = make([]*Layer, len(s.Layers))
+	for i, layer := range s.Layers {
+		l := *layer
+		copied.Layers[i] = &l
+	}
+	return &copied
+}
+
+func (s *IncomingAllStats) clone() *IncomingAllStats {
+
+	copied := *s
+	copied.Media = s.Media.clone()
+	copied.Rtx = s.Rtx.clone()
+	copied.Fec = s.Fec.clone()
+	if s.Jitter != nil {
+		jitter := *s.Jitter
+		copied.Jitter = &jitter
+	}
+	if s.FrameInterval != nil {
+		interval := *s.FrameInterval
+		copied.FrameInterval = &interval
+	}
+	if s.Metadata != nil {
+		copied.Metadata = make(map[string]string, len(s.Metadata))
+		for key, value := range s.Metadata {
+			copied.Metadata[key] = value
+		}
+	}
+	return &copied
+}
+
+// GetStatsWithWindow Get stats for all encodings with the bitrates smoothed over the window instead of the native 1s accumulator
+// Layer bitrates are native ones
+func (i *IncomingStreamTrack) GetStatsWithWindow(window BitrateWindow) map[string]*IncomingAllStats {
+
+	stats := i.GetStats()
+
+	if window == BitrateWindowNative {
+		return stats
+	}
+
+	smoothed := map[string]*IncomingAllStats{}
+
+	for id, state := range stats {
+		copied := *state
+		media := *state.Media
+		rtx := *state.Rtx
+		fec := *state.Fec
+		media.Bitrate = i.bitrates.bitrate(id+".media", window)
+		rtx.Bitrate = i.bitrates.bitrate(id+".rtx", window)
+		fec.Bitrate = i.bitrates.bitrate(id+".fec", window)
+		copied.Media = &media
+		copied.Rtx = &rtx
+		copied.Fec = &fec
+		copied.Bitrate = media.Bitrate
+		copied.Total = media.Bitrate + rtx.Bitrate + fec.Bitrate
+		smoothed[id] = &copied
+	}
+	return smoothed
 }
 
 // GetActiveLayers Get active encodings and layers ordered by bitrate
@@ -436,9 +566,9 @@ func (i *IncomingStreamTrack) Attached() {
 	i.counter = i.counter + 1
 
 	if i.counter == 1 {
-		for _, attach := range i.onAttachedListeners {
-			attach()
-		}
+		i.onAttached.emit(func(listener interface{}) {
+			listener.(func())()
+		})
 	}
 }
 
@@ -457,20 +587,48 @@ func (i *IncomingStreamTrack) Detached() {
 	i.counter = i.counter - 1
 
 	if i.counter == 0 {
-		for _, detach := range i.onDetachedListeners {
-			detach()
-		}
+		i.onDetached.emit(func(listener interface{}) {
+			listener.(func())()
+		})
 	}
 }
 
-// OnDetach
-func (i *IncomingStreamTrack) OnDetach(detach func()) {
-	i.onDetachedListeners = append(i.onDetachedListeners, detach)
+func (i *IncomingStreamTrack) addTransponder(transponder *Transponder) {
+	i.l.Lock()
+	defer i.l.Unlock()
+	i.transponders[transponder] = true
+}
+
+func (i *IncomingStreamTrack) removeTransponder(transponder *Transponder) {
+	i.l.Lock()
+	defer i.l.Unlock()
+	delete(i.transponders, transponder)
+}
+
+// GetTransponders get the transponders currently forwarding this track
+func (i *IncomingStreamTrack) GetTransponders() []*Transponder {
+	i.l.Lock()
+	defer i.l.Unlock()
+	transponders := []*Transponder{}
+	for transponder := range i.transponders {
+		transponders = append(transponders, transponder)
+	}
+	return transponders
+}
+
+// OnDetach run this func when the last outgoing track is detached
+func (i *IncomingStreamTrack) OnDetach(detach func()) ListenerOff {
+	return i.onDetached.on(detach)
 }
 
 // OnAttach  run this func when attached
-func (i *IncomingStreamTrack) OnAttach(attach func()) {
-	i.onAttachedListeners = append(i.onAttachedListeners, attach)
+func (i *IncomingStreamTrack) OnAttach(attach func()) ListenerOff {
+	return i.onAttached.on(attach)
+}
+
+// OnStop register a listener called when the track is stopped, before the native sources are released
+func (i *IncomingStreamTrack) OnStop(stop func()) ListenerOff {
+	return i.onStop.once(stop)
 }
 
 // OnMediaFrame callback
@@ -483,6 +641,62 @@ func (i *IncomingStreamTrack) OnMediaFrame(listener func([]byte, uint64)) {
 	i.mediaframeMultiplexer.SetMediaFrameListener(listener)
 }
 
+// MediaFrames deliver the media frames through a queue of size frames applying policy when the reader falls behind,
+// the queue is closed when the track is stopped
+func (i *IncomingStreamTrack) MediaFrames(size int, policy OverflowPolicy, deadline time.Duration) *FrameQueue {
+
+	queue := NewFrameQueue(size, policy, deadline)
+
+	i.OnMediaFrame(queue.Push)
+	i.OnStop(queue.Close)
+
+	return queue
+}
+
+// OnMediaFrameWithBudget callback off the media path, the media is not delayed by a listener running longer than budget,
+// see MediaFrameMultiplexer.SetMediaFrameListenerWithBudget
+func (i *IncomingStreamTrack) OnMediaFrameWithBudget(listener func([]byte, uint64), budget time.Duration, onOverrun FrameOverrunListener) {
+
+	if i.mediaframeMultiplexer == nil {
+		i.mediaframeMultiplexer = NewMediaFrameMultiplexer(i)
+	}
+
+	i.mediaframeMultiplexer.SetMediaFrameListenerWithBudget(listener, budget, onOverrun)
+}
+
+// EnableTimingStats keep the jitter and the inter-frame interval of the last window frames,
+// their percentiles are reported in GetStats. Frames are depacketized to be timed
+func (i *IncomingStreamTrack) EnableTimingStats(window int) {
+
+	if i.mediaframeMultiplexer == nil {
+		i.mediaframeMultiplexer = NewMediaFrameMultiplexer(i)
+	}
+
+	jitter := NewHistogram(window)
+	frameInterval := NewHistogram(window)
+
+	var last time.Time
+	var arrivals interArrivalJitter
+
+	i.mediaframeMultiplexer.setFrameListener(func(timestamp uint64, clockRate uint) {
+
+		now := time.Now()
+		if !last.IsZero() {
+			frameInterval.Add(float64(now.Sub(last)) / float64(time.Millisecond))
+		}
+		last = now
+
+		if value, ok := arrivals.update(now, timestamp, clockRate); ok {
+			jitter.Add(value)
+		}
+	})
+
+	i.statsLock.Lock()
+	i.jitter = jitter
+	i.frameInterval = frameInterval
+	i.statsLock.Unlock()
+}
+
 // Stop Removes the track from the incoming stream and also detaches any attached outgoing track or recorder
 func (i *IncomingStreamTrack) Stop() {
 
@@ -490,20 +704,32 @@ func (i *IncomingStreamTrack) Stop() {
 		return
 	}
 
+	i.onStop.emit(func(listener interface{}) {
+		listener.(func())()
+	})
+
 	if i.mediaframeMultiplexer != nil {
 		i.mediaframeMultiplexer.Stop()
 		i.mediaframeMultiplexer = nil
 	}
 
+	i.DisableKeyframeCache()
+
 	for _, encoding := range i.encodings {
 		if encoding.depacketizer != nil {
 			encoding.depacketizer.Stop()
 			native.DeleteStreamTrackDepacketizer(encoding.depacketizer)
 		}
+		if encoding.payload != nil {
+			encoding.payload.Stop()
+			native.DeletePayloadCodecMonitor(encoding.payload)
+		}
 		if encoding.source != nil {
 			native.DeleteRTPIncomingSourceGroup(encoding.source)
+			atomic.AddInt64(&numIncomingSourceGroups, -1)
 		}
 	}
+	i.soak.release()
 
 	i.encodings = nil
 
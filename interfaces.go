@@ -0,0 +1,57 @@
+package mediaserver
+
+import (
+	"github.com/notedit/sdp"
+)
+
+// IncomingTrack the methods of IncomingStreamTrack that do not expose native objects,
+// so application logic can be tested with mocks without the native library
+type IncomingTrack interface {
+	GetID() string
+	GetMedia() string
+	GetTrackInfo() *sdp.TrackInfo
+	GetCodecs() []string
+	GetStats() map[string]*IncomingAllStats
+	GetActiveLayers() *ActiveLayersInfo
+	OnAttach(attach func()) ListenerOff
+	OnDetach(detach func()) ListenerOff
+	OnStop(stop func()) ListenerOff
+	Stop()
+}
+
+// OutgoingTrack the methods of OutgoingStreamTrack that do not expose native objects, see IncomingTrack.
+// AttachTo is not included as it needs the concrete incoming track
+type OutgoingTrack interface {
+	GetID() string
+	GetMedia() string
+	GetTrackInfo() *sdp.TrackInfo
+	GetCodecs() []string
+	GetStats() *OutgoingStatss
+	IsMuted() bool
+	Mute(muting bool)
+	OnMute(mute func(bool)) ListenerOff
+	Detach()
+	Stop()
+}
+
+// MediaTransport the methods of Transport used for signaling and connection monitoring, see IncomingTrack
+type MediaTransport interface {
+	GetLocalICEInfo() *sdp.ICEInfo
+	GetLocalDTLSInfo() *sdp.DTLSInfo
+	GetLocalCandidates() []*sdp.CandidateInfo
+	AddRemoteCandidate(candidate *sdp.CandidateInfo)
+	GetDTLSState() string
+	GetState() TransportState
+	OnStateChange(listener TransportStateListener) ListenerOff
+	GetICEStats() *ICEStats
+	GetFeedbackStats() *FeedbackStats
+	SetBandwidthProbing(probe bool)
+	SetMaxProbingBitrate(bitrate uint)
+	Stop()
+}
+
+var (
+	_ IncomingTrack  = (*IncomingStreamTrack)(nil)
+	_ OutgoingTrack  = (*OutgoingStreamTrack)(nil)
+	_ MediaTransport = (*Transport)(nil)
+)
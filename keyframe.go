@@ -0,0 +1,239 @@
+package mediaserver
+
+import (
+	"sync"
+	"time"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// KeyframeConfig what a transponder does to get a keyframe when it starts forwarding a new video source,
+// on attach and on simulcast encoding or spatial layer changes
+type KeyframeConfig struct {
+	// RequestPLI send a PLI upstream, at most once every MinPLIInterval per encoding, 500ms if zero
+	RequestPLI     bool
+	MinPLIInterval time.Duration
+	// WaitForKeyframe do not forward anything until the new source sends a keyframe, for at most Timeout, 2s if zero
+	WaitForKeyframe bool
+	Timeout         time.Duration
+}
+
+// requestKeyframe send a PLI for an encoding unless one was sent less than minInterval ago, returns whether it was sent
+func (i *IncomingStreamTrack) requestKeyframe(encoding *Encoding, minInterval time.Duration) bool {
+
+	if minInterval <= 0 {
+		minInterval = 500 * time.Millisecond
+	}
+
+	i.l.Lock()
+	now := time.Now()
+	if now.Sub(encoding.lastPLI) < minInterval {
+		i.l.Unlock()
+		return false
+	}
+	encoding.lastPLI = now
+	i.l.Unlock()
+
+	i.receiver.SendPLI(encoding.source.GetMedia().GetSsrc())
+
+	return true
+}
+
+// keyframeWatcher depacketize an encoding until its next keyframe
+type keyframeWatcher struct {
+	multiplexer native.MediaFrameMultiplexer
+	listener    mediaframeListener
+	done        func()
+	fired       sync.Once
+	stopped     sync.Once
+}
+
+type keyframeListener struct {
+	watcher *keyframeWatcher
+}
+
+func (k *keyframeListener) OnMediaFrame(frame native.MediaFrame) {
+	if native.MediaFrameIsIntra(frame) {
+		// Removing the listener synchronizes with the media thread we are called from
+		go k.watcher.fire()
+	}
+}
+
+func newKeyframeWatcher(encoding *Encoding, done func()) *keyframeWatcher {
+
+	watcher := &keyframeWatcher{done: done}
+
+	listener := native.NewDirectorMediaFrameListenerFacade(&keyframeListener{watcher: watcher})
+	watcher.listener = &goMediaFrameListener{MediaFrameListenerFacade: listener}
+
+	watcher.multiplexer = native.NewMediaFrameMultiplexer(encoding.GetSource())
+	watcher.multiplexer.AddMediaListener(watcher.listener)
+
+	return watcher
+}
+
+func (w *keyframeWatcher) fire() {
+	w.fired.Do(func() {
+		w.stop()
+		w.done()
+	})
+}
+
+func (w *keyframeWatcher) stop() {
+	w.stopped.Do(func() {
+		w.multiplexer.RemoveMediaListener(w.listener)
+		w.multiplexer.Stop()
+		w.listener.deleteMediaFrameListener()
+		native.DeleteMediaFrameMultiplexer(w.multiplexer)
+	})
+}
+
+// SetKeyframeConfig set how keyframes are obtained when the forwarded source changes, nil to do nothing
+func (t *Transponder) SetKeyframeConfig(config *KeyframeConfig) {
+	t.keyframeLock.Lock()
+	t.keyframe = config
+	t.keyframeLock.Unlock()
+}
+
+// onSourceChange get a keyframe of the encoding the transponder has started forwarding
+func (t *Transponder) onSourceChange(encoding *Encoding) {
+
+	if t.track == nil || t.track.GetMedia() != "video" {
+		return
+	}
+
+	// The cached keyframe can be decoded right away, no need to wait for a new one
+	replayed := !t.muted && t.track.replayKeyframe(encoding, t.transponder)
+
+	t.keyframeLock.Lock()
+	config := t.keyframe
+	t.keyframeLock.Unlock()
+
+	if config == nil {
+		return
+	}
+
+	if config.WaitForKeyframe && !replayed {
+		t.waitKeyframe(encoding, config.Timeout)
+	}
+
+	if config.RequestPLI {
+		t.track.requestKeyframe(encoding, config.MinPLIInterval)
+	}
+}
+
+// waitKeyframe mute the native transponder until the encoding sends a keyframe or timeout
+func (t *Transponder) waitKeyframe(encoding *Encoding, timeout time.Duration) {
+
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+
+	t.keyframeLock.Lock()
+	defer t.keyframeLock.Unlock()
+
+	t.cancelWaitKeyframe()
+
+	t.transponder.Mute(true)
+
+	var watcher *keyframeWatcher
+	received := func() {
+		t.keyframeReceived(watcher)
+	}
+	watcher = newKeyframeWatcher(encoding, received)
+	t.keyframeWatcher = watcher
+	t.keyframeTimer = time.AfterFunc(timeout, received)
+}
+
+func (t *Transponder) keyframeReceived(watcher *keyframeWatcher) {
+
+	t.keyframeLock.Lock()
+	defer t.keyframeLock.Unlock()
+
+	// A newer wait has replaced this one
+	if t.keyframeWatcher != watcher {
+		return
+	}
+
+	t.cancelWaitKeyframe()
+
+	if !t.muted && t.transponder != nil {
+		t.transponder.Mute(false)
+	}
+}
+
+// cancelWaitKeyframe must be called with the keyframe lock held
+func (t *Transponder) cancelWaitKeyframe() {
+
+	if t.keyframeWatcher == nil {
+		return
+	}
+
+	t.keyframeTimer.Stop()
+	go t.keyframeWatcher.stop()
+
+	t.keyframeWatcher = nil
+	t.keyframeTimer = nil
+}
+
+func (t *Transponder) isWaitingKeyframe() bool {
+	t.keyframeLock.Lock()
+	defer t.keyframeLock.Unlock()
+	return t.keyframeWatcher != nil
+}
+
+// SetKeyframeConfig set how the transponders of this track get a keyframe when they start forwarding a new video source
+func (o *OutgoingStreamTrack) SetKeyframeConfig(config *KeyframeConfig) {
+	o.keyframe = config
+	if o.transpoder != nil {
+		o.transpoder.SetKeyframeConfig(config)
+	}
+}
+
+// EnableKeyframeCache keep the last keyframe of each encoding of the video track, with the SPS/PPS sent along it, and replay it
+// to the transponders as soon as they start forwarding the encoding, so new viewers see video without waiting for the PLI round trip.
+// Keyframes older than maxAge are not replayed, zero for no limit; the frames following the replayed keyframe may reference
+// frames not sent, so KeyframeConfig.RequestPLI should still be set to get a fresh keyframe
+func (i *IncomingStreamTrack) EnableKeyframeCache(maxAge time.Duration) {
+
+	if i.GetMedia() != "video" {
+		return
+	}
+
+	i.l.Lock()
+	defer i.l.Unlock()
+
+	i.keyframeMaxAge = maxAge
+	for _, encoding := range i.encodings {
+		if encoding.keyframes == nil {
+			encoding.keyframes = native.NewKeyframeCache(encoding.source)
+		}
+	}
+}
+
+// DisableKeyframeCache stop caching the keyframes of the track
+func (i *IncomingStreamTrack) DisableKeyframeCache() {
+
+	i.l.Lock()
+	defer i.l.Unlock()
+
+	for _, encoding := range i.encodings {
+		if encoding.keyframes != nil {
+			encoding.keyframes.Stop()
+			native.DeleteKeyframeCache(encoding.keyframes)
+			encoding.keyframes = nil
+		}
+	}
+}
+
+// replayKeyframe send the cached keyframe of the encoding through the transponder, returns whether there was one
+func (i *IncomingStreamTrack) replayKeyframe(encoding *Encoding, transponder native.RTPStreamTransponderFacade) bool {
+
+	i.l.Lock()
+	defer i.l.Unlock()
+
+	if encoding.keyframes == nil || transponder == nil {
+		return false
+	}
+	return encoding.keyframes.Replay(transponder, uint(i.keyframeMaxAge/time.Millisecond))
+}
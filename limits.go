@@ -0,0 +1,74 @@
+package mediaserver
+
+import (
+	"fmt"
+
+	"github.com/notedit/sdp"
+)
+
+// Limits bound the number of native objects a remote description can make us allocate
+// A zero value means no limit
+type Limits struct {
+	// MaxStreamsPerTransport max incoming or outgoing streams in one Transport
+	MaxStreamsPerTransport int
+	// MaxTracksPerStream max tracks in one stream
+	MaxTracksPerStream int
+	// MaxEncodingsPerTrack max simulcast encodings in one track
+	MaxEncodingsPerTrack int
+}
+
+// DefaultLimits limits used by new transports, none by default
+var DefaultLimits = Limits{}
+
+// LimitError is returned when a configured limit is exceeded
+type LimitError struct {
+	// Limit name of the exceeded limit, "streams" "tracks" or "encodings"
+	Limit string
+	// Max configured value
+	Max int
+	// Count requested value
+	Count int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("too many %s: %d exceeds limit %d", e.Limit, e.Count, e.Max)
+}
+
+func checkLimit(limit string, max int, count int) error {
+	if max > 0 && count > max {
+		return &LimitError{Limit: limit, Max: max, Count: count}
+	}
+	return nil
+}
+
+// CheckTrackInfo check the track encodings against the limits
+func (l Limits) CheckTrackInfo(track *sdp.TrackInfo) error {
+
+	count := 0
+	for _, alternatives := range track.GetEncodings() {
+		count += len(alternatives)
+	}
+
+	if SIM := track.GetSourceGroup("SIM"); count == 0 && SIM != nil {
+		count = len(SIM.GetSSRCs())
+	}
+
+	return checkLimit("encodings", l.MaxEncodingsPerTrack, count)
+}
+
+// CheckStreamInfo check the stream tracks and its encodings against the limits
+func (l Limits) CheckStreamInfo(stream *sdp.StreamInfo) error {
+
+	tracks := stream.GetTracks()
+
+	if err := checkLimit("tracks", l.MaxTracksPerStream, len(tracks)); err != nil {
+		return err
+	}
+
+	for _, track := range tracks {
+		if err := l.CheckTrackInfo(track); err != nil {
+			return err
+		}
+	}
+	return nil
+}
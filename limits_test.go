@@ -0,0 +1,98 @@
+package mediaserver
+
+import (
+	"testing"
+
+	"github.com/notedit/sdp"
+)
+
+func Test_LimitsCheckStreamInfo(t *testing.T) {
+
+	limits := Limits{MaxTracksPerStream: 2, MaxEncodingsPerTrack: 2}
+
+	stream := sdp.NewStreamInfo("stream")
+	stream.AddTrack(sdp.NewTrackInfo("audio", "audio"))
+	stream.AddTrack(sdp.NewTrackInfo("video", "video"))
+
+	if err := limits.CheckStreamInfo(stream); err != nil {
+		t.Error("unexpected limit error", err)
+	}
+
+	stream.AddTrack(sdp.NewTrackInfo("video2", "video"))
+
+	err, ok := limits.CheckStreamInfo(stream).(*LimitError)
+	if !ok || err.Limit != "tracks" || err.Count != 3 {
+		t.Error("expected tracks limit error", err)
+	}
+
+	track := sdp.NewTrackInfo("simulcast", "video")
+	track.AddSourceGroup(sdp.NewSourceGroupInfo("SIM", []uint{1, 2, 3}))
+
+	err, ok = limits.CheckTrackInfo(track).(*LimitError)
+	if !ok || err.Limit != "encodings" {
+		t.Error("expected encodings limit error", err)
+	}
+}
+
+func Test_CreateStreamChecked(t *testing.T) {
+
+	if DefaultLimits != (Limits{}) {
+		t.Error("expected no default limits", DefaultLimits)
+	}
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	transport.SetLimits(Limits{MaxStreamsPerTransport: 1})
+
+	if _, err := transport.CreateOutgoingStreamChecked(sdp.NewStreamInfo("out1")); err != nil {
+		t.Error("unexpected error", err)
+	}
+	outgoing, err := transport.CreateOutgoingStreamChecked(sdp.NewStreamInfo("out2"))
+	if limit, ok := err.(*LimitError); outgoing != nil || !ok || limit.Limit != "streams" {
+		t.Error("expected streams limit error", err)
+	}
+
+	if _, err := transport.CreateIncomingStreamChecked(sdp.NewStreamInfo("in1")); err != nil {
+		t.Error("unexpected error", err)
+	}
+	incoming, err := transport.CreateIncomingStreamChecked(sdp.NewStreamInfo("in2"))
+	if limit, ok := err.(*LimitError); incoming != nil || !ok || limit.Limit != "streams" {
+		t.Error("expected streams limit error", err)
+	}
+	if transport.CreateIncomingStream(sdp.NewStreamInfo("in3")) != nil {
+		t.Error("expected nil stream over the limit")
+	}
+}
+
+func Test_StoppedStreamsReleaseLimit(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	defer transport.Stop()
+	transport.SetLimits(Limits{MaxStreamsPerTransport: 1})
+
+	// the stopped streams do not count anymore and their ids can be used again
+	for i := 0; i < 3; i++ {
+		outgoing, err := transport.CreateOutgoingStreamChecked(sdp.NewStreamInfo("out"))
+		if err != nil {
+			t.Fatal("unexpected error", i, err)
+		}
+		outgoing.Stop()
+		if transport.GetOutgoingStream("out") != nil {
+			t.Fatal("expected the outgoing stream removed")
+		}
+
+		info := sdp.NewStreamInfo("in")
+		info.AddTrack(newTestTrackInfo("video", 1))
+		incoming, err := transport.CreateIncomingStreamChecked(info)
+		if err != nil {
+			t.Fatal("unexpected error", i, err)
+		}
+		incoming.Stop()
+		if transport.GetIncomingStream("in") != nil || transport.isIncomingTrackIDTaken("video") {
+			t.Fatal("expected the incoming stream removed")
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package mediaserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Marker a chapter or cue point of a recording, ie a slide change or the start of the Q&A
+type Marker struct {
+	Name     string            `json:"name"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Offset   time.Duration     `json:"offset"`
+}
+
+// MarkerWriter write markers as a JSON lines sidecar file, one marker per line, offsets are relative to the writer origin
+type MarkerWriter struct {
+	file    *os.File
+	origin  time.Time
+	markers []*Marker
+	sync.Mutex
+}
+
+// NewMarkerWriter create the markers file, origin is the time of the 0 offset
+func NewMarkerWriter(filename string, origin time.Time) (*MarkerWriter, error) {
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MarkerWriter{
+		file:   file,
+		origin: origin,
+	}, nil
+}
+
+// WriteMarker append a marker at the given time, markers before the origin are clamped to it
+func (w *MarkerWriter) WriteMarker(name string, metadata map[string]string, when time.Time) (*Marker, error) {
+
+	if name == "" {
+		return nil, errors.New("marker name is empty")
+	}
+
+	offset := when.Sub(w.origin)
+	if offset < 0 {
+		offset = 0
+	}
+
+	marker := &Marker{
+		Name:     name,
+		Metadata: metadata,
+		Offset:   offset,
+	}
+
+	line, err := json.Marshal(marker)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	if w.file == nil {
+		return nil, errors.New("marker writer closed")
+	}
+
+	// written unbuffered so the markers survive a crash of the recording
+	if _, err := w.file.Write(append(line, '\n')); err != nil {
+		return nil, err
+	}
+
+	w.markers = append(w.markers, marker)
+	return marker, nil
+}
+
+// GetMarkers get the markers written so far
+func (w *MarkerWriter) GetMarkers() []*Marker {
+
+	w.Lock()
+	defer w.Unlock()
+
+	return append([]*Marker(nil), w.markers...)
+}
+
+// Close close the file
+func (w *MarkerWriter) Close() error {
+
+	w.Lock()
+	defer w.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+func escapeFFMetadata(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "=", "\\=", ";", "\\;", "#", "\\#", "\n", "\\\n").Replace(value)
+}
+
+// FormatFFMetadataChapters format the markers as an ffmpeg metadata file, each marker is a chapter lasting until the next one
+// or the end of the recording. It can be muxed into the mp4 with "ffmpeg -i rec.mp4 -i chapters.txt -map_metadata 1 -map_chapters 1 -c copy"
+func FormatFFMetadataChapters(markers []*Marker, duration time.Duration) string {
+
+	var b strings.Builder
+	b.WriteString(";FFMETADATA1\n")
+
+	for i, marker := range markers {
+		end := duration
+		if i+1 < len(markers) {
+			end = markers[i+1].Offset
+		}
+		if end < marker.Offset {
+			end = marker.Offset
+		}
+		fmt.Fprintf(&b, "\n[CHAPTER]\nTIMEBASE=1/1000\nSTART=%d\nEND=%d\ntitle=%s\n",
+			marker.Offset.Milliseconds(), end.Milliseconds(), escapeFFMetadata(marker.Name))
+	}
+	return b.String()
+}
+
+// EnableMarkers write the markers added with AddMarker to a sidecar file, timed from the recording start
+func (r *Recorder) EnableMarkers(filename string) error {
+
+	if r.markers != nil {
+		return errors.New("markers already enabled")
+	}
+
+	markers, err := NewMarkerWriter(filename, r.started)
+	if err != nil {
+		return err
+	}
+	r.markers = markers
+	return nil
+}
+
+// AddMarker add a chapter marker at the current recording time, EnableMarkers must be called first
+func (r *Recorder) AddMarker(name string, metadata map[string]string) (*Marker, error) {
+
+	if r.markers == nil {
+		return nil, errors.New("markers not enabled")
+	}
+	return r.markers.WriteMarker(name, metadata, time.Now())
+}
+
+// GetMarkers get the markers added to the recording
+func (r *Recorder) GetMarkers() []*Marker {
+
+	if r.markers == nil {
+		return nil
+	}
+	return r.markers.GetMarkers()
+}
+
+// WriteChapters write the markers as an ffmpeg chapters metadata file, see FormatFFMetadataChapters
+func (r *Recorder) WriteChapters(filename string) error {
+
+	if r.markers == nil {
+		return errors.New("markers not enabled")
+	}
+
+	duration := time.Since(r.started)
+	if !r.stopped.IsZero() {
+		duration = r.stopped.Sub(r.started)
+	}
+	return ioutil.WriteFile(filename, []byte(FormatFFMetadataChapters(r.markers.GetMarkers(), duration)), 0644)
+}
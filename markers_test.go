@@ -0,0 +1,22 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_FormatFFMetadataChapters(t *testing.T) {
+
+	markers := []*Marker{
+		{Name: "Intro", Offset: 0},
+		{Name: "Q=A", Offset: 90 * time.Second},
+	}
+
+	expected := ";FFMETADATA1\n" +
+		"\n[CHAPTER]\nTIMEBASE=1/1000\nSTART=0\nEND=90000\ntitle=Intro\n" +
+		"\n[CHAPTER]\nTIMEBASE=1/1000\nSTART=90000\nEND=120000\ntitle=Q\\=A\n"
+
+	if chapters := FormatFFMetadataChapters(markers, 2*time.Minute); chapters != expected {
+		t.Error("unexpected chapters", chapters)
+	}
+}
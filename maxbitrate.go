@@ -0,0 +1,105 @@
+package mediaserver
+
+import (
+	"math"
+)
+
+// SetMaxOutgoingBitrate cap the aggregate bitrate in bps sent by all the outgoing tracks of the transport, zero removes the cap.
+// It is enforced by the native pacer, which is enabled with the default config if pacing is disabled, and the bandwidth probing
+// is paused over it. The transponders of the video tracks do not select layers over their share of the cap, the bitrate left
+// by the audio tracks split evenly, so SetTargetBitrate is capped too; use a TransponderGroup on top for a weighted split
+func (t *Transport) SetMaxOutgoingBitrate(bps uint) {
+
+	t.Lock()
+	if t.transport == nil {
+		t.Unlock()
+		return
+	}
+	t.maxOutgoingBitrate = bps
+	pacing := t.pacer != nil
+	implicit := t.pacerForCap
+	limit := t.probing.BitrateLimit
+	t.Unlock()
+
+	switch {
+	case bps > 0 && !pacing:
+		t.SetPacing(PacerConfig{})
+		t.Lock()
+		t.pacerForCap = t.pacer != nil
+		t.Unlock()
+	case bps == 0 && implicit:
+		t.DisablePacing()
+	}
+
+	if bps > 0 && (limit == 0 || bps < limit) {
+		limit = bps
+	}
+	if limit == 0 {
+		// no limit
+		limit = math.MaxUint32
+	}
+	t.transport.SetProbingBitrateLimit(limit)
+
+	t.retargetTransponders()
+}
+
+// GetMaxOutgoingBitrate get the cap of the aggregate outgoing bitrate, zero if there is none
+func (t *Transport) GetMaxOutgoingBitrate() uint {
+	t.Lock()
+	defer t.Unlock()
+	return t.maxOutgoingBitrate
+}
+
+// capPacerBitrate limit the pacer bitrate to the outgoing cap, called locked
+func (t *Transport) capPacerBitrate(bitrate uint) uint {
+	if t.maxOutgoingBitrate > 0 && bitrate > t.maxOutgoingBitrate {
+		return t.maxOutgoingBitrate
+	}
+	return bitrate
+}
+
+// maxVideoTrackBitrate the share of the outgoing cap of each attached video track, zero if there is no cap
+func (t *Transport) maxVideoTrackBitrate() uint {
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.maxOutgoingBitrate == 0 {
+		return 0
+	}
+
+	var audio uint
+	video := uint(0)
+	for _, track := range t.outgoingStreamTracks {
+		if track.media == "audio" {
+			audio += getStatsFromOutgoingSource(track.source.GetMedia()).Bitrate
+		} else if track.transpoder != nil {
+			video++
+		}
+	}
+	if video == 0 {
+		video = 1
+	}
+	if audio >= t.maxOutgoingBitrate {
+		// nothing left, the lowest layer is selected when not strict
+		return 1
+	}
+	return (t.maxOutgoingBitrate - audio) / video
+}
+
+// retargetTransponders select again the layers of the video transponders for the current cap
+func (t *Transport) retargetTransponders() {
+
+	t.Lock()
+	transponders := make([]*Transponder, 0, len(t.outgoingStreamTracks))
+	for _, track := range t.outgoingStreamTracks {
+		if track.media == "video" && track.transpoder != nil {
+			transponders = append(transponders, track.transpoder)
+		}
+	}
+	t.Unlock()
+
+	for _, transponder := range transponders {
+		transponder.retarget()
+	}
+}
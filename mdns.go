@@ -0,0 +1,250 @@
+package mediaserver
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/notedit/sdp"
+)
+
+// mdnsAddress multicast group and port of mDNS, RFC 6762
+var mdnsAddress = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: 5353}
+
+// ErrMDNSTimeout is returned when no mDNS responder answered before the timeout
+var ErrMDNSTimeout = errors.New("mdns resolution timed out")
+
+// isMDNSCandidate check if the candidate address is an mDNS hostname, as sent by browsers to hide the local ip
+func isMDNSCandidate(candidate *sdp.CandidateInfo) bool {
+	return strings.HasSuffix(strings.ToLower(candidate.GetAddress()), ".local")
+}
+
+type mdnsEntry struct {
+	ip      string
+	expires time.Time
+}
+
+// MDNSResolver resolve the .local hostnames of remote candidates with one shot mDNS queries, caching the results
+type MDNSResolver struct {
+	timeout time.Duration
+	ttl     time.Duration
+	cache   map[string]*mdnsEntry
+	sync.Mutex
+}
+
+// NewMDNSResolver create a resolver waiting timeout for an answer and caching the resolved addresses for ttl
+func NewMDNSResolver(timeout, ttl time.Duration) *MDNSResolver {
+	return &MDNSResolver{
+		timeout: timeout,
+		ttl:     ttl,
+		cache:   make(map[string]*mdnsEntry),
+	}
+}
+
+// Resolve get the IPv4 address of the hostname
+func (r *MDNSResolver) Resolve(hostname string) (string, error) {
+
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+
+	r.Lock()
+	entry := r.cache[hostname]
+	r.Unlock()
+
+	if entry != nil && time.Now().Before(entry.expires) {
+		return entry.ip, nil
+	}
+
+	ip, err := r.query(hostname)
+	if err != nil {
+		return "", err
+	}
+
+	r.Lock()
+	r.cache[hostname] = &mdnsEntry{ip: ip, expires: time.Now().Add(r.ttl)}
+	r.Unlock()
+
+	return ip, nil
+}
+
+// query send a one shot query from an ephemeral port, responders answer it with unicast, RFC 6762 section 5.1
+func (r *MDNSResolver) query(hostname string) (string, error) {
+
+	query, err := buildMDNSQuery(hostname)
+	if err != nil {
+		return "", err
+	}
+
+	conn, err := net.ListenUDP("udp4", nil)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(r.timeout)
+	conn.SetDeadline(deadline)
+
+	if _, err := conn.WriteToUDP(query, mdnsAddress); err != nil {
+		return "", err
+	}
+
+	buffer := make([]byte, 1500)
+	for {
+		n, _, err := conn.ReadFromUDP(buffer)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				return "", ErrMDNSTimeout
+			}
+			return "", err
+		}
+		if ip := parseMDNSAnswer(buffer[:n], hostname); ip != "" {
+			return ip, nil
+		}
+	}
+}
+
+func appendDNSName(message []byte, name string) ([]byte, error) {
+	for _, label := range strings.Split(name, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return nil, errors.New("invalid hostname")
+		}
+		message = append(message, byte(len(label)))
+		message = append(message, label...)
+	}
+	return append(message, 0), nil
+}
+
+// buildMDNSQuery build a query for the A record of the hostname
+func buildMDNSQuery(hostname string) ([]byte, error) {
+
+	// id, flags, 1 question, no answer, authority or additional records
+	message := []byte{0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0}
+
+	message, err := appendDNSName(message, hostname)
+	if err != nil {
+		return nil, err
+	}
+	// type A, class IN
+	return append(message, 0, 1, 0, 1), nil
+}
+
+// readDNSName read a possibly compressed name at offset, returning it and the offset after it
+func readDNSName(message []byte, offset int) (string, int, error) {
+
+	labels := []string{}
+	next := -1
+
+	for jumps := 0; jumps < 16; {
+		if offset >= len(message) {
+			return "", 0, errors.New("truncated name")
+		}
+		length := int(message[offset])
+		switch {
+		case length == 0:
+			if next < 0 {
+				next = offset + 1
+			}
+			return strings.Join(labels, "."), next, nil
+		case length&0xc0 == 0xc0:
+			if offset+1 >= len(message) {
+				return "", 0, errors.New("truncated name")
+			}
+			if next < 0 {
+				next = offset + 2
+			}
+			offset = int(binary.BigEndian.Uint16(message[offset:]) & 0x3fff)
+			jumps++
+		default:
+			if offset+1+length > len(message) {
+				return "", 0, errors.New("truncated name")
+			}
+			labels = append(labels, string(message[offset+1:offset+1+length]))
+			offset += 1 + length
+		}
+	}
+	return "", 0, errors.New("too many name pointers")
+}
+
+// parseMDNSAnswer get the address of the A record of hostname in a response, empty if there is none
+func parseMDNSAnswer(message []byte, hostname string) string {
+
+	if len(message) < 12 || message[2]&0x80 == 0 {
+		return ""
+	}
+
+	questions := int(binary.BigEndian.Uint16(message[4:]))
+	records := int(binary.BigEndian.Uint16(message[6:])) + int(binary.BigEndian.Uint16(message[8:])) + int(binary.BigEndian.Uint16(message[10:]))
+
+	offset := 12
+	for i := 0; i < questions; i++ {
+		_, next, err := readDNSName(message, offset)
+		if err != nil {
+			return ""
+		}
+		offset = next + 4
+	}
+
+	for i := 0; i < records; i++ {
+		name, next, err := readDNSName(message, offset)
+		if err != nil || next+10 > len(message) {
+			return ""
+		}
+		rtype := binary.BigEndian.Uint16(message[next:])
+		length := int(binary.BigEndian.Uint16(message[next+8:]))
+		data := next + 10
+		if data+length > len(message) {
+			return ""
+		}
+		if rtype == 1 && length == 4 && strings.EqualFold(name, hostname) {
+			return net.IP(message[data : data+4]).String()
+		}
+		offset = data + length
+	}
+	return ""
+}
+
+// SetMDNSResolver resolve the .local remote candidates with the resolver, the ones already known are resolved in background.
+// Without a resolver they are ignored, the native transport only accepts ip addresses
+func (t *Transport) SetMDNSResolver(resolver *MDNSResolver) {
+
+	t.Lock()
+	t.mdns = resolver
+	candidates := append([]*sdp.CandidateInfo(nil), t.remoteCandidates...)
+	t.Unlock()
+
+	if resolver == nil {
+		return
+	}
+
+	for _, candidate := range candidates {
+		if isMDNSCandidate(candidate) {
+			go t.addMDNSCandidate(resolver, candidate)
+		}
+	}
+}
+
+// addMDNSCandidate resolve the candidate and register the resolved address in the bundle
+func (t *Transport) addMDNSCandidate(resolver *MDNSResolver, candidate *sdp.CandidateInfo) {
+
+	ip, err := resolver.Resolve(candidate.GetAddress())
+	if err != nil {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.bundle == nil {
+		return
+	}
+	t.bundle.AddRemoteCandidate(t.username, ip, uint16(candidate.GetPort()))
+}
+
+// SetMDNSResolver resolve the .local remote candidates of the transports created after with the resolver
+func (e *Endpoint) SetMDNSResolver(resolver *MDNSResolver) {
+	e.Lock()
+	e.mdns = resolver
+	e.Unlock()
+}
@@ -0,0 +1,33 @@
+package mediaserver
+
+import (
+	"testing"
+)
+
+func Test_ParseMDNSAnswer(t *testing.T) {
+
+	hostname := "1f4712db-ea17-4bcf-a596-105139dfd8bf.local"
+
+	query, err := buildMDNSQuery(hostname)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// response echoing the question, with the answer name compressed as a pointer to it
+	response := append([]byte{}, query...)
+	response[2] = 0x84
+	response[7] = 1
+	response = append(response, 0xc0, 12, 0, 1, 0x80, 1, 0, 0, 0, 120, 0, 4, 192, 168, 1, 34)
+
+	if ip := parseMDNSAnswer(response, hostname); ip != "192.168.1.34" {
+		t.Error("unexpected address", ip)
+	}
+
+	if ip := parseMDNSAnswer(response, "other.local"); ip != "" {
+		t.Error("answer for another hostname accepted", ip)
+	}
+
+	if ip := parseMDNSAnswer(query, hostname); ip != "" {
+		t.Error("query accepted as a response", ip)
+	}
+}
@@ -1,7 +1,9 @@
 package mediaserver
 
-import "C"
 import (
+	"sync"
+	"time"
+
 	native "github.com/notedit/media-server-go/wrapper"
 )
 
@@ -12,6 +14,9 @@ type MediaFrameMultiplexer struct {
 	listener    mediaframeListener // used for native wrapper, see swig's doc
 
 	mediaframeListener func([]byte, uint64) // used for outside
+	frameListener      func(uint64, uint)   // timestamp and clock rate only, used for stats
+	budget             *frameBudget
+	l                  sync.Mutex // guards the listeners and the budget, read from the native thread
 }
 
 type mediaframeListener interface {
@@ -34,20 +39,34 @@ type overwrittenMediaFrameListener struct {
 
 func (p *overwrittenMediaFrameListener) OnMediaFrame(frame native.MediaFrame) {
 
-	//if p.multiplexer != nil && p.multiplexer.mediaframeListener != nil {
-	//	buffer := C.GoBytes(unsafe.Pointer(frame.GetData()), C.int(frame.GetLength()))
-	//	if frame.GetType() == native.MediaFrameVideo {
-	//		data, err := annexbConvert(buffer)
-	//		if err == nil {
-	//			p.multiplexer.mediaframeListener(data, frame.GetTimeStamp())
-	//		} else {
-	//			fmt.Println(err)
-	//		}
-	//	} else {
-	//		p.multiplexer.mediaframeListener(buffer, frame.GetTimeStamp())
-	//	}
-	//
-	//}
+	if p.multiplexer == nil {
+		return
+	}
+
+	mediaframeListener, frameListener, budget := p.multiplexer.getListeners()
+
+	if frameListener != nil {
+		frameListener(native.MediaFrameGetTimestamp(frame), native.MediaFrameGetClockRate(frame))
+	}
+
+	if mediaframeListener == nil && budget == nil {
+		return
+	}
+
+	length := native.MediaFrameGetLength(frame)
+	if length == 0 {
+		return
+	}
+
+	buffer := make([]byte, length)
+	native.MediaFrameCopyData(frame, &buffer[0], len(buffer))
+
+	if budget != nil {
+		budget.handle(buffer, native.MediaFrameGetTimestamp(frame))
+		return
+	}
+
+	mediaframeListener(buffer, native.MediaFrameGetTimestamp(frame))
 }
 
 // NewMediaStreamDuplicater duplicate this IncomingStreamTrack and callback the mediaframe
@@ -73,9 +92,58 @@ func NewMediaFrameMultiplexer(track *IncomingStreamTrack) *MediaFrameMultiplexer
 	return duplicater
 }
 
-// SetMediaFrameListener set outside mediaframe listener
+// SetMediaFrameListener set outside mediaframe listener, called with a copy of each depacketized frame and its rtp timestamp
+// H264 frames are length prefixed (AVCC), see annexbConvert
 func (d *MediaFrameMultiplexer) SetMediaFrameListener(listener func([]byte, uint64)) {
+
+	d.l.Lock()
+	budget := d.budget
+	d.budget = nil
 	d.mediaframeListener = listener
+	d.l.Unlock()
+
+	if budget != nil {
+		budget.stop()
+	}
+}
+
+// SetMediaFrameListenerWithBudget set the outside mediaframe listener running it off the media path,
+// if it takes longer than budget for a frame the media goes on without it and onOverrun is called.
+// Frames arriving while the listener is still busy are skipped
+func (d *MediaFrameMultiplexer) SetMediaFrameListenerWithBudget(listener func([]byte, uint64), budget time.Duration, onOverrun FrameOverrunListener) {
+
+	d.l.Lock()
+	previous := d.budget
+	d.mediaframeListener = nil
+	d.budget = newFrameBudget(budget, listener, onOverrun)
+	d.l.Unlock()
+
+	if previous != nil {
+		previous.stop()
+	}
+}
+
+// setFrameListener set the timing listener, called from the native thread before the mediaframe listener
+func (d *MediaFrameMultiplexer) setFrameListener(listener func(uint64, uint)) {
+	d.l.Lock()
+	d.frameListener = listener
+	d.l.Unlock()
+}
+
+// getListeners load the listeners once per frame so they can be replaced while the native thread runs them
+func (d *MediaFrameMultiplexer) getListeners() (func([]byte, uint64), func(uint64, uint), *frameBudget) {
+	d.l.Lock()
+	defer d.l.Unlock()
+	return d.mediaframeListener, d.frameListener, d.budget
+}
+
+// GetOverruns get the number of frames for which the listener exceeded its budget
+func (d *MediaFrameMultiplexer) GetOverruns() uint64 {
+	_, _, budget := d.getListeners()
+	if budget == nil {
+		return 0
+	}
+	return budget.getOverruns()
 }
 
 // Stop stop this
@@ -90,5 +158,9 @@ func (d *MediaFrameMultiplexer) Stop() {
 		d.listener.deleteMediaFrameListener()
 	}
 
+	if _, _, budget := d.getListeners(); budget != nil {
+		budget.stop()
+	}
+
 	d.track = nil
 }
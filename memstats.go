@@ -0,0 +1,31 @@
+package mediaserver
+
+import (
+	"sync/atomic"
+)
+
+var (
+	numTransports           int64
+	numIncomingSourceGroups int64
+	numOutgoingSourceGroups int64
+	numRecorders            int64
+)
+
+// NativeMemStats native objects currently alive. The native layer does not report its allocations,
+// so leaks are spotted by counts that keep growing rather than by bytes
+type NativeMemStats struct {
+	Transports           int64
+	IncomingSourceGroups int64
+	OutgoingSourceGroups int64
+	Recorders            int64
+}
+
+// MemStats get native objects counts, so leaks can be spotted before OOM
+func MemStats() *NativeMemStats {
+	return &NativeMemStats{
+		Transports:           atomic.LoadInt64(&numTransports),
+		IncomingSourceGroups: atomic.LoadInt64(&numIncomingSourceGroups),
+		OutgoingSourceGroups: atomic.LoadInt64(&numOutgoingSourceGroups),
+		Recorders:            atomic.LoadInt64(&numRecorders),
+	}
+}
@@ -0,0 +1,47 @@
+package mediaserver
+
+import (
+	"sync"
+)
+
+// metadata thread safe application metadata attached to streams and tracks, like display name or device label
+type metadata struct {
+	values map[string]string
+	ml     sync.RWMutex
+}
+
+// SetMetadata set an application metadata value
+func (m *metadata) SetMetadata(key string, value string) {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+	if m.values == nil {
+		m.values = make(map[string]string)
+	}
+	m.values[key] = value
+}
+
+// GetMetadata get an application metadata value
+func (m *metadata) GetMetadata(key string) (string, bool) {
+	m.ml.RLock()
+	defer m.ml.RUnlock()
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// DeleteMetadata remove an application metadata value
+func (m *metadata) DeleteMetadata(key string) {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+	delete(m.values, key)
+}
+
+// GetAllMetadata get a copy of all the application metadata values
+func (m *metadata) GetAllMetadata() map[string]string {
+	m.ml.RLock()
+	defer m.ml.RUnlock()
+	values := make(map[string]string, len(m.values))
+	for key, value := range m.values {
+		values[key] = value
+	}
+	return values
+}
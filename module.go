@@ -16,6 +16,7 @@ func EnableDebug(flag bool) {
 	native.MediaServerEnableDebug(flag)
 }
 
+// SetPortRange set the port range used by the endpoints created with NewEndpoint, see NewEndpointWithPortRange for a per endpoint range
 func SetPortRange(minPort, maxPort int) bool {
 	return native.MediaServerSetPortRange(minPort, maxPort)
 }
@@ -23,3 +24,8 @@ func SetPortRange(minPort, maxPort int) bool {
 func EnableUltraDebug(flag bool) {
 	native.MediaServerEnableUltraDebug(flag)
 }
+
+// NativeVersion get the version of the native library media-server-go is built against, "dev" when built from the submodules
+func NativeVersion() string {
+	return native.Version
+}
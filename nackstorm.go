@@ -0,0 +1,160 @@
+package mediaserver
+
+import (
+	"time"
+)
+
+// NACKStormConfig circuit breaker of the outgoing tracks retransmitting too much, ie when a subscriber keeps nacking
+// the same packets. NACKs are handled natively and not reported, so a storm is detected from the rtx bitrate of the track
+type NACKStormConfig struct {
+	// Ratio rtx bitrate over media bitrate above which the track is retransmitting too much, 0.5 if zero
+	Ratio float64
+	// Duration the ratio must be exceeded before the breaker trips, 3s if zero
+	Duration time.Duration
+	// MinBitrate tracks sending less media bitrate are not checked, 100kbps if zero
+	MinBitrate uint
+	// Cooldown when set, the track is muted for this time when the breaker trips so the subscriber stops nacking.
+	// Muting stops all the media of the track, it is not a temporary rtx suppression as the native library can not
+	// disable rtx for a single track. Tracks muted by the application are not touched, and the track is not unmuted
+	// after the cooldown if the application muted or unmuted it meanwhile
+	Cooldown time.Duration
+}
+
+// NACKStormEvent is emitted once each time the breaker of an outgoing track trips
+type NACKStormEvent struct {
+	Track        *OutgoingStreamTrack
+	MediaBitrate uint
+	RtxBitrate   uint
+	// Muted the track was muted for the cooldown
+	Muted bool
+}
+
+// NACKStormListener called when the breaker of an outgoing track trips
+type NACKStormListener func(event *NACKStormEvent)
+
+type nackStormState struct {
+	since   time.Time
+	tripped bool
+	muted   bool
+	until   time.Time
+	// muteCalls of the track once muted by the breaker, any other Mute call means the application took over
+	muteCalls int
+}
+
+type nackStormBreaker struct {
+	config   NACKStormConfig
+	listener NACKStormListener
+	tracks   map[*OutgoingStreamTrack]*nackStormState
+}
+
+// SetNACKStormBreaker check the outgoing tracks every second and call the listener when one retransmits more than
+// the configured ratio for too long, muting it for the cooldown if set
+func (t *Transport) SetNACKStormBreaker(config NACKStormConfig, listener NACKStormListener) {
+
+	if config.Ratio <= 0 {
+		config.Ratio = 0.5
+	}
+	if config.Duration <= 0 {
+		config.Duration = 3 * time.Second
+	}
+	if config.MinBitrate == 0 {
+		config.MinBitrate = 100000
+	}
+
+	monitor := t.getStateMonitor()
+	monitor.Lock()
+	monitor.nackStorm = &nackStormBreaker{
+		config:   config,
+		listener: listener,
+		tracks:   map[*OutgoingStreamTrack]*nackStormState{},
+	}
+	monitor.Unlock()
+}
+
+// nackStormAction a change to apply to a track out of the monitor lock
+type nackStormAction struct {
+	track *OutgoingStreamTrack
+	event *NACKStormEvent
+	mute  bool
+}
+
+// check the bitrates of the tracks on each poll, called with the monitor locked
+func (b *nackStormBreaker) check(transport *Transport, now time.Time) []nackStormAction {
+
+	actions := []nackStormAction{}
+	seen := map[*OutgoingStreamTrack]bool{}
+
+	for _, stream := range transport.GetOutgoingStreams() {
+		for _, track := range stream.GetTracks() {
+
+			seen[track] = true
+			state, ok := b.tracks[track]
+			if !ok {
+				state = &nackStormState{}
+				b.tracks[track] = state
+			}
+
+			if state.muted {
+				if now.Before(state.until) {
+					continue
+				}
+				state.muted = false
+				state.since = time.Time{}
+				state.tripped = false
+				if track.muteCalls == state.muteCalls && track.IsMuted() {
+					actions = append(actions, nackStormAction{track: track, mute: false})
+				}
+				continue
+			}
+
+			stats := track.GetStats()
+			media, rtx := stats.Media.Bitrate, stats.Rtx.Bitrate
+			if media < b.config.MinBitrate || float64(rtx) < b.config.Ratio*float64(media) {
+				state.since = time.Time{}
+				state.tripped = false
+				continue
+			}
+
+			if state.since.IsZero() {
+				state.since = now
+			}
+			if state.tripped || now.Sub(state.since) < b.config.Duration {
+				continue
+			}
+
+			state.tripped = true
+			event := &NACKStormEvent{Track: track, MediaBitrate: media, RtxBitrate: rtx}
+			if b.config.Cooldown > 0 && !track.IsMuted() {
+				state.muted = true
+				state.until = now.Add(b.config.Cooldown)
+				state.muteCalls = track.muteCalls + 1
+				event.Muted = true
+			}
+			actions = append(actions, nackStormAction{track: track, event: event, mute: event.Muted})
+		}
+	}
+
+	for track := range b.tracks {
+		if !seen[track] {
+			delete(b.tracks, track)
+		}
+	}
+	return actions
+}
+
+// apply mute or unmute the tracks and call the listener
+func (b *nackStormBreaker) apply(actions []nackStormAction) {
+
+	for _, action := range actions {
+		if action.event == nil {
+			action.track.Mute(false)
+			continue
+		}
+		if action.mute {
+			action.track.Mute(true)
+		}
+		if b.listener != nil {
+			b.listener(action.event)
+		}
+	}
+}
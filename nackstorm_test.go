@@ -0,0 +1,43 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_NACKStormCooldown(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+
+	stream := transport.CreateOutgoingStreamWithID("stream", true, true)
+	audio, video := stream.GetAudioTracks()[0], stream.GetVideoTracks()[0]
+
+	breaker := &nackStormBreaker{
+		config: NACKStormConfig{Ratio: 0.5, Duration: time.Second, MinBitrate: 100000, Cooldown: time.Second},
+		tracks: map[*OutgoingStreamTrack]*nackStormState{},
+	}
+
+	now := time.Now()
+	// as left by the breaker when it tripped
+	for _, track := range []*OutgoingStreamTrack{audio, video} {
+		breaker.tracks[track] = &nackStormState{tripped: true, muted: true, until: now, muteCalls: track.muteCalls + 1}
+		track.Mute(true)
+	}
+
+	// the application mutes the video track during the cooldown
+	video.Mute(true)
+
+	breaker.apply(breaker.check(transport, now.Add(time.Second)))
+
+	if audio.IsMuted() {
+		t.Error("expected the track unmuted after the cooldown")
+	}
+	if !video.IsMuted() {
+		t.Error("expected the track muted by the application left muted")
+	}
+	if state := breaker.tracks[video]; state.muted || state.tripped {
+		t.Error("expected the breaker state reset", state)
+	}
+}
@@ -0,0 +1,214 @@
+package mediaserver
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/notedit/sdp"
+)
+
+// OfferLimits hard limits applied to remote descriptions before any native processing
+// A zero value means no limit
+type OfferLimits struct {
+	// MaxSize max size in bytes of the raw sdp
+	MaxSize int
+	// MaxMedias max number of m-lines
+	MaxMedias int
+	// MaxCandidates max number of remote candidates
+	MaxCandidates int
+	// MaxExtensions max number of rtp header extensions per m-line
+	MaxExtensions int
+}
+
+// DefaultOfferLimits limits used by ParseOffer and SanitizeOffer
+var DefaultOfferLimits = OfferLimits{
+	MaxSize:       64 * 1024,
+	MaxMedias:     64,
+	MaxCandidates: 32,
+	MaxExtensions: 16,
+}
+
+// ErrMalformedOffer is returned when the remote description can not be parsed
+var ErrMalformedOffer = errors.New("malformed sdp")
+
+// OfferStats counters of the parsed and rejected remote descriptions
+type OfferStats struct {
+	Parsed             int64
+	Rejected           int64
+	RejectedMalformed  int64
+	RejectedSize       int64
+	RejectedMedias     int64
+	RejectedCandidates int64
+	RejectedExtensions int64
+}
+
+var offerStats OfferStats
+
+// GetOfferStats get the counters of the parsed and rejected remote descriptions
+func GetOfferStats() *OfferStats {
+	return &OfferStats{
+		Parsed:             atomic.LoadInt64(&offerStats.Parsed),
+		Rejected:           atomic.LoadInt64(&offerStats.Rejected),
+		RejectedMalformed:  atomic.LoadInt64(&offerStats.RejectedMalformed),
+		RejectedSize:       atomic.LoadInt64(&offerStats.RejectedSize),
+		RejectedMedias:     atomic.LoadInt64(&offerStats.RejectedMedias),
+		RejectedCandidates: atomic.LoadInt64(&offerStats.RejectedCandidates),
+		RejectedExtensions: atomic.LoadInt64(&offerStats.RejectedExtensions),
+	}
+}
+
+func rejectOffer(err error) error {
+
+	atomic.AddInt64(&offerStats.Rejected, 1)
+
+	if limitErr, ok := err.(*LimitError); ok {
+		switch limitErr.Limit {
+		case "size":
+			atomic.AddInt64(&offerStats.RejectedSize, 1)
+		case "medias":
+			atomic.AddInt64(&offerStats.RejectedMedias, 1)
+		case "candidates":
+			atomic.AddInt64(&offerStats.RejectedCandidates, 1)
+		case "extensions":
+			atomic.AddInt64(&offerStats.RejectedExtensions, 1)
+		}
+	} else {
+		atomic.AddInt64(&offerStats.RejectedMalformed, 1)
+	}
+	return err
+}
+
+// ParseOffer parse a remote sdp applying the DefaultOfferLimits
+func ParseOffer(str string) (*sdp.SDPInfo, error) {
+	return DefaultOfferLimits.ParseOffer(str)
+}
+
+// SanitizeOffer sanitize a parsed remote sdp applying the DefaultOfferLimits
+func SanitizeOffer(offer *sdp.SDPInfo) error {
+	return DefaultOfferLimits.SanitizeOffer(offer)
+}
+
+// ParseOffer check the raw sdp size, parse it and sanitize it
+// Offers keyed with SDES have no DTLS info, their crypto attribute is available with GetSDESCrypto.
+// Returns a *LimitError if a limit is exceeded or ErrMalformedOffer if it can not be parsed
+func (l OfferLimits) ParseOffer(str string) (offer *sdp.SDPInfo, err error) {
+
+	if err := checkLimit("size", l.MaxSize, len(str)); err != nil {
+		return nil, rejectOffer(err)
+	}
+
+	// the sdp parser does not validate mandatory attributes and may panic on them
+	defer func() {
+		if r := recover(); r != nil {
+			offer = nil
+			err = rejectOffer(fmt.Errorf("%w: %v", ErrMalformedOffer, r))
+		}
+	}()
+
+	sdes := isSDESOnly(str)
+
+	if sdes {
+		offer, err = sdp.Parse(withPlaceholderFingerprint(str))
+	} else {
+		offer, err = sdp.Parse(str)
+	}
+	if err != nil {
+		return nil, rejectOffer(fmt.Errorf("%w: %v", ErrMalformedOffer, err))
+	}
+
+	if sdes {
+		offer.SetDTLS(nil)
+		parseSDESCryptoLines(str, offer)
+	}
+
+	if err := l.SanitizeOffer(offer); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// SanitizeOffer check the limits of a parsed remote sdp and drop invalid header extensions
+// Returns a *LimitError if a limit is exceeded or ErrMalformedOffer if ICE info is missing, or DTLS info without SDES crypto
+func (l OfferLimits) SanitizeOffer(offer *sdp.SDPInfo) error {
+
+	if offer.GetICE() == nil {
+		return rejectOffer(fmt.Errorf("%w: missing ice info", ErrMalformedOffer))
+	}
+
+	if offer.GetDTLS() == nil {
+		if offer.GetCrypto() == nil {
+			return rejectOffer(fmt.Errorf("%w: missing dtls or sdes crypto info", ErrMalformedOffer))
+		}
+		if _, err := GetSDESCrypto(offer); err != nil {
+			return rejectOffer(fmt.Errorf("%w: %v", ErrMalformedOffer, err))
+		}
+	}
+
+	medias := offer.GetMedias()
+
+	if err := checkLimit("medias", l.MaxMedias, len(medias)); err != nil {
+		return rejectOffer(err)
+	}
+
+	if err := checkLimit("candidates", l.MaxCandidates, len(l.SanitizeCandidates(offer.GetCandidates()))); err != nil {
+		return rejectOffer(err)
+	}
+
+	for _, media := range medias {
+		extensions := media.GetExtensions()
+		for id, uri := range extensions {
+			// one byte and two bytes header extension ids
+			if id < 1 || id > 255 || uri == "" {
+				delete(extensions, id)
+			}
+		}
+		if err := checkLimit("extensions", l.MaxExtensions, len(extensions)); err != nil {
+			return rejectOffer(err)
+		}
+	}
+
+	atomic.AddInt64(&offerStats.Parsed, 1)
+
+	return nil
+}
+
+// candidateAddress get the address the STUN checks and media are sent to. For relay candidates it is the relayed address
+// on the TURN server, the related address is only informative and usually masked by browsers
+func candidateAddress(candidate *sdp.CandidateInfo) (string, int) {
+	return candidate.GetAddress(), candidate.GetPort()
+}
+
+// SanitizeCandidates drop duplicated and invalid remote candidates
+func (l OfferLimits) SanitizeCandidates(candidates []*sdp.CandidateInfo) []*sdp.CandidateInfo {
+
+	sanitized := []*sdp.CandidateInfo{}
+	seen := map[string]bool{}
+
+	for _, candidate := range candidates {
+
+		address, port := candidateAddress(candidate)
+
+		if port <= 0 || port > 65535 {
+			continue
+		}
+
+		if net.ParseIP(address) == nil && !strings.HasSuffix(address, ".local") {
+			continue
+		}
+
+		key := strings.ToLower(candidate.GetTransport()) + ":" + net.JoinHostPort(address, strconv.Itoa(port))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		sanitized = append(sanitized, candidate)
+	}
+
+	return sanitized
+}
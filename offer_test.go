@@ -0,0 +1,134 @@
+package mediaserver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/notedit/sdp"
+)
+
+const testOffer = "v=0\r\n" +
+	"o=- 4327261771880257373 2 IN IP4 127.0.0.1\r\n" +
+	"s=-\r\n" +
+	"t=0 0\r\n" +
+	"a=group:BUNDLE audio\r\n" +
+	"m=audio 9 UDP/TLS/RTP/SAVPF 111\r\n" +
+	"c=IN IP4 0.0.0.0\r\n" +
+	"a=rtcp:9 IN IP4 0.0.0.0\r\n" +
+	"a=ice-ufrag:ez5G\r\n" +
+	"a=ice-pwd:1F1qS++jzWLSQi0qQDZkX/QV\r\n" +
+	"a=candidate:1 1 UDP 33554431 35.188.215.104 59110 typ host\r\n" +
+	"a=fingerprint:sha-256 D2:FA:0E:C3:22:59:5E:14:95:69:92:3D:13:B4:84:24:2C:C2:A2:C0:3E:FD:34:8E:5E:EA:6F:AF:52:CE:E6:0F\r\n" +
+	"a=setup:actpass\r\n" +
+	"a=mid:audio\r\n" +
+	"a=extmap:1 urn:ietf:params:rtp-hdrext:ssrc-audio-level\r\n" +
+	"a=sendrecv\r\n" +
+	"a=rtcp-mux\r\n" +
+	"a=rtpmap:111 opus/48000/2\r\n"
+
+const testCrypto = "a=crypto:1 AES_CM_128_HMAC_SHA1_80 inline:WVNfX19zZW1jdGwgKCkgewkyMjA7fQp9CnVubGVz|2^31\r\n"
+
+func withoutFingerprint(offer string) string {
+	lines := []string{}
+	for _, line := range strings.SplitAfter(offer, "\r\n") {
+		if !strings.HasPrefix(line, "a=fingerprint:") && !strings.HasPrefix(line, "a=setup:") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+func Test_ParseOffer(t *testing.T) {
+
+	before := GetOfferStats()
+
+	offer, err := ParseOffer(testOffer)
+	if err != nil || offer.GetDTLS() == nil || len(offer.GetMedias()) != 1 {
+		t.Fatal("unexpected offer", offer, err)
+	}
+
+	stats := GetOfferStats()
+	if stats.Parsed != before.Parsed+1 || stats.Rejected != before.Rejected {
+		t.Error("expected the offer counted as parsed", stats)
+	}
+
+	if _, err := ParseOffer("not an sdp"); !errors.Is(err, ErrMalformedOffer) {
+		t.Error("expected malformed offer", err)
+	}
+
+	limits := OfferLimits{MaxSize: 64}
+	if _, err := limits.ParseOffer(testOffer); err == nil {
+		t.Error("expected size limit error")
+	} else if limit, ok := err.(*LimitError); !ok || limit.Limit != "size" {
+		t.Error("expected size limit error", err)
+	}
+
+	stats = GetOfferStats()
+	if stats.Rejected != before.Rejected+2 || stats.RejectedMalformed != before.RejectedMalformed+1 || stats.RejectedSize != before.RejectedSize+1 {
+		t.Error("expected the rejected offers counted", stats)
+	}
+}
+
+func Test_ParseOfferSDES(t *testing.T) {
+
+	// SDES offers from legacy endpoints have no fingerprint
+	offer, err := ParseOffer(withoutFingerprint(testOffer) + testCrypto)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	crypto, err := GetSDESCrypto(offer)
+	if err != nil || crypto == nil || crypto.Tag != 1 || crypto.Suite != SDESAESCM128HMACSHA1_80 {
+		t.Error("unexpected sdes crypto", crypto, err)
+	}
+
+	if _, err := ParseOffer(withoutFingerprint(testOffer)); !errors.Is(err, ErrMalformedOffer) {
+		t.Error("expected offer without dtls nor sdes crypto rejected", err)
+	}
+}
+
+func Test_SanitizeOffer(t *testing.T) {
+
+	offer, err := ParseOffer(testOffer)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	// invalid extension ids are dropped
+	media := offer.GetMedias()[0]
+	media.AddExtension(0, "urn:ietf:params:rtp-hdrext:toffset")
+	media.AddExtension(2, "")
+	if err := SanitizeOffer(offer); err != nil {
+		t.Error("unexpected error", err)
+	}
+	if len(media.GetExtensions()) != 1 {
+		t.Error("expected invalid extensions dropped", media.GetExtensions())
+	}
+
+	limits := OfferLimits{MaxMedias: 1, MaxExtensions: 1}
+	media.AddExtension(3, "urn:ietf:params:rtp-hdrext:toffset")
+	if limit, ok := limits.SanitizeOffer(offer).(*LimitError); !ok || limit.Limit != "extensions" {
+		t.Error("expected extensions limit error", limit)
+	}
+
+	offer.SetDTLS(nil)
+	if err := SanitizeOffer(offer); !errors.Is(err, ErrMalformedOffer) {
+		t.Error("expected missing dtls rejected", err)
+	}
+
+	offer.SetCrypto(sdp.NewCryptoInfo(1, SDESAESCM128HMACSHA1_80, "inline:c2hvcnQ=", ""))
+	if err := SanitizeOffer(offer); !errors.Is(err, ErrMalformedOffer) {
+		t.Error("expected bad sdes key rejected", err)
+	}
+}
+
+func Test_SanitizeRelayCandidates(t *testing.T) {
+
+	// browsers mask the related address of relay candidates
+	relay := sdp.NewCandidateInfo("3", 1, "UDP", 41885439, "203.0.113.7", 50000, "relay", "0.0.0.0", 0)
+
+	if len(DefaultOfferLimits.SanitizeCandidates([]*sdp.CandidateInfo{relay})) != 1 {
+		t.Error("relay candidate dropped")
+	}
+}
@@ -0,0 +1,267 @@
+package mediaserver
+
+import (
+	"errors"
+
+	"github.com/notedit/sdp"
+)
+
+type endpointOptions struct {
+	port      int
+	minPort   int
+	maxPort   int
+	affinity  int
+	relayIP   string
+	relayPort int
+	dscp      int
+	iface     string
+}
+
+// EndpointOption configure an endpoint created with NewEndpointWithOptions
+type EndpointOption func(*endpointOptions)
+
+// WithPort bind the endpoint to the given port
+func WithPort(port int) EndpointOption {
+	return func(o *endpointOptions) {
+		o.port = port
+	}
+}
+
+// WithPortRange bind the endpoint to a free port of the range, see NewEndpointWithPortRange
+func WithPortRange(minPort, maxPort int) EndpointOption {
+	return func(o *endpointOptions) {
+		o.minPort = minPort
+		o.maxPort = maxPort
+	}
+}
+
+// WithAffinity pin the endpoint thread to the cpu
+func WithAffinity(cpu int) EndpointOption {
+	return func(o *endpointOptions) {
+		o.affinity = cpu
+	}
+}
+
+// WithRelayCandidate advertise a relay candidate, see Endpoint.SetRelayCandidate
+func WithRelayCandidate(ip string, port int) EndpointOption {
+	return func(o *endpointOptions) {
+		o.relayIP = ip
+		o.relayPort = port
+	}
+}
+
+// WithDSCP mark the packets sent by the endpoint, see Endpoint.SetDSCP
+func WithDSCP(dscp int) EndpointOption {
+	return func(o *endpointOptions) {
+		o.dscp = dscp
+	}
+}
+
+// WithInterface bind the endpoint to a network interface, see Endpoint.BindToInterface
+func WithInterface(name string) EndpointOption {
+	return func(o *endpointOptions) {
+		o.iface = name
+	}
+}
+
+// NewEndpointWithOptions create a new endpoint with given ip configured with the options,
+// new knobs are added as options so the constructor signature does not change
+func NewEndpointWithOptions(ip string, opts ...EndpointOption) (*Endpoint, error) {
+
+	options := &endpointOptions{affinity: -1}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.port > 0 && options.maxPort > 0 {
+		return nil, errors.New("port and port range are exclusive")
+	}
+
+	var endpoint *Endpoint
+	switch {
+	case options.maxPort > 0:
+		var err error
+		if endpoint, err = NewEndpointWithPortRange(ip, options.minPort, options.maxPort); err != nil {
+			return nil, err
+		}
+	case options.port > 0:
+		endpoint = NewEndpointWithPort(ip, options.port)
+	default:
+		endpoint = NewEndpoint(ip)
+	}
+
+	if options.affinity >= 0 {
+		endpoint.SetAffinity(options.affinity)
+	}
+
+	if options.relayIP != "" {
+		endpoint.SetRelayCandidate(options.relayIP, options.relayPort)
+	}
+
+	if options.dscp != 0 {
+		if err := endpoint.SetDSCP(options.dscp); err != nil {
+			endpoint.Stop()
+			return nil, err
+		}
+	}
+
+	if options.iface != "" {
+		if err := endpoint.BindToInterface(options.iface); err != nil {
+			endpoint.Stop()
+			return nil, err
+		}
+	}
+
+	return endpoint, nil
+}
+
+type transportOptions struct {
+	mode                 ICEMode
+	publicIP             string
+	disableSTUNKeepAlive bool
+	configure            []func(*Transport)
+}
+
+// TransportOption configure a transport created with Endpoint.CreateTransportWithOptions
+type TransportOption func(*transportOptions)
+
+// WithICEMode use the given ICE mode instead of ICELite
+func WithICEMode(mode ICEMode) TransportOption {
+	return func(o *transportOptions) {
+		o.mode = mode
+	}
+}
+
+// WithoutSTUNKeepAlive disable ICE/STUN keep alives, required for server to server transports
+func WithoutSTUNKeepAlive() TransportOption {
+	return func(o *transportOptions) {
+		o.disableSTUNKeepAlive = true
+	}
+}
+
+// WithPublicIP advertise ip in the host candidates of this transport instead of the endpoint ip,
+// ie the address of the NIC or NAT of a customer on a multi-homed server, see Endpoint.BindToInterface
+func WithPublicIP(ip string) TransportOption {
+	return func(o *transportOptions) {
+		o.publicIP = ip
+	}
+}
+
+func withTransport(configure func(*Transport)) TransportOption {
+	return func(o *transportOptions) {
+		o.configure = append(o.configure, configure)
+	}
+}
+
+// WithBandwidthProbing enable or disable the bandwidth probing, see Transport.SetBandwidthProbing
+func WithBandwidthProbing(probe bool) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetBandwidthProbing(probe)
+	})
+}
+
+// WithMaxProbingBitrate limit the bitrate of the bandwidth probing, see Transport.SetMaxProbingBitrate
+func WithMaxProbingBitrate(bitrate uint) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetMaxProbingBitrate(bitrate)
+	})
+}
+
+// WithLimits set the limits enforced on the transport streams, see Transport.SetLimits
+func WithLimits(limits Limits) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetLimits(limits)
+	})
+}
+
+// WithAutoGenerateIDs see Transport.SetAutoGenerateIDs
+func WithAutoGenerateIDs(auto bool) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetAutoGenerateIDs(auto)
+	})
+}
+
+// WithPacing pace the outgoing packets, see Transport.SetPacing
+func WithPacing(config PacerConfig) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetPacing(config)
+	})
+}
+
+// WithSenderReports send sender reports as configured, see Transport.SetSenderReportConfig
+func WithSenderReports(config SenderReportConfig) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetSenderReportConfig(config)
+	})
+}
+
+// WithSRTPProtectionProfiles restrict the SRTP profiles of the transport, ie only the AES-GCM ones,
+// invalid profiles are ignored, see Transport.SetSRTPProtectionProfiles
+func WithSRTPProtectionProfiles(profiles ...string) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetSRTPProtectionProfiles(profiles)
+	})
+}
+
+// WithMDNSResolver resolve the .local remote candidates, see Transport.SetMDNSResolver
+func WithMDNSResolver(resolver *MDNSResolver) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetMDNSResolver(resolver)
+	})
+}
+
+// CreateTransportWithOptions create a new Transport object configured with the options,
+// they are applied in order before the transport is returned
+func (e *Endpoint) CreateTransportWithOptions(remoteSdp *sdp.SDPInfo, localSdp *sdp.SDPInfo, opts ...TransportOption) *Transport {
+
+	options := &transportOptions{mode: ICELite}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	transport := e.createTransport(remoteSdp, localSdp, options.mode, options.disableSTUNKeepAlive)
+
+	if options.publicIP != "" {
+		transport.localCandidates = withPublicIP(transport.localCandidates, options.publicIP)
+	}
+
+	for _, configure := range options.configure {
+		configure(transport)
+	}
+	return transport
+}
+
+// WithProbing set the bandwidth probing parameters, see Transport.SetProbing
+func WithProbing(config ProbingConfig) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetProbing(config)
+	})
+}
+
+// WithoutSenderSideEstimation disable the sender side bandwidth estimation, see Transport.EnableSenderSideEstimation
+func WithoutSenderSideEstimation() TransportOption {
+	return withTransport(func(t *Transport) {
+		t.EnableSenderSideEstimation(false)
+	})
+}
+
+// WithBWETrace record the bandwidth estimation internals to a csv file, see Transport.DumpBWEStats
+func WithBWETrace(filename string) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.DumpBWEStats(filename)
+	})
+}
+
+// WithREMB send REMBs to the remote peer as configured, see Transport.SetREMBConfig
+func WithREMB(config REMBConfig) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetREMBConfig(config)
+	})
+}
+
+// WithMaxOutgoingBitrate cap the aggregate bitrate sent, see Transport.SetMaxOutgoingBitrate
+func WithMaxOutgoingBitrate(bps uint) TransportOption {
+	return withTransport(func(t *Transport) {
+		t.SetMaxOutgoingBitrate(bps)
+	})
+}
@@ -3,6 +3,7 @@ package mediaserver
 import (
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	native "github.com/notedit/media-server-go/wrapper"
 	"github.com/notedit/sdp"
@@ -10,14 +11,16 @@ import (
 
 // OutgoingStream  represent the Media stream sent to a remote peer
 type OutgoingStream struct {
-	id                  string
-	transport           native.DTLSICETransport
-	info                *sdp.StreamInfo
-	muted               bool
-	tracks              map[string]*OutgoingStreamTrack
-	onStopListeners     []func()
-	onAddTrackListeners []func(*OutgoingStreamTrack)
-	l                   sync.Mutex
+	id         string
+	transport  native.DTLSICETransport
+	info       *sdp.StreamInfo
+	muted      bool
+	tracks     map[string]*OutgoingStreamTrack
+	onAddTrack emitter
+	owner      *Transport
+	l          sync.Mutex
+	async      serialQueue
+	metadata
 }
 
 // NewOutgoingStream create outgoing stream
@@ -33,9 +36,6 @@ func NewOutgoingStream(transport native.DTLSICETransport, info *sdp.StreamInfo)
 		stream.CreateTrack(track)
 	}
 
-	stream.onStopListeners = make([]func(), 0)
-	stream.onAddTrackListeners = make([]func(*OutgoingStreamTrack), 0)
-
 	return stream
 }
 
@@ -48,7 +48,7 @@ func (o *OutgoingStream) GetID() string {
 func (o *OutgoingStream) GetStats() map[string]*OutgoingStatss {
 
 	stats := map[string]*OutgoingStatss{}
-	for _, track := range o.tracks {
+	for _, track := range o.GetTracks() {
 		stats[track.GetID()] = track.GetStats()
 	}
 	return stats
@@ -74,6 +74,29 @@ func (o *OutgoingStream) Mute(muting bool) {
 // AttachTo Listen Media from the incoming stream and send it to the remote peer of the associated Transport
 func (o *OutgoingStream) AttachTo(incomingStream *IncomingStream) []*Transponder {
 
+	return o.attachTo(incomingStream, func(outgoing *OutgoingStreamTrack, incoming *IncomingStreamTrack) *Transponder {
+		return outgoing.AttachTo(incoming)
+	})
+}
+
+// AttachToChecked attach like AttachTo, tracks the remote peer can not decode are not attached and the first *CodecMismatchError is returned
+func (o *OutgoingStream) AttachToChecked(incomingStream *IncomingStream) ([]*Transponder, error) {
+
+	var err error
+
+	transponders := o.attachTo(incomingStream, func(outgoing *OutgoingStreamTrack, incoming *IncomingStreamTrack) *Transponder {
+		transponder, attachErr := outgoing.AttachToChecked(incoming)
+		if attachErr != nil && err == nil {
+			err = attachErr
+		}
+		return transponder
+	})
+
+	return transponders, err
+}
+
+func (o *OutgoingStream) attachTo(incomingStream *IncomingStream, attach func(outgoing *OutgoingStreamTrack, incoming *IncomingStreamTrack) *Transponder) []*Transponder {
+
 	o.Detach()
 	transponders := []*Transponder{}
 	audios := o.GetAudioTracks()
@@ -86,7 +109,9 @@ func (o *OutgoingStream) AttachTo(incomingStream *IncomingStream) []*Transponder
 
 		for i, track := range tracks {
 			if i < index {
-				transponders = append(transponders, audios[i].AttachTo(track))
+				if transponder := attach(audios[i], track); transponder != nil {
+					transponders = append(transponders, transponder)
+				}
 			}
 		}
 	}
@@ -100,7 +125,9 @@ func (o *OutgoingStream) AttachTo(incomingStream *IncomingStream) []*Transponder
 		}
 		for i, track := range tracks {
 			if i < index {
-				transponders = append(transponders, videos[i].AttachTo(track))
+				if transponder := attach(videos[i], track); transponder != nil {
+					transponders = append(transponders, transponder)
+				}
 			}
 		}
 	}
@@ -178,16 +205,31 @@ func (o *OutgoingStream) AddTrack(track *OutgoingStreamTrack) {
 	o.tracks[track.GetID()] = track
 }
 
+// RemoveTrack remove one outgoing track
 func (o *OutgoingStream) RemoveTrack(track *OutgoingStreamTrack) {
 	o.l.Lock()
 	defer o.l.Unlock()
 
-	delete(o.tracks, track.GetID())
+	if o.tracks[track.GetID()] == track {
+		delete(o.tracks, track.GetID())
+		if o.owner != nil {
+			o.owner.unregisterOutgoingTrack(track)
+		}
+	}
 }
 
 // CreateTrack Create new track from a TrackInfo object and add it to this stream
+// Returns nil if the track id is invalid or already used in the Transport
 func (o *OutgoingStream) CreateTrack(track *sdp.TrackInfo) *OutgoingStreamTrack {
 
+	if ValidateID(track.GetID()) != nil {
+		return nil
+	}
+
+	if o.owner != nil && o.owner.isOutgoingTrackIDTaken(track.GetID()) {
+		return nil
+	}
+
 	var mediaType native.MediaFrameType = 0
 	if track.GetMedia() == "video" {
 		mediaType = 1
@@ -218,7 +260,14 @@ func (o *OutgoingStream) CreateTrack(track *sdp.TrackInfo) *OutgoingStreamTrack
 
 	o.transport.AddOutgoingSourceGroup(source)
 
+	atomic.AddInt64(&numOutgoingSourceGroups, 1)
+
 	outgoingTrack := newOutgoingStreamTrack(track.GetMedia(), track.GetID(), native.TransportToSender(o.transport), source)
+	outgoingTrack.soak = soakTrack(soakOutgoingSourceGroups, 1)
+	if o.owner != nil {
+		outgoingTrack.codecs = o.owner.getRemoteCodecs
+		o.owner.registerOutgoingTrack(outgoingTrack)
+	}
 
 	// TODO
 	// runtime.SetFinalizer(source, func(source native.RTPOutgoingSourceGroup) {
@@ -229,16 +278,16 @@ func (o *OutgoingStream) CreateTrack(track *sdp.TrackInfo) *OutgoingStreamTrack
 	o.tracks[outgoingTrack.GetID()] = outgoingTrack
 	o.l.Unlock()
 
-	for _, addTrackFunc := range o.onAddTrackListeners {
-		addTrackFunc(outgoingTrack)
-	}
+	o.onAddTrack.emit(func(listener interface{}) {
+		listener.(func(*OutgoingStreamTrack))(outgoingTrack)
+	})
 
 	return outgoingTrack
 }
 
 // OnTrack new outgoing track listener
-func (o *OutgoingStream) OnTrack(listener func(*OutgoingStreamTrack)) {
-	o.onAddTrackListeners = append(o.onAddTrackListeners, listener)
+func (o *OutgoingStream) OnTrack(listener func(*OutgoingStreamTrack)) ListenerOff {
+	return o.onAddTrack.on(listener)
 }
 
 // Stop stop the remote stream
@@ -256,4 +305,8 @@ func (o *OutgoingStream) Stop() {
 	o.tracks = make(map[string]*OutgoingStreamTrack, 0)
 
 	o.transport = nil
+
+	if o.owner != nil {
+		o.owner.removeOutgoingStream(o)
+	}
 }
@@ -1,6 +1,8 @@
 package mediaserver
 
 import (
+	"sync"
+	"sync/atomic"
 	"time"
 
 	native "github.com/notedit/media-server-go/wrapper"
@@ -9,16 +11,28 @@ import (
 
 // OutgoingStreamTrack Audio or Video track of a Media stream sent to a remote peer
 type OutgoingStreamTrack struct {
-	id              string
-	media           string
-	muted           bool
-	sender          native.RTPSenderFacade
-	source          native.RTPOutgoingSourceGroup
-	transpoder      *Transponder
-	trackInfo       *sdp.TrackInfo
-	statss          *OutgoingStatss
-	onMuteListeners []func(bool)
-	onStopListeners []func()
+	id          string
+	media       string
+	muted       bool
+	muteCalls   int
+	sender      native.RTPSenderFacade
+	tee         native.RTPSenderTee
+	teeListener native.MediaFrameListener
+	source      native.RTPOutgoingSourceGroup
+	transpoder  *Transponder
+	trackInfo   *sdp.TrackInfo
+	statss      *OutgoingStatss
+	statsLock   sync.Mutex
+	feedback    FeedbackStats
+	bitrates    bitrateMeters
+	codecs      func(media string) []string
+	owner       *Transport
+	keyframe    *KeyframeConfig
+	onMute      emitter
+	async       serialQueue
+	soak        *soakSentinel
+	byeSent     bool
+	metadata
 	// todo outercallback
 }
 
@@ -36,6 +50,8 @@ type OutgoingStatss struct {
 	Media     *OutgoingStats
 	Rtx       *OutgoingStats
 	Fec       *OutgoingStats
+	Feedback  *FeedbackStats
+	Metadata  map[string]string `json:",omitempty"`
 	timestamp int64
 }
 
@@ -83,9 +99,6 @@ func newOutgoingStreamTrack(media string, id string, sender native.RTPSenderFaca
 		track.trackInfo.AddSourceGroup(sourceGroup)
 	}
 
-	track.onMuteListeners = make([]func(bool), 0)
-	track.onStopListeners = make([]func(), 0)
-
 	return track
 }
 
@@ -105,8 +118,12 @@ func (o *OutgoingStreamTrack) GetTrackInfo() *sdp.TrackInfo {
 }
 
 // GetStats get stats Info
+// The returned stats are a snapshot owned by the caller, they are not modified by later calls
 func (o *OutgoingStreamTrack) GetStats() *OutgoingStatss {
 
+	o.statsLock.Lock()
+	defer o.statsLock.Unlock()
+
 	if o.statss == nil {
 		o.statss = &OutgoingStatss{}
 	}
@@ -115,10 +132,75 @@ func (o *OutgoingStreamTrack) GetStats() *OutgoingStatss {
 		o.statss.Media = getStatsFromOutgoingSource(o.source.GetMedia())
 		o.statss.Rtx = getStatsFromOutgoingSource(o.source.GetRtx())
 		o.statss.Fec = getStatsFromOutgoingSource(o.source.GetFec())
+		o.statss.Feedback = o.GetFeedbackStats()
+		o.statss.Metadata = o.GetAllMetadata()
 		o.statss.timestamp = time.Now().UnixNano()
+		o.bitrates.update("media", o.statss.timestamp, o.statss.Media.TotalBytes)
+		o.bitrates.update("rtx", o.statss.timestamp, o.statss.Rtx.TotalBytes)
+		o.bitrates.update("fec", o.statss.timestamp, o.statss.Fec.TotalBytes)
+	}
+
+	return o.statss.clone()
+}
+
+func (s *OutgoingStatss) clone() *OutgoingStatss {
+
+	copied := *s
+	for _, stats := range []**OutgoingStats{&copied.Media, &copied.Rtx, &copied.Fec} {
+		if *stats != nil {
+			source := **stats
+			*stats = &source
+		}
+	}
+	if s.Feedback != nil {
+		feedback := *s.Feedback
+		copied.Feedback = &feedback
+	}
+	if s.Metadata != nil {
+		copied.Metadata = make(map[string]string, len(s.Metadata))
+		for key, value := range s.Metadata {
+			copied.Metadata[key] = value
+		}
+	}
+	return &copied
+}
+
+// GetStatsWithWindow get stats Info with the bitrates smoothed over the window instead of the native 1s accumulator
+func (o *OutgoingStreamTrack) GetStatsWithWindow(window BitrateWindow) *OutgoingStatss {
+
+	stats := o.GetStats()
+
+	if window == BitrateWindowNative {
+		return stats
+	}
+
+	smoothed := *stats
+	media := *stats.Media
+	rtx := *stats.Rtx
+	fec := *stats.Fec
+	media.Bitrate = o.bitrates.bitrate("media", window)
+	rtx.Bitrate = o.bitrates.bitrate("rtx", window)
+	fec.Bitrate = o.bitrates.bitrate("fec", window)
+	smoothed.Media = &media
+	smoothed.Rtx = &rtx
+	smoothed.Fec = &fec
+
+	return &smoothed
+}
+
+// getSentBitrate get the bitrate of the media, rtx and fec sources, zero once the source group is deleted
+func (o *OutgoingStreamTrack) getSentBitrate() uint {
+
+	o.statsLock.Lock()
+	defer o.statsLock.Unlock()
+
+	if o.source == nil {
+		return 0
 	}
 
-	return o.statss
+	return getStatsFromOutgoingSource(o.source.GetMedia()).Bitrate +
+		getStatsFromOutgoingSource(o.source.GetRtx()).Bitrate +
+		getStatsFromOutgoingSource(o.source.GetFec()).Bitrate
 }
 
 // GetSSRCs get ssrcs map
@@ -139,6 +221,8 @@ func (o *OutgoingStreamTrack) IsMuted() bool {
 // Mute Mute/Unmute the track
 func (o *OutgoingStreamTrack) Mute(muting bool) {
 
+	o.muteCalls++
+
 	if o.transpoder != nil {
 		o.transpoder.Mute(muting)
 	}
@@ -146,21 +230,33 @@ func (o *OutgoingStreamTrack) Mute(muting bool) {
 	if o.muted != muting {
 		o.muted = muting
 
-		for _, mutefunc := range o.onMuteListeners {
-			mutefunc(muting)
-		}
+		o.onMute.emit(func(listener interface{}) {
+			listener.(func(bool))(muting)
+		})
 	}
 }
 
+// GetCodecs get the Media codecs supported by the remote peer, nil if unknown
+func (o *OutgoingStreamTrack) GetCodecs() []string {
+	if o.codecs == nil {
+		return nil
+	}
+	return o.codecs(o.media)
+}
+
 // AttachTo Listen Media from the incoming stream track and send it to the remote peer of the associated Transport
 func (o *OutgoingStreamTrack) AttachTo(incomingTrack *IncomingStreamTrack) *Transponder {
 
 	// detach first
 	o.Detach()
 
-	transponder := native.NewRTPStreamTransponderFacade(o.source, o.sender)
+	transponder := native.NewRTPStreamTransponderFacade(o.source, o.getSender())
 
 	o.transpoder = NewTransponder(transponder)
+	if o.owner != nil && o.media == "video" {
+		o.transpoder.maxBitrate = o.owner.maxVideoTrackBitrate
+	}
+	o.transpoder.SetKeyframeConfig(o.keyframe)
 
 	if o.muted {
 		o.transpoder.Mute(o.muted)
@@ -168,9 +264,94 @@ func (o *OutgoingStreamTrack) AttachTo(incomingTrack *IncomingStreamTrack) *Tran
 
 	o.transpoder.SetIncomingTrack(incomingTrack)
 
+	o.sendSenderReportOnAttach()
+
 	return o.transpoder
 }
 
+// AttachToChecked attach like AttachTo only if the remote peer can decode the incoming track codec,
+// returns a *CodecMismatchError otherwise and the track is left untouched
+func (o *OutgoingStreamTrack) AttachToChecked(incomingTrack *IncomingStreamTrack) (*Transponder, error) {
+
+	if err := checkCodecs(o.media, incomingTrack.GetPayloadCodec(), incomingTrack.GetCodecs(), o.GetCodecs()); err != nil {
+		return nil, err
+	}
+
+	return o.AttachTo(incomingTrack), nil
+}
+
+// getSender sender used by the transponder, the recording tee when the track is recorded
+func (o *OutgoingStreamTrack) getSender() native.RTPSenderFacade {
+	if o.tee != nil {
+		return o.tee.GetSender()
+	}
+	return o.baseSender()
+}
+
+// baseSender sender of the transport, through its pacer when pacing is enabled
+func (o *OutgoingStreamTrack) baseSender() native.RTPSenderFacade {
+	if o.owner != nil {
+		if pacer := o.owner.getPacer(); pacer != nil {
+			return pacer
+		}
+	}
+	return o.sender
+}
+
+// resetSender recreate the recording tee and rebind the transponder after the transport sender has changed
+func (o *OutgoingStreamTrack) resetSender() {
+
+	if o.sender == nil {
+		return
+	}
+
+	if o.tee == nil {
+		o.reattach()
+		return
+	}
+
+	tee := o.tee
+	o.tee = native.NewRTPSenderTee(o.baseSender(), o.teeListener)
+	o.reattach()
+
+	tee.Stop()
+	native.DeleteRTPSenderTee(tee)
+}
+
+// reattach rebind the transponder to a native transponder using the current sender, the Transponder held by the
+// application and its layer selection are kept. The codecs are not checked again, the incoming track was accepted when attached
+func (o *OutgoingStreamTrack) reattach() {
+	if o.transpoder == nil || o.transpoder.transponder == nil || o.source == nil {
+		return
+	}
+	o.transpoder.rebind(native.NewRTPStreamTransponderFacade(o.source, o.getSender()))
+}
+
+// record depacketize what is sent to the remote peer to the listener, see Recorder.RecordOutgoing
+func (o *OutgoingStreamTrack) record(listener native.MediaFrameListener) {
+
+	o.stopRecording()
+
+	o.tee = native.NewRTPSenderTee(o.baseSender(), listener)
+	o.teeListener = listener
+	o.reattach()
+}
+
+func (o *OutgoingStreamTrack) stopRecording() {
+
+	if o.tee == nil {
+		return
+	}
+
+	tee := o.tee
+	o.tee = nil
+	o.teeListener = nil
+	o.reattach()
+
+	tee.Stop()
+	native.DeleteRTPSenderTee(tee)
+}
+
 // Detach Stop forwarding any previous attached track
 func (o *OutgoingStreamTrack) Detach() {
 
@@ -179,6 +360,7 @@ func (o *OutgoingStreamTrack) Detach() {
 	}
 
 	o.transpoder.Stop()
+	o.feedback.add(o.transpoder.getFeedbackStats())
 
 	o.transpoder = nil
 }
@@ -188,11 +370,12 @@ func (o *OutgoingStreamTrack) GetTransponder() *Transponder {
 	return o.transpoder
 }
 
-func (o *OutgoingStreamTrack) OnMute(mute func(bool)) {
-	o.onMuteListeners = append(o.onMuteListeners, mute)
+// OnMute register a listener called when the track is muted or unmuted
+func (o *OutgoingStreamTrack) OnMute(mute func(bool)) ListenerOff {
+	return o.onMute.on(mute)
 }
 
-// Stop Removes the track from the outgoing stream and also detaches from any attached incoming track
+// Stop Removes the track from the outgoing stream and also detaches from any attached incoming track, a RTCP BYE is sent for its ssrcs
 func (o *OutgoingStreamTrack) Stop() {
 
 	if o.sender == nil {
@@ -201,17 +384,41 @@ func (o *OutgoingStreamTrack) Stop() {
 
 	if o.transpoder != nil { // maybe = nil at onTransponderStopped
 		o.transpoder.Stop()
+		o.feedback.add(o.transpoder.getFeedbackStats())
 		o.transpoder = nil
 	}
 
+	if o.tee != nil {
+		o.tee.Stop()
+		native.DeleteRTPSenderTee(o.tee)
+		o.tee = nil
+	}
+
+	if o.owner != nil {
+		o.sendBye(o.owner.transport)
+	}
+
 	native.DeleteRTPSenderFacade(o.sender)
 	o.sender = nil
 }
 
+// DeleteOutgoingSourceGroup remove the source group from the transport, the track is unregistered first
+// so the pacer no longer reads its stats
 func (o *OutgoingStreamTrack) DeleteOutgoingSourceGroup(transport native.DTLSICETransport) {
+
+	if o.owner != nil {
+		o.owner.unregisterOutgoingTrack(o)
+	}
+
+	o.statsLock.Lock()
+	defer o.statsLock.Unlock()
+
 	if o.source != nil {
+		o.sendBye(transport)
 		transport.RemoveOutgoingSourceGroup(o.source)
 		native.DeleteRTPOutgoingSourceGroup(o.source)
 		o.source = nil
+		atomic.AddInt64(&numOutgoingSourceGroups, -1)
+		o.soak.release()
 	}
 }
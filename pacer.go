@@ -0,0 +1,179 @@
+package mediaserver
+
+import (
+	"time"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// PacerConfig pacing of the rtp sent by a transport, so large keyframes are spread instead of sent in one burst
+type PacerConfig struct {
+	// RateMultiplier pacing bitrate as a multiple of the bitrate being sent, 2.5 if zero
+	RateMultiplier float64
+	// MinBitrate pacing bitrate floor in bps, 300kbps if zero
+	MinBitrate uint
+	// BurstBytes bytes that can be sent at once after an idle period, 1500 minimum
+	BurstBytes uint
+	// QueueLimit packets queued before dropping the oldest ones, 0 for no limit
+	QueueLimit uint
+}
+
+// PacerStats state of the pacer of a transport
+type PacerStats struct {
+	Bitrate uint
+	Queued  uint
+	Sent    uint64
+	Dropped uint64
+}
+
+// pacer bitrate update period
+const pacerUpdateInterval = 200 * time.Millisecond
+
+// SetPacing pace the rtp sent by all the outgoing tracks of the transport, replacing the previous config.
+// The pacing bitrate never exceeds the cap set with SetMaxOutgoingBitrate.
+// The transponders of the attached tracks are rebound to go through the pacer, keeping their layer selection
+func (t *Transport) SetPacing(config PacerConfig) {
+
+	if config.RateMultiplier <= 0 {
+		config.RateMultiplier = 2.5
+	}
+	if config.MinBitrate == 0 {
+		config.MinBitrate = 300000
+	}
+
+	t.Lock()
+	if t.transport == nil {
+		t.Unlock()
+		return
+	}
+	created := t.pacer == nil
+	if created {
+		t.pacerSender = native.TransportToSender(t.transport)
+		t.pacer = native.NewRTPPacer(t.pacerSender, t.transport.GetTimeService())
+	}
+	if t.pacerStop != nil {
+		close(t.pacerStop)
+	}
+	stop := make(chan struct{})
+	t.pacerStop = stop
+	t.pacerConfig = &config
+	t.pacerForCap = false
+	pacer := t.pacer
+	bitrate := t.capPacerBitrate(uint(float64(config.MinBitrate) * config.RateMultiplier))
+	t.Unlock()
+
+	pacer.Configure(bitrate, config.BurstBytes, config.QueueLimit)
+
+	if created {
+		t.resetSenders()
+	}
+
+	go t.updatePacer(pacer, config, stop)
+}
+
+// DisablePacing send the rtp as soon as it is ready again, the transponders of the attached tracks are rebound
+func (t *Transport) DisablePacing() {
+
+	t.Lock()
+	pacer, sender := t.pacer, t.pacerSender
+	t.pacer, t.pacerSender, t.pacerConfig = nil, nil, nil
+	t.pacerForCap = false
+	if t.pacerStop != nil {
+		close(t.pacerStop)
+		t.pacerStop = nil
+	}
+	t.Unlock()
+
+	if pacer == nil {
+		return
+	}
+
+	// Move the transponders off the pacer before deleting it
+	t.resetSenders()
+
+	pacer.Stop()
+	native.DeleteRTPPacer(pacer)
+	native.DeleteRTPSenderFacade(sender)
+}
+
+// GetPacerStats get the pacer state, nil if pacing is disabled
+func (t *Transport) GetPacerStats() *PacerStats {
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.pacer == nil {
+		return nil
+	}
+
+	return &PacerStats{
+		Bitrate: t.pacerBitrate,
+		Queued:  t.pacer.GetQueued(),
+		Sent:    t.pacer.GetSent(),
+		Dropped: t.pacer.GetDropped(),
+	}
+}
+
+// getPacer get the sender of the pacer, nil if pacing is disabled
+func (t *Transport) getPacer() native.RTPSenderFacade {
+	t.Lock()
+	defer t.Unlock()
+	if t.pacer == nil {
+		return nil
+	}
+	return t.pacer.GetSender()
+}
+
+func (t *Transport) resetSenders() {
+
+	t.Lock()
+	tracks := make([]*OutgoingStreamTrack, 0, len(t.outgoingStreamTracks))
+	for _, track := range t.outgoingStreamTracks {
+		tracks = append(tracks, track)
+	}
+	t.Unlock()
+
+	for _, track := range tracks {
+		track.resetSender()
+	}
+}
+
+func (t *Transport) updatePacer(pacer native.RTPPacer, config PacerConfig, stop chan struct{}) {
+
+	ticker := time.NewTicker(pacerUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		t.Lock()
+		tracks := make([]*OutgoingStreamTrack, 0, len(t.outgoingStreamTracks))
+		for _, track := range t.outgoingStreamTracks {
+			tracks = append(tracks, track)
+		}
+		t.Unlock()
+
+		var sent uint
+		for _, track := range tracks {
+			sent += track.getSentBitrate()
+		}
+		if sent < config.MinBitrate {
+			sent = config.MinBitrate
+		}
+
+		// configured locked so DisablePacing can not delete the pacer meanwhile
+		t.Lock()
+		if t.pacerStop != stop {
+			// pacing was disabled or reconfigured while reading the stats
+			t.Unlock()
+			return
+		}
+		t.pacerBitrate = t.capPacerBitrate(uint(float64(sent) * config.RateMultiplier))
+		pacer.Configure(t.pacerBitrate, config.BurstBytes, config.QueueLimit)
+		t.Unlock()
+	}
+}
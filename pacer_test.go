@@ -0,0 +1,72 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/notedit/sdp"
+)
+
+func Test_PacingKeepsTransponder(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	defer transport.Stop()
+
+	info := sdp.NewStreamInfo("stream")
+	info.AddTrack(newTestTrackInfo("video", 1))
+	incoming := transport.CreateIncomingStream(info).GetTrack("video")
+
+	outgoing := transport.CreateOutgoingStreamWithID("out", false, true).GetVideoTracks()[0]
+	transponder := outgoing.AttachTo(incoming)
+	transponder.SelectLayer(1, 0)
+	transponder.Mute(true)
+	native := transponder.transponder
+
+	transport.SetPacing(PacerConfig{})
+	defer transport.DisablePacing()
+
+	if outgoing.GetTransponder() != transponder || transponder.transponder == native || transponder.transponder == nil {
+		t.Fatal("expected the transponder rebound to a new native transponder")
+	}
+	if transponder.GetIncomingTrack() != incoming || transponder.GetSelectedSpatialLayerId() != 1 || !transponder.IsMuted() {
+		t.Error("expected the transponder state kept")
+	}
+
+	transport.DisablePacing()
+	if outgoing.GetTransponder() != transponder || transponder.GetIncomingTrack() != incoming {
+		t.Error("expected the transponder kept when pacing is disabled")
+	}
+
+	// a stopped transponder ignores the layer selection
+	transponder.Stop()
+	transponder.SelectEncoding("other")
+	transponder.SelectLayer(0, 0)
+}
+
+func Test_PacingDeletedSourceGroup(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	defer transport.Stop()
+
+	transport.SetPacing(PacerConfig{})
+	defer transport.DisablePacing()
+
+	stream := transport.CreateOutgoingStreamWithID("out", true, true)
+	track := stream.GetVideoTracks()[0]
+	track.DeleteOutgoingSourceGroup(stream.transport)
+
+	if transport.isOutgoingTrackIDTaken(track.GetID()) {
+		t.Error("expected the track unregistered with its source group")
+	}
+	if bitrate := track.getSentBitrate(); bitrate != 0 {
+		t.Error("expected no bitrate without source group", bitrate)
+	}
+
+	// the pacer updates read the remaining tracks only
+	time.Sleep(2 * pacerUpdateInterval)
+	stream.Stop()
+}
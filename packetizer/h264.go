@@ -18,7 +18,8 @@ func (p *H264Packetier) Packetize(payload []byte, mtu int) (payloads [][]byte) {
 		naluType := nalu[0] & 0x1F
 		naluRefIdc := nalu[0] & 0x60
 
-		if !(naluType < 9 && naluType > 4) {
+		// keep the slices, sei, sps and pps
+		if naluType < 1 || naluType > 8 {
 			continue
 		}
 
@@ -0,0 +1,105 @@
+package mediaserver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/notedit/sdp"
+)
+
+// ErrUnsafeNotAllowed plain RTP transports must be explicitly allowed
+var ErrUnsafeNotAllowed = errors.New("unencrypted rtp transport not allowed, set allowUnsafe only on trusted networks")
+
+// PlainTransport unencrypted RTP transport without ICE, DTLS or SRTP, one rtp session per media
+// Only meant for trusted intra-datacenter hops, ie to transcoders or mixers
+type PlainTransport struct {
+	id       string
+	sessions map[string]*StreamerSession
+	sync.Mutex
+}
+
+// NewPlainTransport create a plain RTP transport with a session for each media, using auto selected local ports
+// allowUnsafe must be true, media is sent and received in clear
+func NewPlainTransport(medias []*sdp.MediaInfo, allowUnsafe bool) (*PlainTransport, error) {
+
+	if !allowUnsafe {
+		return nil, ErrUnsafeNotAllowed
+	}
+
+	transport := &PlainTransport{
+		id:       uuid.Must(uuid.NewV4()).String(),
+		sessions: make(map[string]*StreamerSession),
+	}
+
+	for _, media := range medias {
+		mediaType := strings.ToLower(media.GetType())
+		if _, ok := transport.sessions[mediaType]; ok {
+			transport.Stop()
+			return nil, fmt.Errorf("duplicated %s media in plain rtp transport", mediaType)
+		}
+		transport.sessions[mediaType] = NewStreamerSession(media)
+	}
+
+	return transport, nil
+}
+
+// GetID get id
+func (p *PlainTransport) GetID() string {
+	return p.id
+}
+
+func (p *PlainTransport) getSession(media string) *StreamerSession {
+	p.Lock()
+	defer p.Unlock()
+	return p.sessions[strings.ToLower(media)]
+}
+
+// GetLocalPort get the local rtp port of the media, 0 if there is no such media
+func (p *PlainTransport) GetLocalPort(media string) int {
+	if session := p.getSession(media); session != nil {
+		return session.GetLocalPort()
+	}
+	return 0
+}
+
+// SetRemotePort set the remote address the media is sent to
+func (p *PlainTransport) SetRemotePort(media string, ip string, port int) error {
+	session := p.getSession(media)
+	if session == nil {
+		return fmt.Errorf("no %s media in plain rtp transport", media)
+	}
+	session.SetRemotePort(ip, port)
+	return nil
+}
+
+// GetIncomingStreamTrack get the track receiving the media
+func (p *PlainTransport) GetIncomingStreamTrack(media string) *IncomingStreamTrack {
+	if session := p.getSession(media); session != nil {
+		return session.GetIncomingStreamTrack()
+	}
+	return nil
+}
+
+// GetOutgoingStreamTrack get the track sending the media
+func (p *PlainTransport) GetOutgoingStreamTrack(media string) *OutgoingStreamTrack {
+	if session := p.getSession(media); session != nil {
+		return session.GetOutgoingStreamTrack()
+	}
+	return nil
+}
+
+// Stop stop all the rtp sessions
+func (p *PlainTransport) Stop() {
+
+	p.Lock()
+	sessions := p.sessions
+	p.sessions = make(map[string]*StreamerSession)
+	p.Unlock()
+
+	for _, session := range sessions {
+		session.Stop()
+	}
+}
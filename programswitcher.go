@@ -0,0 +1,203 @@
+package mediaserver
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ProgramChangeListener called when the program video track changes
+type ProgramChangeListener func(video *IncomingStreamTrack)
+
+// ProgramSwitcher choose the program video track among several sources based on the active speaker,
+// and forward it to a single outgoing track and/or the program change listeners, ie a composite recorder
+type ProgramSwitcher struct {
+	detector *ActiveSpeakerDetector
+	output   *OutgoingStreamTrack
+	// audio track -> video track of each source
+	sources  map[*IncomingStreamTrack]*IncomingStreamTrack
+	program  *IncomingStreamTrack
+	pending  *IncomingStreamTrack
+	minDwell time.Duration
+	switched time.Time
+	timer    *time.Timer
+	onChange []ProgramChangeListener
+	stopped  bool
+	// serialize the switches of the detector callback, the dwell timer and the sources changes
+	switching sync.Mutex
+	sync.Mutex
+}
+
+// NewProgramSwitcher create a new switcher, the program stays at least minDwell on a source before switching again
+// output may be nil if the program is only consumed with OnProgramChange
+func NewProgramSwitcher(output *OutgoingStreamTrack, minDwell time.Duration) *ProgramSwitcher {
+
+	switcher := &ProgramSwitcher{
+		detector: NewActiveSpeakerDetector(),
+		output:   output,
+		sources:  make(map[*IncomingStreamTrack]*IncomingStreamTrack),
+		minDwell: minDwell,
+	}
+
+	switcher.detector.OnActiveSpeakerChanged(switcher.onActiveSpeaker)
+
+	return switcher
+}
+
+// GetActiveSpeakerDetector get the detector to tune its parameters
+func (p *ProgramSwitcher) GetActiveSpeakerDetector() *ActiveSpeakerDetector {
+	return p.detector
+}
+
+// AddSource add a source, its audio track is used for the detection and its video track is switched to the program
+// The first source added becomes the program
+func (p *ProgramSwitcher) AddSource(audio *IncomingStreamTrack, video *IncomingStreamTrack) error {
+
+	if audio == nil || video == nil {
+		return errors.New("program source needs an audio and a video track")
+	}
+
+	p.Lock()
+	p.sources[audio] = video
+	first := p.program == nil
+	p.Unlock()
+
+	p.detector.AddSpeaker(audio)
+
+	if first {
+		return p.switchTo(video)
+	}
+	return nil
+}
+
+// RemoveSource remove a source, if it was the program another source is selected
+func (p *ProgramSwitcher) RemoveSource(audio *IncomingStreamTrack) error {
+
+	p.detector.RemoveSpeaker(audio)
+
+	p.Lock()
+	video := p.sources[audio]
+	delete(p.sources, audio)
+	if p.pending == video {
+		p.pending = nil
+	}
+	var next *IncomingStreamTrack
+	if video != nil && p.program == video {
+		p.program = nil
+		for _, other := range p.sources {
+			next = other
+			break
+		}
+	}
+	p.Unlock()
+
+	if next != nil {
+		return p.switchTo(next)
+	}
+	return nil
+}
+
+// GetProgram get the current program video track
+func (p *ProgramSwitcher) GetProgram() *IncomingStreamTrack {
+	p.Lock()
+	defer p.Unlock()
+	return p.program
+}
+
+// OnProgramChange register a listener of the program changes
+func (p *ProgramSwitcher) OnProgramChange(listener ProgramChangeListener) {
+	p.Lock()
+	defer p.Unlock()
+	p.onChange = append(p.onChange, listener)
+}
+
+func (p *ProgramSwitcher) onActiveSpeaker(audio *IncomingStreamTrack) {
+
+	p.Lock()
+	video := p.sources[audio]
+	if p.stopped || video == nil || video == p.program {
+		p.pending = nil
+		p.Unlock()
+		return
+	}
+
+	wait := p.minDwell - time.Since(p.switched)
+	if wait > 0 {
+		// keep the latest speaker and switch once the dwell time is over
+		p.pending = video
+		if p.timer == nil {
+			p.timer = time.AfterFunc(wait, p.onDwellTimeout)
+		}
+		p.Unlock()
+		return
+	}
+	p.Unlock()
+
+	p.switchTo(video)
+}
+
+func (p *ProgramSwitcher) onDwellTimeout() {
+
+	p.Lock()
+	p.timer = nil
+	video := p.pending
+	p.pending = nil
+	stopped := p.stopped
+	p.Unlock()
+
+	if video != nil && !stopped {
+		p.switchTo(video)
+	}
+}
+
+// switchTo forward video to the output, the program only changes if it succeeds
+func (p *ProgramSwitcher) switchTo(video *IncomingStreamTrack) error {
+
+	p.switching.Lock()
+	defer p.switching.Unlock()
+
+	p.Lock()
+	if p.stopped {
+		p.Unlock()
+		return nil
+	}
+	output := p.output
+	p.Unlock()
+
+	if output != nil {
+		if transponder := output.GetTransponder(); transponder != nil {
+			if err := transponder.SetIncomingTrack(video); err != nil {
+				return err
+			}
+		} else if _, err := output.AttachToChecked(video); err != nil {
+			return err
+		}
+	}
+
+	p.Lock()
+	p.program = video
+	p.switched = time.Now()
+	listeners := p.onChange
+	p.Unlock()
+
+	for _, listener := range listeners {
+		listener(video)
+	}
+	return nil
+}
+
+// Stop stop switching, the output track keeps its last program attached
+func (p *ProgramSwitcher) Stop() {
+
+	p.Lock()
+	p.stopped = true
+	p.pending = nil
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	p.sources = make(map[*IncomingStreamTrack]*IncomingStreamTrack)
+	p.Unlock()
+
+	p.detector.Stop()
+}
@@ -0,0 +1,51 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/notedit/sdp"
+)
+
+func Test_ProgramSwitcherStop(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	defer transport.Stop()
+
+	info := sdp.NewStreamInfo("stream")
+	info.AddTrack(newTestTrackInfo("audio1", 1))
+	info.AddTrack(newTestTrackInfo("video1", 2))
+	info.AddTrack(newTestTrackInfo("audio2", 3))
+	info.AddTrack(newTestTrackInfo("video2", 4))
+	stream := transport.CreateIncomingStream(info)
+
+	switcher := NewProgramSwitcher(nil, time.Hour)
+	changes := 0
+	switcher.OnProgramChange(func(video *IncomingStreamTrack) {
+		changes++
+	})
+
+	video1 := stream.GetTrack("video1")
+	if err := switcher.AddSource(stream.GetTrack("audio1"), video1); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if err := switcher.AddSource(stream.GetTrack("audio2"), stream.GetTrack("video2")); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if switcher.GetProgram() != video1 || changes != 1 {
+		t.Fatal("expected the first source as program", changes)
+	}
+
+	// a speaker change within the dwell time is kept pending
+	switcher.onActiveSpeaker(stream.GetTrack("audio2"))
+	switcher.Stop()
+
+	// the dwell timer firing after stop does not switch
+	switcher.onDwellTimeout()
+	switcher.onActiveSpeaker(stream.GetTrack("audio2"))
+	if switcher.GetProgram() != video1 || changes != 1 {
+		t.Error("expected no switch after stop", changes)
+	}
+}
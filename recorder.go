@@ -1,7 +1,11 @@
 package mediaserver
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	native "github.com/notedit/media-server-go/wrapper"
@@ -14,16 +18,42 @@ type Recorder struct {
 	ticker     *time.Ticker
 	refresher  *Refresher
 	maxTrackId int
+	filename   string
+	started    time.Time
+	captions   *WebVTTWriter
+	markers    *MarkerWriter
+	stopped    time.Time
+	processors recordingProcessors
+	outgoing   []*OutgoingStreamTrack
+	incoming   []*IncomingStreamTrack
+	rtpdump    native.RTPDumpWriter
+	onStop     []func()
+	onEvent    []RecorderEventListener
+	disk       *diskMonitor
+	err        error
+	lock       sync.Mutex
 }
 
 // NewRecorder create a new recorder
 func NewRecorder(filename string, waitForIntra bool, refresh int) *Recorder {
 	recorder := &Recorder{}
-	recorder.recorder = native.NewMP4RecorderFacade()
-	recorder.recorder.Create(filename)
-	recorder.recorder.Record(waitForIntra)
 	recorder.tracks = map[string]*RecorderTrack{}
 	recorder.maxTrackId = 1
+	recorder.filename = filename
+	recorder.started = time.Now()
+
+	recorder.recorder = native.NewMP4RecorderFacade()
+	if !recorder.recorder.Create(filename) {
+		// nothing is recorded, the recorder is returned stopped with the error
+		native.DeleteMP4RecorderFacade(recorder.recorder)
+		recorder.recorder = nil
+		recorder.err = ErrRecorderCreate
+		recorder.stopped = recorder.started
+		return recorder
+	}
+	recorder.recorder.Record(waitForIntra)
+
+	atomic.AddInt64(&numRecorders, 1)
 
 	if refresh > 0 {
 		recorder.refresher = NewRefresher(refresh)
@@ -33,8 +63,29 @@ func NewRecorder(filename string, waitForIntra bool, refresh int) *Recorder {
 }
 
 // Record start record an incoming track
+// Only the best active encoding of a simulcast video track is recorded, see newSimulcastRecorderTrack
 func (r *Recorder) Record(incoming *IncomingStreamTrack) {
 
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.recorder == nil {
+		return
+	}
+
+	r.incoming = append(r.incoming, incoming)
+	r.dumpIncoming(incoming)
+
+	if incoming.GetMedia() == "video" && len(incoming.GetEncodings()) > 1 {
+		r.maxTrackId += 1
+		recorderTrack := newSimulcastRecorderTrack(strconv.Itoa(r.maxTrackId), incoming, r.recorder)
+		r.tracks[recorderTrack.GetID()] = recorderTrack
+		if r.refresher != nil {
+			r.refresher.Add(incoming)
+		}
+		return
+	}
+
 	for _, encoding := range incoming.GetEncodings() {
 		encoding.GetDepacketizer().AddMediaListener(r.recorder)
 
@@ -56,25 +107,163 @@ func (r *Recorder) RecordStream(incoming *IncomingStream) {
 	}
 }
 
+// EnableRTPDump capture the original rtp packets of the recorded incoming tracks in rtpdump format, with their receive time,
+// so a lost or garbled recording can be rebuilt. Header extensions are not captured
+func (r *Recorder) EnableRTPDump(filename string) error {
+
+	if r.rtpdump != nil {
+		return errors.New("rtpdump already enabled")
+	}
+
+	rtpdump := native.NewRTPDumpWriter()
+	if !rtpdump.Open(filename) {
+		native.DeleteRTPDumpWriter(rtpdump)
+		return fmt.Errorf("can not open rtpdump file %s", filename)
+	}
+
+	r.rtpdump = rtpdump
+
+	for _, incoming := range r.incoming {
+		r.dumpIncoming(incoming)
+	}
+	return nil
+}
+
+func (r *Recorder) dumpIncoming(incoming *IncomingStreamTrack) {
+
+	if r.rtpdump == nil {
+		return
+	}
+
+	for _, encoding := range incoming.GetEncodings() {
+		r.rtpdump.AddIncoming(encoding.GetSource())
+	}
+}
+
+// RecordOutgoing record what is sent to the remote peer of an outgoing track, after the layer selection of its transponder,
+// so it captures exactly what the viewer received. The track transponder is rebound to the recording tee, the Transponder
+// held by the application keeps working with its layer selection
+func (r *Recorder) RecordOutgoing(outgoing *OutgoingStreamTrack) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.recorder == nil || outgoing.sender == nil {
+		return
+	}
+
+	outgoing.record(r.recorder)
+	r.outgoing = append(r.outgoing, outgoing)
+}
+
 // Stop  stop the recorder
 func (r *Recorder) Stop() {
 
+	r.lock.Lock()
+
 	if r.recorder == nil {
+		r.lock.Unlock()
 		return
 	}
 
+	r.stopDiskMonitor()
+
 	for _, track := range r.tracks {
 		track.Stop()
 	}
 
+	for _, outgoing := range r.outgoing {
+		outgoing.stopRecording()
+	}
+	r.outgoing = nil
+	r.incoming = nil
+
+	if r.rtpdump != nil {
+		r.rtpdump.Close()
+		native.DeleteRTPDumpWriter(r.rtpdump)
+		r.rtpdump = nil
+	}
+
 	if r.refresher != nil {
 		r.refresher.Stop()
 	}
 
 	r.recorder.Close()
 
+	if r.captions != nil {
+		r.captions.Close()
+		r.captions = nil
+	}
+
+	if r.markers != nil {
+		r.markers.Close()
+	}
+
 	native.DeleteMP4RecorderFacade(r.recorder)
 
+	atomic.AddInt64(&numRecorders, -1)
+
 	r.refresher = nil
 	r.recorder = nil
+	r.stopped = time.Now()
+	listeners := r.onStop
+	r.lock.Unlock()
+
+	// called unlocked, so they can read the recorder state like GetError
+	for _, stopFunc := range listeners {
+		stopFunc()
+	}
+
+	r.processors.run(r.filename)
+}
+
+// OnStop register a listener called when the recorder is stopped and the file is closed, before the processors run
+func (r *Recorder) OnStop(stop func()) {
+	r.lock.Lock()
+	r.onStop = append(r.onStop, stop)
+	r.lock.Unlock()
+}
+
+// GetFilename get the recorded file name
+func (r *Recorder) GetFilename() string {
+	return r.filename
+}
+
+// EnableCaptions write the captions added with AddCaption to a WebVTT sidecar file, timed from the recording start
+func (r *Recorder) EnableCaptions(filename string) error {
+
+	if r.captions != nil {
+		return errors.New("captions already enabled")
+	}
+
+	captions, err := NewWebVTTWriter(filename, r.started)
+	if err != nil {
+		return err
+	}
+	r.captions = captions
+	return nil
+}
+
+// AddCaption add a caption to the sidecar file, EnableCaptions must be called first
+func (r *Recorder) AddCaption(caption *Caption) error {
+
+	if r.captions == nil {
+		return errors.New("captions not enabled")
+	}
+	return r.captions.WriteCaption(caption)
+}
+
+// AddProcessor register a processor run in background on the file once the recorder is stopped
+func (r *Recorder) AddProcessor(processor RecordingProcessor) {
+	r.processors.add(processor)
+}
+
+// OnProcessorEvent register a listener of the processors progress events
+func (r *Recorder) OnProcessorEvent(listener RecordingProcessorListener) {
+	r.processors.addListener(listener)
+}
+
+// WaitProcessors wait until the processors have finished with the recorded file
+func (r *Recorder) WaitProcessors() {
+	r.processors.wait()
 }
@@ -0,0 +1,79 @@
+package mediaserver
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/notedit/sdp"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+func Test_RecorderCreateError(t *testing.T) {
+
+	recorder := NewRecorder("/nonexistent/recording.mp4", true, 0)
+
+	if recorder.GetError() != ErrRecorderCreate {
+		t.Error("expected create error", recorder.GetError())
+	}
+
+	// a recorder that failed to create its file is stopped
+	if recorder.EnableDiskMonitor(DiskWatermarks{}, time.Second) == nil {
+		t.Error("expected the disk monitor refused")
+	}
+	recorder.RecordStream(&IncomingStream{})
+	recorder.Stop()
+}
+
+func Test_RecordOutgoingKeepsTransponder(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	defer transport.Stop()
+
+	info := sdp.NewStreamInfo("stream")
+	info.AddTrack(newTestTrackInfo("video", 1))
+	incoming := transport.CreateIncomingStream(info).GetTrack("video")
+
+	outgoing := transport.CreateOutgoingStreamWithID("out", false, true).GetVideoTracks()[0]
+	transponder := outgoing.AttachTo(incoming)
+	transponder.SelectLayer(1, 0)
+
+	recorder := native.NewMP4RecorderFacade()
+	defer native.DeleteMP4RecorderFacade(recorder)
+
+	outgoing.record(recorder)
+	if outgoing.GetTransponder() != transponder || transponder.GetIncomingTrack() != incoming || transponder.GetSelectedSpatialLayerId() != 1 {
+		t.Error("expected the transponder kept when the recording starts")
+	}
+
+	outgoing.stopRecording()
+	if outgoing.GetTransponder() != transponder || transponder.GetIncomingTrack() != incoming || transponder.GetSelectedSpatialLayerId() != 1 {
+		t.Error("expected the transponder kept when the recording stops")
+	}
+}
+
+func Test_RecorderStopListeners(t *testing.T) {
+
+	atomic.AddInt64(&numRecorders, 1)
+	recorder := &Recorder{recorder: native.NewMP4RecorderFacade(), tracks: map[string]*RecorderTrack{}}
+
+	errs := make(chan error, 1)
+	recorder.OnStop(func() {
+		errs <- recorder.GetError()
+	})
+
+	// the disk monitor stops the recorder on a write error
+	go recorder.fail(&RecorderEvent{Err: ErrRecorderWrite})
+
+	select {
+	case err := <-errs:
+		if err != ErrRecorderWrite {
+			t.Error("unexpected error", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("stop listener deadlocked reading the error")
+	}
+}
@@ -0,0 +1,177 @@
+package mediaserver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+// Recorder event types
+const (
+	RecorderEventDiskLow    = "disk-low"
+	RecorderEventDiskFull   = "disk-full"
+	RecorderEventWriteError = "write-error"
+)
+
+// ErrRecorderCreate is returned by GetError when the recording file could not be created
+var ErrRecorderCreate = errors.New("could not create recording file")
+
+// ErrRecorderWrite is returned by GetError when the mp4 writer failed
+var ErrRecorderWrite = errors.New("could not write recording file")
+
+// DiskWatermarks free space thresholds in bytes, a recorder warns below Warning and stops below Critical
+type DiskWatermarks struct {
+	Warning  uint64
+	Critical uint64
+}
+
+// RecorderEvent is emitted when the recorder runs low on disk or fails to write
+type RecorderEvent struct {
+	Type      string
+	Filename  string
+	FreeBytes uint64
+	Err       error
+}
+
+// RecorderEventListener listener for recorder events
+type RecorderEventListener func(*RecorderEvent)
+
+type diskMonitor struct {
+	watermarks DiskWatermarks
+	ticker     *time.Ticker
+	stop       chan struct{}
+	warned     bool
+}
+
+// OnEvent register a listener for disk and write failure events
+func (r *Recorder) OnEvent(listener RecorderEventListener) {
+	r.onEvent = append(r.onEvent, listener)
+}
+
+// GetError get the error that made the recorder stop, if any
+func (r *Recorder) GetError() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+// EnableDiskMonitor check the free space of the recording disk and the mp4 writer errors every interval,
+// the recording is stopped and finalized when it drops below the critical watermark or the file can not be written.
+// The mp4 writer does not say which file failed, so its errors stop all the recorders monitored when they happen
+func (r *Recorder) EnableDiskMonitor(watermarks DiskWatermarks, interval time.Duration) error {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.recorder == nil {
+		return errors.New("recorder stopped")
+	}
+
+	if r.disk != nil {
+		return errors.New("disk monitor already enabled")
+	}
+
+	if interval <= 0 {
+		return errors.New("invalid disk monitor interval")
+	}
+
+	monitor := &diskMonitor{
+		watermarks: watermarks,
+		ticker:     time.NewTicker(interval),
+		stop:       make(chan struct{}),
+	}
+
+	r.disk = monitor
+
+	go func() {
+		for {
+			select {
+			case <-monitor.ticker.C:
+				if !r.checkDisk(monitor) {
+					return
+				}
+			case <-monitor.stop:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// getWriteErrors get the errors of the mp4 writer since the recorder was created, false if it is stopped
+func (r *Recorder) getWriteErrors() (uint, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.recorder == nil {
+		return 0, false
+	}
+	return r.recorder.GetWriteErrors(), true
+}
+
+func (r *Recorder) checkDisk(monitor *diskMonitor) bool {
+
+	failures, ok := r.getWriteErrors()
+	if !ok {
+		return false
+	}
+
+	if failures > 0 {
+		r.fail(&RecorderEvent{Type: RecorderEventWriteError, Filename: r.filename, Err: ErrRecorderWrite})
+		return false
+	}
+
+	if _, err := os.Stat(r.filename); err != nil {
+		r.fail(&RecorderEvent{Type: RecorderEventWriteError, Filename: r.filename, Err: err})
+		return false
+	}
+
+	free, err := diskFree(filepath.Dir(r.filename))
+	if err != nil {
+		r.fail(&RecorderEvent{Type: RecorderEventWriteError, Filename: r.filename, Err: err})
+		return false
+	}
+
+	if free < monitor.watermarks.Critical {
+		r.fail(&RecorderEvent{Type: RecorderEventDiskFull, Filename: r.filename, FreeBytes: free, Err: syscall.ENOSPC})
+		return false
+	}
+
+	if free < monitor.watermarks.Warning {
+		// Only warn once until the disk recovers
+		if !monitor.warned {
+			monitor.warned = true
+			r.emit(&RecorderEvent{Type: RecorderEventDiskLow, Filename: r.filename, FreeBytes: free})
+		}
+	} else {
+		monitor.warned = false
+	}
+
+	return true
+}
+
+func (r *Recorder) fail(event *RecorderEvent) {
+	r.lock.Lock()
+	r.err = event.Err
+	r.lock.Unlock()
+	r.emit(event)
+	// Stop closes the mp4 file so what has been recorded so far is playable
+	r.Stop()
+}
+
+func (r *Recorder) emit(event *RecorderEvent) {
+	for _, listener := range r.onEvent {
+		listener(event)
+	}
+}
+
+func (r *Recorder) stopDiskMonitor() {
+	if r.disk == nil {
+		return
+	}
+	r.disk.ticker.Stop()
+	close(r.disk.stop)
+	r.disk = nil
+}
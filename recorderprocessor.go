@@ -0,0 +1,85 @@
+package mediaserver
+
+import (
+	"sync"
+)
+
+// RecordingProcessor post processing run on a finished recording, ie transcription, thumbnail extraction or loudness normalization
+type RecordingProcessor interface {
+	// Name of the processor, used in the events
+	Name() string
+	// Process the recorded file, progress can be called with values from 0 to 1
+	Process(filename string, progress func(progress float64)) error
+}
+
+// RecordingProcessorEvent progress of a processor on a finished recording
+type RecordingProcessorEvent struct {
+	Processor string
+	Filename  string
+	// Progress from 0 to 1
+	Progress float64
+	// Done the processor has finished, Err is set if it failed
+	Done bool
+	Err  error
+}
+
+// RecordingProcessorListener listener of the recording processors events
+type RecordingProcessorListener func(event *RecordingProcessorEvent)
+
+type recordingProcessors struct {
+	processors []RecordingProcessor
+	listeners  []RecordingProcessorListener
+	wg         sync.WaitGroup
+	sync.Mutex
+}
+
+func (r *recordingProcessors) add(processor RecordingProcessor) {
+	r.Lock()
+	defer r.Unlock()
+	r.processors = append(r.processors, processor)
+}
+
+func (r *recordingProcessors) addListener(listener RecordingProcessorListener) {
+	r.Lock()
+	defer r.Unlock()
+	r.listeners = append(r.listeners, listener)
+}
+
+func (r *recordingProcessors) emit(event *RecordingProcessorEvent) {
+
+	r.Lock()
+	listeners := r.listeners
+	r.Unlock()
+
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// run the processors in registration order in background, a failing processor does not stop the next ones
+func (r *recordingProcessors) run(filename string) {
+
+	r.Lock()
+	processors := r.processors
+	r.Unlock()
+
+	if len(processors) == 0 {
+		return
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		for _, processor := range processors {
+			name := processor.Name()
+			err := processor.Process(filename, func(progress float64) {
+				r.emit(&RecordingProcessorEvent{Processor: name, Filename: filename, Progress: progress})
+			})
+			r.emit(&RecordingProcessorEvent{Processor: name, Filename: filename, Progress: 1, Done: true, Err: err})
+		}
+	}()
+}
+
+func (r *recordingProcessors) wait() {
+	r.wg.Wait()
+}
@@ -1,12 +1,26 @@
 package mediaserver
 
+import (
+	"sync"
+	"time"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
 type RecorderTrackStopListener func()
 
+// simulcastCheckPeriod how often the best active encoding of a simulcast track is checked
+const simulcastCheckPeriod = time.Second
+
 // RecorderTrack  a track to record
 type RecorderTrack struct {
 	id       string
 	track    *IncomingStreamTrack
 	encoding *Encoding
+	selector native.SimulcastFrameSelector
+	ticker   *time.Ticker
+	stop     chan struct{}
+	sync.Mutex
 }
 
 // NewRecorderTrack create a new recorder track
@@ -20,6 +34,78 @@ func NewRecorderTrack(id string, track *IncomingStreamTrack, encoding *Encoding)
 	return recorderTrack
 }
 
+// newSimulcastRecorderTrack record the best active encoding of a simulcast track in a single mp4 track,
+// switching to another encoding at its first intra frame when the current one goes inactive or a better one appears
+func newSimulcastRecorderTrack(id string, track *IncomingStreamTrack, recorder native.MP4RecorderFacade) *RecorderTrack {
+
+	recorderTrack := &RecorderTrack{}
+	recorderTrack.id = id
+	recorderTrack.track = track
+	recorderTrack.selector = native.NewSimulcastFrameSelector(recorder)
+
+	for _, encoding := range track.GetEncodings() {
+		encoding.GetDepacketizer().AddMediaListener(recorderTrack.selector)
+	}
+
+	recorderTrack.selectEncoding(track.GetFirstEncoding())
+
+	recorderTrack.ticker = time.NewTicker(simulcastCheckPeriod)
+	recorderTrack.stop = make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-recorderTrack.ticker.C:
+				recorderTrack.selectBestEncoding()
+			case <-recorderTrack.stop:
+				return
+			}
+		}
+	}()
+
+	return recorderTrack
+}
+
+func (r *RecorderTrack) selectEncoding(encoding *Encoding) {
+
+	if encoding == nil || encoding == r.encoding {
+		return
+	}
+
+	ssrc := encoding.GetSource().GetMedia().GetSsrc()
+
+	r.selector.Select(ssrc)
+	r.encoding = encoding
+
+	// the switch happens at the next intra frame of the encoding
+	r.track.receiver.SendPLI(ssrc)
+}
+
+func (r *RecorderTrack) selectBestEncoding() {
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.track == nil || r.track.receiver == nil {
+		return
+	}
+
+	active := r.track.GetActiveLayers().Active
+
+	// sorted by bitrate
+	if len(active) == 0 {
+		return
+	}
+
+	best := active[len(active)-1].EncodingId
+
+	for _, encoding := range r.track.GetEncodings() {
+		if encoding.GetID() == best {
+			r.selectEncoding(encoding)
+		}
+	}
+}
+
 // GetID  get recorder track Id
 func (r *RecorderTrack) GetID() string {
 	return r.id
@@ -30,7 +116,7 @@ func (r *RecorderTrack) GetTrack() *IncomingStreamTrack {
 	return r.track
 }
 
-// GetEncoding get encoding Info
+// GetEncoding get encoding Info, for simulcast tracks the encoding currently recorded
 func (r *RecorderTrack) GetEncoding() *Encoding {
 	return r.encoding
 }
@@ -38,10 +124,24 @@ func (r *RecorderTrack) GetEncoding() *Encoding {
 // Stop stop the recorder track
 func (r *RecorderTrack) Stop() {
 
+	r.Lock()
+	defer r.Unlock()
+
 	if r.track == nil {
 		return
 	}
 
+	if r.selector != nil {
+		r.ticker.Stop()
+		close(r.stop)
+		// encodings are released if the incoming track was stopped first
+		for _, encoding := range r.track.GetEncodings() {
+			encoding.GetDepacketizer().RemoveMediaListener(r.selector)
+		}
+		native.DeleteSimulcastFrameSelector(r.selector)
+		r.selector = nil
+	}
+
 	r.track = nil
 	r.encoding = nil
 }
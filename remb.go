@@ -0,0 +1,133 @@
+package mediaserver
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// REMBConfig REMBs sent to the remote peer to cap the bitrate of the video it publishes
+type REMBConfig struct {
+	// MaxBitrate bitrate in bps announced to the publisher
+	MaxBitrate uint
+	// Interval between the REMBs, 1s if zero. Browsers apply the last REMB received until a new one arrives
+	Interval time.Duration
+}
+
+// REMBListener called with the REMB bitrate in bps received from the remote peer
+type REMBListener func(bitrate uint)
+
+// ErrNoIncomingVideo is returned when a REMB is sent on a transport not receiving any video
+var ErrNoIncomingVideo = errors.New("no incoming video tracks")
+
+// the native transport uses 1 as the sender ssrc of its own feedback
+const rembSenderSSRC = 1
+
+// incomingVideoSSRCs the media ssrcs of all the encodings of the incoming video tracks, packed in network order
+func (t *Transport) incomingVideoSSRCs() []byte {
+
+	ssrcs := []byte{}
+	for _, stream := range t.GetIncomingStreams() {
+		for _, track := range stream.GetVideoTracks() {
+			for _, encoding := range track.GetEncodings() {
+				ssrc := make([]byte, 4)
+				binary.BigEndian.PutUint32(ssrc, uint32(encoding.GetSource().GetMedia().GetSsrc()))
+				ssrcs = append(ssrcs, ssrc...)
+			}
+		}
+	}
+	return ssrcs
+}
+
+// SendREMB send a REMB with the given bitrate in bps for all the incoming video tracks now,
+// the remote peer will not send more than that bitrate until a new REMB is received
+func (t *Transport) SendREMB(bitrate uint) error {
+
+	if t.transport == nil {
+		return errors.New("transport stopped")
+	}
+
+	ssrcs := t.incomingVideoSSRCs()
+	if len(ssrcs) == 0 {
+		return ErrNoIncomingVideo
+	}
+
+	if !native.TransportSendREMB(t.transport, rembSenderSSRC, bitrate, &ssrcs[0], len(ssrcs)) {
+		return errors.New("can not send remb")
+	}
+	return nil
+}
+
+// SetREMBConfig start sending REMBs periodically to the remote peer as configured, replacing the previous config
+func (t *Transport) SetREMBConfig(config REMBConfig) {
+
+	if config.Interval <= 0 {
+		config.Interval = time.Second
+	}
+
+	t.Lock()
+	if t.rembStop != nil {
+		close(t.rembStop)
+	}
+	stop := make(chan struct{})
+	t.rembConfig = &config
+	t.rembStop = stop
+	t.Unlock()
+
+	go t.sendREMBs(config, stop)
+}
+
+// GetREMBConfig get the REMB config, nil if not set
+func (t *Transport) GetREMBConfig() *REMBConfig {
+	t.Lock()
+	defer t.Unlock()
+	return t.rembConfig
+}
+
+// DisableREMB stop sending REMBs, the remote peer keeps applying the last one sent
+func (t *Transport) DisableREMB() {
+	t.Lock()
+	defer t.Unlock()
+	if t.rembStop != nil {
+		close(t.rembStop)
+		t.rembStop = nil
+	}
+	t.rembConfig = nil
+}
+
+func (t *Transport) sendREMBs(config REMBConfig, stop chan struct{}) {
+
+	ticker := time.NewTicker(config.Interval)
+	defer ticker.Stop()
+
+	for {
+		// the publisher may not have any video yet, it is sent on the next tick
+		t.SendREMB(config.MaxBitrate)
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// OnREMB register a listener of the REMBs received from the remote peer for the outgoing tracks,
+// so the application can throttle the quality sent. REMBs are checked every second and the last bitrate is reported
+func (t *Transport) OnREMB(listener REMBListener) ListenerOff {
+	return t.getStateMonitor().rembListeners.on(listener)
+}
+
+// receivedREMBs count and last bitrate of the REMBs received for the outgoing tracks
+func (t *Transport) receivedREMBs() (uint, uint) {
+
+	feedback := &FeedbackStats{}
+	for _, stream := range t.GetOutgoingStreams() {
+		for _, track := range stream.GetTracks() {
+			feedback.add(track.GetFeedbackStats())
+		}
+	}
+	return feedback.REMBsReceived, feedback.Remb
+}
@@ -0,0 +1,317 @@
+package mediaserver
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RecordingSegment is one file of a rolling recording, End is zero while it is being recorded
+type RecordingSegment struct {
+	Filename string    `json:"filename"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Size     int64     `json:"size"`
+}
+
+// RollingRecorder records continuously into a directory, rotating files every segment duration
+// and pruning the oldest ones by age and total size
+type RollingRecorder struct {
+	directory string
+	prefix    string
+	segment   time.Duration
+	maxAge    time.Duration
+	maxBytes  int64
+	tracks    []*IncomingStreamTrack
+	recorder  *Recorder
+	current   *RecordingSegment
+	index     *recordingIndex
+	timer     *time.Timer
+	onSegment []func(*RecordingSegment)
+	lock      sync.Mutex
+	stopped   bool
+}
+
+const rollingRecorderIndex = "index.json"
+
+// NewRollingRecorder create a rolling recorder writing prefix-<start time>.mp4 files into directory,
+// the index of a previous run in the same directory is kept
+func NewRollingRecorder(directory string, prefix string, segment time.Duration) (*RollingRecorder, error) {
+
+	if segment <= 0 {
+		return nil, errors.New("invalid segment duration")
+	}
+
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return nil, err
+	}
+
+	index, err := loadRecordingIndex(filepath.Join(directory, rollingRecorderIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := &RollingRecorder{
+		directory: directory,
+		prefix:    prefix,
+		segment:   segment,
+		index:     index,
+	}
+
+	recorder.rotate()
+
+	return recorder, nil
+}
+
+// SetRetention set how long and how many bytes of finished segments are kept, zero means unlimited
+func (r *RollingRecorder) SetRetention(maxAge time.Duration, maxBytes int64) {
+	r.lock.Lock()
+	r.maxAge = maxAge
+	r.maxBytes = maxBytes
+	r.lock.Unlock()
+
+	r.prune()
+}
+
+// Record record an incoming track in the current and all following segments until it is stopped
+func (r *RollingRecorder) Record(incoming *IncomingStreamTrack) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.stopped {
+		return
+	}
+
+	r.tracks = append(r.tracks, incoming)
+	r.recorder.Record(incoming)
+
+	incoming.OnStop(func() {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		for i, track := range r.tracks {
+			if track == incoming {
+				r.tracks = append(r.tracks[:i], r.tracks[i+1:]...)
+				break
+			}
+		}
+	})
+}
+
+// RecordStream record all the tracks of an incoming stream
+func (r *RollingRecorder) RecordStream(incoming *IncomingStream) {
+
+	for _, track := range incoming.GetTracks() {
+		r.Record(track)
+	}
+}
+
+// OnSegment register a listener called when a segment file is finished
+func (r *RollingRecorder) OnSegment(listener func(*RecordingSegment)) {
+	r.lock.Lock()
+	r.onSegment = append(r.onSegment, listener)
+	r.lock.Unlock()
+}
+
+// Query get the segments overlapping the from-to time range, ordered by start time
+func (r *RollingRecorder) Query(from time.Time, to time.Time) []*RecordingSegment {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	segments := r.index.query(from, to)
+	if r.current != nil && r.current.Start.Before(to) {
+		segment := *r.current
+		segments = append(segments, &segment)
+	}
+	return segments
+}
+
+// GetSegments get all the indexed segments
+func (r *RollingRecorder) GetSegments() []*RecordingSegment {
+	return r.Query(time.Time{}, time.Now().Add(r.segment))
+}
+
+// Stop finish the current segment and stop recording
+func (r *RollingRecorder) Stop() {
+
+	r.lock.Lock()
+	if r.stopped {
+		r.lock.Unlock()
+		return
+	}
+	r.stopped = true
+	r.timer.Stop()
+	recorder, segment := r.recorder, r.current
+	r.recorder, r.current = nil, nil
+	r.tracks = nil
+	r.lock.Unlock()
+
+	r.finish(recorder, segment)
+	r.prune()
+}
+
+func (r *RollingRecorder) rotate() {
+
+	r.lock.Lock()
+
+	if r.stopped {
+		r.lock.Unlock()
+		return
+	}
+
+	now := time.Now()
+	previous, finished := r.recorder, r.current
+
+	r.current = &RecordingSegment{
+		Filename: filepath.Join(r.directory, r.prefix+"-"+now.UTC().Format("20060102T150405")+".mp4"),
+		Start:    now,
+	}
+	r.recorder = NewRecorder(r.current.Filename, true, 0)
+	for _, track := range r.tracks {
+		r.recorder.Record(track)
+		// Get an intra frame so the new file does not wait for the next one
+		track.Refresh()
+	}
+
+	// Align the rotations to the segment boundaries, so hourly files start on the hour
+	next := now.Truncate(r.segment).Add(r.segment)
+	r.timer = time.AfterFunc(next.Sub(now), r.rotate)
+
+	r.lock.Unlock()
+
+	if previous != nil {
+		r.finish(previous, finished)
+		r.prune()
+	}
+}
+
+func (r *RollingRecorder) finish(recorder *Recorder, segment *RecordingSegment) {
+
+	recorder.Stop()
+
+	segment.End = time.Now()
+	if info, err := os.Stat(segment.Filename); err == nil {
+		segment.Size = info.Size()
+	}
+
+	r.lock.Lock()
+	r.index.add(segment)
+	r.index.save()
+	listeners := r.onSegment
+	r.lock.Unlock()
+
+	for _, listener := range listeners {
+		listener(segment)
+	}
+}
+
+func (r *RollingRecorder) prune() {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.index.prune(time.Now(), r.maxAge, r.maxBytes) {
+		r.index.save()
+	}
+}
+
+type recordingIndex struct {
+	filename string
+	segments []*RecordingSegment
+}
+
+func loadRecordingIndex(filename string) (*recordingIndex, error) {
+
+	index := &recordingIndex{filename: filename}
+
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &index.segments); err != nil {
+		return nil, err
+	}
+
+	// Drop the files removed behind our back and the segments of a run that did not finish
+	segments := index.segments[:0]
+	for _, segment := range index.segments {
+		if segment.End.IsZero() {
+			continue
+		}
+		if _, err := os.Stat(segment.Filename); err == nil {
+			segments = append(segments, segment)
+		}
+	}
+	index.segments = segments
+
+	return index, nil
+}
+
+func (i *recordingIndex) add(segment *RecordingSegment) {
+	i.segments = append(i.segments, segment)
+	sort.Slice(i.segments, func(a, b int) bool {
+		return i.segments[a].Start.Before(i.segments[b].Start)
+	})
+}
+
+func (i *recordingIndex) query(from time.Time, to time.Time) []*RecordingSegment {
+
+	segments := []*RecordingSegment{}
+	for _, segment := range i.segments {
+		if segment.Start.Before(to) && segment.End.After(from) {
+			copied := *segment
+			segments = append(segments, &copied)
+		}
+	}
+	return segments
+}
+
+// prune remove the oldest segments older than maxAge or over maxBytes, returns whether the index changed
+func (i *recordingIndex) prune(now time.Time, maxAge time.Duration, maxBytes int64) bool {
+
+	var total int64
+	for _, segment := range i.segments {
+		total += segment.Size
+	}
+
+	removed := 0
+	for _, segment := range i.segments {
+		expired := maxAge > 0 && now.Sub(segment.End) > maxAge
+		oversized := maxBytes > 0 && total > maxBytes
+		if !expired && !oversized {
+			break
+		}
+		os.Remove(segment.Filename)
+		total -= segment.Size
+		removed++
+	}
+
+	i.segments = i.segments[removed:]
+
+	return removed > 0
+}
+
+func (i *recordingIndex) save() error {
+
+	data, err := json.Marshal(i.segments)
+	if err != nil {
+		return err
+	}
+
+	// Write and rename so a crash never leaves a truncated index
+	tmp := i.filename + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, i.filename)
+}
@@ -0,0 +1,67 @@
+package mediaserver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_RecordingIndex(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "rolling")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	index, err := loadRecordingIndex(filepath.Join(directory, rollingRecorderIndex))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 4; i++ {
+		filename := filepath.Join(directory, "segment"+string(rune('a'+i))+".mp4")
+		ioutil.WriteFile(filename, make([]byte, 100), 0644)
+		index.add(&RecordingSegment{
+			Filename: filename,
+			Start:    start.Add(time.Duration(i) * time.Hour),
+			End:      start.Add(time.Duration(i+1) * time.Hour),
+			Size:     100,
+		})
+	}
+
+	if segments := index.query(start.Add(90*time.Minute), start.Add(150*time.Minute)); len(segments) != 2 {
+		t.Error("expected 2 overlapping segments", len(segments))
+	}
+
+	if err := index.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Drop the oldest by size, then by age
+	if !index.prune(start.Add(4*time.Hour), 0, 300) || len(index.segments) != 3 {
+		t.Error("expected the oldest segment pruned by size", len(index.segments))
+	}
+
+	if !index.prune(start.Add(4*time.Hour), 30*time.Minute, 0) || len(index.segments) != 1 {
+		t.Error("expected the segments older than 30 minutes pruned", len(index.segments))
+	}
+
+	if _, err := os.Stat(filepath.Join(directory, "segmenta.mp4")); !os.IsNotExist(err) {
+		t.Error("expected pruned file removed")
+	}
+
+	// The saved index still lists the pruned files, they are dropped on load
+	loaded, err := loadRecordingIndex(index.filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(loaded.segments) != 1 {
+		t.Error("expected the missing files dropped from the loaded index", len(loaded.segments))
+	}
+}
@@ -0,0 +1,49 @@
+package mediaserver
+
+import (
+	"errors"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// ErrInvalidRTCPApp is returned when an rtcp APP packet can not be built from the given fields
+var ErrInvalidRTCPApp = errors.New("invalid rtcp app packet, name must be 4 ascii chars, subtype 0-31 and data a multiple of 4 bytes")
+
+// CheckRTCPApp check the fields of an rtcp APP packet, see RFC 3550 section 6.7
+func CheckRTCPApp(subtype int, name string, data []byte) error {
+
+	if subtype < 0 || subtype > 31 || len(data)%4 != 0 || len(name) != 4 {
+		return ErrInvalidRTCPApp
+	}
+
+	for i := 0; i < len(name); i++ {
+		if name[i] < 0x20 || name[i] > 0x7e {
+			return ErrInvalidRTCPApp
+		}
+	}
+	return nil
+}
+
+// SendRTCPApp send an application defined rtcp APP packet to the remote peer, ie for a proprietary control channel with native clients.
+// The packet is sent on its own compound packet from the given ssrc, usually one of the outgoing tracks ones.
+// Received APP packets are not reported, the native transport drops them
+func (t *Transport) SendRTCPApp(ssrc uint, subtype int, name string, data []byte) error {
+
+	if err := CheckRTCPApp(subtype, name, data); err != nil {
+		return err
+	}
+
+	if t.transport == nil {
+		return errors.New("transport stopped")
+	}
+
+	var payload *byte
+	if len(data) > 0 {
+		payload = &data[0]
+	}
+
+	if !native.TransportSendRTCPApp(t.transport, ssrc, subtype, name, payload, len(data)) {
+		return ErrInvalidRTCPApp
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package mediaserver
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/notedit/sdp"
+)
+
+// SDES crypto suites, RFC 4568 and RFC 7714 naming
+const (
+	SDESAESCM128HMACSHA1_80 = "AES_CM_128_HMAC_SHA1_80"
+	SDESAESCM128HMACSHA1_32 = "AES_CM_128_HMAC_SHA1_32"
+	SDESAEADAES128GCM       = "AEAD_AES_128_GCM"
+	SDESAEADAES256GCM       = "AEAD_AES_256_GCM"
+)
+
+// master key + master salt length of each suite
+var sdesKeyLengths = map[string]int{
+	SDESAESCM128HMACSHA1_80: 30,
+	SDESAESCM128HMACSHA1_32: 30,
+	SDESAEADAES128GCM:       28,
+	SDESAEADAES256GCM:       44,
+}
+
+// ErrInvalidSDESCrypto the crypto attribute is malformed, uses an unsupported suite or a wrong key length
+var ErrInvalidSDESCrypto = errors.New("invalid sdes crypto")
+
+// SDESCrypto SDES crypto attribute, a=crypto:<tag> <suite> inline:<key>
+type SDESCrypto struct {
+	Tag   int
+	Suite string
+	// Key base64 master key and salt
+	Key string
+}
+
+// GenerateSDESCrypto generate a random key for the suite
+func GenerateSDESCrypto(tag int, suite string) (*SDESCrypto, error) {
+
+	length, ok := sdesKeyLengths[suite]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported suite %q", ErrInvalidSDESCrypto, suite)
+	}
+
+	key := make([]byte, length)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	return &SDESCrypto{Tag: tag, Suite: suite, Key: base64.StdEncoding.EncodeToString(key)}, nil
+}
+
+// ParseSDESCrypto parse the value of an a=crypto attribute, ie "1 AES_CM_128_HMAC_SHA1_80 inline:KEY|2^31"
+// Key lifetime and MKI parameters are ignored
+func ParseSDESCrypto(attr string) (*SDESCrypto, error) {
+
+	attr = strings.TrimPrefix(strings.TrimSpace(attr), "a=crypto:")
+	fields := strings.Fields(attr)
+	if len(fields) < 3 || !strings.HasPrefix(fields[2], "inline:") {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSDESCrypto, attr)
+	}
+
+	tag, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: bad tag %q", ErrInvalidSDESCrypto, fields[0])
+	}
+
+	key := strings.SplitN(strings.TrimPrefix(fields[2], "inline:"), "|", 2)[0]
+
+	crypto := &SDESCrypto{Tag: tag, Suite: fields[1], Key: key}
+	if err := crypto.Validate(); err != nil {
+		return nil, err
+	}
+	return crypto, nil
+}
+
+// Validate check the suite is supported and the key length matches it
+func (c *SDESCrypto) Validate() error {
+
+	length, ok := sdesKeyLengths[c.Suite]
+	if !ok {
+		return fmt.Errorf("%w: unsupported suite %q", ErrInvalidSDESCrypto, c.Suite)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(c.Key)
+	if err != nil || len(key) != length {
+		return fmt.Errorf("%w: bad key for suite %s", ErrInvalidSDESCrypto, c.Suite)
+	}
+	return nil
+}
+
+// String get the value of the a=crypto attribute
+func (c *SDESCrypto) String() string {
+	return fmt.Sprintf("%d %s inline:%s", c.Tag, c.Suite, c.Key)
+}
+
+// GetSDESCrypto get the SDES crypto of a remote sdp parsed by ParseOffer, nil if it has none
+func GetSDESCrypto(offer *sdp.SDPInfo) (*SDESCrypto, error) {
+
+	info := offer.GetCrypto()
+	if info == nil {
+		return nil, nil
+	}
+
+	return ParseSDESCrypto(fmt.Sprintf("%d %s %s", info.GetTag(), info.GetCipherSuite(), info.GetKeyParams()))
+}
+
+// sdesPlaceholderFingerprint lets the sdp parser, which requires a fingerprint, parse SDES only offers
+const sdesPlaceholderFingerprint = "a=fingerprint:sha-256 00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00\r\n"
+
+// isSDESOnly the raw sdp has SDES crypto attributes and no DTLS fingerprint
+func isSDESOnly(str string) bool {
+	return strings.Contains(str, "a=crypto:") && !strings.Contains(str, "a=fingerprint:")
+}
+
+// withPlaceholderFingerprint add the placeholder fingerprint at session level, drop the DTLS info once parsed
+func withPlaceholderFingerprint(str string) string {
+	index := strings.Index(str, "m=")
+	if index < 0 {
+		return str + sdesPlaceholderFingerprint
+	}
+	return str[:index] + sdesPlaceholderFingerprint + str[index:]
+}
+
+// parseSDESCryptoLines set the first valid a=crypto attribute of the raw sdp on the offer, the sdp parser ignores them
+func parseSDESCryptoLines(str string, offer *sdp.SDPInfo) {
+
+	if offer.GetCrypto() != nil {
+		return
+	}
+
+	for _, line := range strings.Split(str, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "a=crypto:") {
+			continue
+		}
+		crypto, err := ParseSDESCrypto(line)
+		if err != nil {
+			continue
+		}
+		offer.SetCrypto(sdp.NewCryptoInfo(crypto.Tag, crypto.Suite, "inline:"+crypto.Key, ""))
+		return
+	}
+}
+
+// CreateSDESTransport create a new Transport keyed with SDES instead of DTLS, for legacy SIP trunks and hardware encoders
+// A local key is generated with the remote suite and tag, send it back in the a=crypto attribute of the answer.
+// ICE is still used to associate the remote address, so the remote sdp must include ICE info
+func (e *Endpoint) CreateSDESTransport(remoteSdp *sdp.SDPInfo, localSdp *sdp.SDPInfo, remoteCrypto *SDESCrypto) (*Transport, *SDESCrypto, error) {
+
+	if remoteSdp.GetICE() == nil {
+		return nil, nil, fmt.Errorf("%w: missing ice info", ErrMalformedOffer)
+	}
+
+	if err := remoteCrypto.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	localCrypto, err := GenerateSDESCrypto(remoteCrypto.Tag, remoteCrypto.Suite)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transport := e.createTransport(remoteSdp, localSdp, ICELite, false)
+
+	if err := transport.SetSDESCrypto(localCrypto, remoteCrypto); err != nil {
+		transport.Stop()
+		return nil, nil, err
+	}
+
+	return transport, localCrypto, nil
+}
+
+// SetSDESCrypto key the SRTP sessions of this Transport with SDES, local is used for sending and remote for receiving
+func (t *Transport) SetSDESCrypto(local *SDESCrypto, remote *SDESCrypto) error {
+
+	if err := local.Validate(); err != nil {
+		return err
+	}
+
+	if err := remote.Validate(); err != nil {
+		return err
+	}
+
+	if t.transport.SetLocalCryptoSDES(local.Suite, local.Key) == 0 {
+		return fmt.Errorf("%w: local key rejected", ErrInvalidSDESCrypto)
+	}
+
+	if t.transport.SetRemoteCryptoSDES(remote.Suite, remote.Key) == 0 {
+		return fmt.Errorf("%w: remote key rejected", ErrInvalidSDESCrypto)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package mediaserver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/notedit/sdp"
+)
+
+const (
+	testSDESKey30 = "WVNfX19zZW1jdGwgKCkgewkyMjA7fQp9CnVubGVz"
+	testSDESKey28 = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGw=="
+	testSDESKey44 = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIjJCUmJygpKis="
+	testSDESKey16 = "AAECAwQFBgcICQoLDA0ODw=="
+)
+
+func Test_ParseSDESCrypto(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		attr  string
+		tag   int
+		suite string
+		key   string
+		valid bool
+	}{
+		{"aes cm 80", "1 AES_CM_128_HMAC_SHA1_80 inline:" + testSDESKey30, 1, SDESAESCM128HMACSHA1_80, testSDESKey30, true},
+		{"aes cm 32", "2 AES_CM_128_HMAC_SHA1_32 inline:" + testSDESKey30, 2, SDESAESCM128HMACSHA1_32, testSDESKey30, true},
+		{"aead 128", "1 AEAD_AES_128_GCM inline:" + testSDESKey28, 1, SDESAEADAES128GCM, testSDESKey28, true},
+		{"aead 256", "1 AEAD_AES_256_GCM inline:" + testSDESKey44, 1, SDESAEADAES256GCM, testSDESKey44, true},
+		{"attribute line", "a=crypto:1 AES_CM_128_HMAC_SHA1_80 inline:" + testSDESKey30 + "\r\n", 1, SDESAESCM128HMACSHA1_80, testSDESKey30, true},
+		{"lifetime and mki", "1 AES_CM_128_HMAC_SHA1_80 inline:" + testSDESKey30 + "|2^31|1:1", 1, SDESAESCM128HMACSHA1_80, testSDESKey30, true},
+		{"session params", "1 AES_CM_128_HMAC_SHA1_80 inline:" + testSDESKey30 + " UNENCRYPTED_SRTCP", 1, SDESAESCM128HMACSHA1_80, testSDESKey30, true},
+		{"unsupported suite", "1 F8_128_HMAC_SHA1_80 inline:" + testSDESKey30, 0, "", "", false},
+		{"empty suite", "1  inline:" + testSDESKey30, 0, "", "", false},
+		{"short key", "1 AES_CM_128_HMAC_SHA1_80 inline:" + testSDESKey16, 0, "", "", false},
+		{"key of another suite", "1 AEAD_AES_256_GCM inline:" + testSDESKey30, 0, "", "", false},
+		{"key not base64", "1 AES_CM_128_HMAC_SHA1_80 inline:not*base64", 0, "", "", false},
+		{"bad tag", "one AES_CM_128_HMAC_SHA1_80 inline:" + testSDESKey30, 0, "", "", false},
+		{"missing inline", "1 AES_CM_128_HMAC_SHA1_80 " + testSDESKey30, 0, "", "", false},
+		{"missing key", "1 AES_CM_128_HMAC_SHA1_80", 0, "", "", false},
+		{"empty", "", 0, "", "", false},
+	}
+
+	for _, test := range tests {
+		crypto, err := ParseSDESCrypto(test.attr)
+		if !test.valid {
+			if !errors.Is(err, ErrInvalidSDESCrypto) {
+				t.Error(test.name, "expected invalid sdes crypto", crypto, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Error(test.name, "unexpected error", err)
+			continue
+		}
+		if crypto.Tag != test.tag || crypto.Suite != test.suite || crypto.Key != test.key {
+			t.Error(test.name, "unexpected sdes crypto", crypto)
+		}
+	}
+}
+
+func Test_GenerateSDESCrypto(t *testing.T) {
+
+	for suite := range sdesKeyLengths {
+		crypto, err := GenerateSDESCrypto(3, suite)
+		if err != nil {
+			t.Error(suite, "unexpected error", err)
+			continue
+		}
+		parsed, err := ParseSDESCrypto(crypto.String())
+		if err != nil || *parsed != *crypto {
+			t.Error(suite, "expected the generated crypto parsed back", parsed, err)
+		}
+	}
+
+	if _, err := GenerateSDESCrypto(1, "NULL"); !errors.Is(err, ErrInvalidSDESCrypto) {
+		t.Error("expected unsupported suite", err)
+	}
+}
+
+func Test_ParseSDESCryptoLines(t *testing.T) {
+
+	offer := sdp.NewSDPInfo()
+
+	// the first valid attribute is used
+	parseSDESCryptoLines("a=crypto:1 F8_128_HMAC_SHA1_80 inline:"+testSDESKey30+"\r\n"+
+		"a=crypto:2 AES_CM_128_HMAC_SHA1_32 inline:"+testSDESKey30+"\r\n"+
+		"a=crypto:3 AES_CM_128_HMAC_SHA1_80 inline:"+testSDESKey30+"\r\n", offer)
+
+	crypto, err := GetSDESCrypto(offer)
+	if err != nil || crypto == nil || crypto.Tag != 2 || crypto.Suite != SDESAESCM128HMACSHA1_32 || crypto.Key != testSDESKey30 {
+		t.Error("unexpected sdes crypto", crypto, err)
+	}
+
+	if crypto, err := GetSDESCrypto(sdp.NewSDPInfo()); crypto != nil || err != nil {
+		t.Error("expected no sdes crypto", crypto, err)
+	}
+}
@@ -0,0 +1,160 @@
+package mediaserver
+
+import (
+	"encoding/binary"
+	"time"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// SenderReportConfig rtcp sender reports sent by the server on top of the native ones
+type SenderReportConfig struct {
+	// Interval between the sender reports of each outgoing track, 1s if zero
+	Interval time.Duration
+	// BandwidthFraction when set, the interval grows so the sender reports use at most this fraction
+	// of the bitrate sent, like the rtcp interval of RFC 3550, Interval is then the minimum
+	BandwidthFraction float64
+	// SendOnAttach send a sender report right after an outgoing track is attached so new subscribers sync audio and video sooner
+	SendOnAttach bool
+}
+
+// average size of a sender report with the udp, ip and srtcp overhead
+const senderReportSize = 100
+
+// the source needs to have sent some packets after an attach for the sender report to map the new timestamps
+const senderReportAttachDelay = 100 * time.Millisecond
+
+// SetSenderReportConfig start sending sender reports for all the outgoing tracks as configured, replacing the previous config
+func (t *Transport) SetSenderReportConfig(config SenderReportConfig) {
+
+	if config.Interval <= 0 {
+		config.Interval = time.Second
+	}
+
+	t.Lock()
+	if t.senderReportStop != nil {
+		close(t.senderReportStop)
+	}
+	stop := make(chan struct{})
+	t.senderReportConfig = &config
+	t.senderReportStop = stop
+	t.Unlock()
+
+	go t.sendSenderReports(config, stop)
+}
+
+// GetSenderReportConfig get the sender report config, nil if not set
+func (t *Transport) GetSenderReportConfig() *SenderReportConfig {
+	t.Lock()
+	defer t.Unlock()
+	return t.senderReportConfig
+}
+
+func (t *Transport) stopSenderReports() {
+	t.Lock()
+	defer t.Unlock()
+	if t.senderReportStop != nil {
+		close(t.senderReportStop)
+		t.senderReportStop = nil
+	}
+}
+
+func (t *Transport) sendSenderReports(config SenderReportConfig, stop chan struct{}) {
+
+	timer := time.NewTimer(config.Interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-stop:
+			return
+		}
+
+		t.Lock()
+		tracks := make([]*OutgoingStreamTrack, 0, len(t.outgoingStreamTracks))
+		for _, track := range t.outgoingStreamTracks {
+			tracks = append(tracks, track)
+		}
+		t.Unlock()
+
+		var bitrate uint
+		for _, track := range tracks {
+			if track.SendSenderReport() {
+				bitrate += getStatsFromOutgoingSource(track.source.GetMedia()).Bitrate
+			}
+		}
+
+		timer.Reset(senderReportInterval(config.Interval, len(tracks), bitrate, config.BandwidthFraction))
+	}
+}
+
+// senderReportInterval interval so the sender reports of tracks use at most fraction of bitrate, never below minimum
+func senderReportInterval(minimum time.Duration, tracks int, bitrate uint, fraction float64) time.Duration {
+
+	if fraction <= 0 || bitrate == 0 {
+		return minimum
+	}
+
+	interval := time.Duration(float64(tracks*senderReportSize*8) / (fraction * float64(bitrate)) * float64(time.Second))
+	if interval < minimum {
+		return minimum
+	}
+	return interval
+}
+
+// SendSenderReport send a sender report of the track now, returns false if the track is not sent over a transport
+func (o *OutgoingStreamTrack) SendSenderReport() bool {
+
+	if o.sender == nil || o.owner == nil {
+		return false
+	}
+
+	transport := o.owner.transport
+	if transport == nil {
+		return false
+	}
+
+	return native.TransportSendSenderReport(transport, o.source)
+}
+
+// sendBye send a RTCP BYE for the media, rtx and fec ssrcs of the track, once, so the remote peer ends the stream
+// right away instead of waiting for its timeout. It is queued before the source group can be removed
+func (o *OutgoingStreamTrack) sendBye(transport native.DTLSICETransport) {
+
+	if o.byeSent || o.source == nil || transport == nil {
+		return
+	}
+	o.byeSent = true
+
+	ssrcs := []byte{}
+	for _, source := range []native.RTPOutgoingSource{o.source.GetMedia(), o.source.GetRtx(), o.source.GetFec()} {
+		if ssrc := source.GetSsrc(); ssrc > 0 {
+			packed := make([]byte, 4)
+			binary.BigEndian.PutUint32(packed, uint32(ssrc))
+			ssrcs = append(ssrcs, packed...)
+		}
+	}
+
+	if len(ssrcs) == 0 {
+		return
+	}
+
+	native.TransportSendBYE(transport, &ssrcs[0], len(ssrcs))
+}
+
+// sendSenderReportOnAttach send a sender report shortly after the track is attached if the transport is configured so
+func (o *OutgoingStreamTrack) sendSenderReportOnAttach() {
+
+	if o.owner == nil {
+		return
+	}
+
+	if config := o.owner.GetSenderReportConfig(); config == nil || !config.SendOnAttach {
+		return
+	}
+
+	time.AfterFunc(senderReportAttachDelay, func() {
+		o.SendSenderReport()
+	})
+}
@@ -0,0 +1,360 @@
+package mediaserver
+
+import (
+	"encoding/json"
+
+	"github.com/notedit/sdp"
+)
+
+// CandidateSnapshot serialized ICE candidate
+type CandidateSnapshot struct {
+	Foundation  string `json:"foundation"`
+	ComponentID int    `json:"componentId"`
+	Transport   string `json:"transport"`
+	Priority    int    `json:"priority"`
+	Address     string `json:"address"`
+	Port        int    `json:"port"`
+	Type        string `json:"type"`
+	RelAddr     string `json:"relAddr,omitempty"`
+	RelPort     int    `json:"relPort,omitempty"`
+}
+
+// CodecSnapshot serialized codec
+type CodecSnapshot struct {
+	Codec  string            `json:"codec"`
+	Type   int               `json:"type"`
+	RTX    int               `json:"rtx,omitempty"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// MediaSnapshot serialized codecs and header extensions of a media
+type MediaSnapshot struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Codecs     []CodecSnapshot `json:"codecs"`
+	Extensions map[int]string  `json:"extensions,omitempty"`
+}
+
+// SourceGroupSnapshot serialized ssrc group
+type SourceGroupSnapshot struct {
+	Semantics string `json:"semantics"`
+	SSRCs     []uint `json:"ssrcs"`
+}
+
+// EncodingSnapshot serialized simulcast encoding
+type EncodingSnapshot struct {
+	ID     string `json:"id"`
+	Paused bool   `json:"paused,omitempty"`
+}
+
+// TrackSnapshot serialized track layout
+type TrackSnapshot struct {
+	ID        string                `json:"id"`
+	Media     string                `json:"media"`
+	MediaID   string                `json:"mediaId,omitempty"`
+	SSRCs     []uint                `json:"ssrcs,omitempty"`
+	Groups    []SourceGroupSnapshot `json:"groups,omitempty"`
+	Encodings [][]EncodingSnapshot  `json:"encodings,omitempty"`
+	Metadata  map[string]string     `json:"metadata,omitempty"`
+}
+
+// StreamSnapshot serialized stream layout and application metadata
+type StreamSnapshot struct {
+	ID       string            `json:"id"`
+	Tracks   []TrackSnapshot   `json:"tracks"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// TransportSnapshot serialized ICE and DTLS parameters of the remote peer and stream layouts of a Transport
+// DTLS keys and the native state can not be saved, so a restored Transport needs an ICE restart and a new DTLS handshake
+type TransportSnapshot struct {
+	RemoteUfrag       string              `json:"remoteUfrag"`
+	RemotePassword    string              `json:"remotePassword"`
+	RemoteSetup       string              `json:"remoteSetup"`
+	RemoteHash        string              `json:"remoteHash"`
+	RemoteFingerprint string              `json:"remoteFingerprint"`
+	RemoteCandidates  []CandidateSnapshot `json:"remoteCandidates,omitempty"`
+	ICEMode           ICEMode             `json:"iceMode"`
+	RemoteAudio       *MediaSnapshot      `json:"remoteAudio,omitempty"`
+	RemoteVideo       *MediaSnapshot      `json:"remoteVideo,omitempty"`
+	LocalAudio        *MediaSnapshot      `json:"localAudio,omitempty"`
+	LocalVideo        *MediaSnapshot      `json:"localVideo,omitempty"`
+	IncomingStreams   []StreamSnapshot    `json:"incomingStreams,omitempty"`
+	OutgoingStreams   []StreamSnapshot    `json:"outgoingStreams,omitempty"`
+}
+
+func snapshotMedia(media *sdp.MediaInfo) *MediaSnapshot {
+
+	if media == nil {
+		return nil
+	}
+
+	snapshot := &MediaSnapshot{
+		ID:         media.GetID(),
+		Type:       media.GetType(),
+		Codecs:     []CodecSnapshot{},
+		Extensions: map[int]string{},
+	}
+
+	for _, codec := range media.GetCodecs() {
+		params := map[string]string{}
+		for key, value := range codec.GetParams() {
+			params[key] = value
+		}
+		snapshot.Codecs = append(snapshot.Codecs, CodecSnapshot{
+			Codec:  codec.GetCodec(),
+			Type:   codec.GetType(),
+			RTX:    codec.GetRTX(),
+			Params: params,
+		})
+	}
+
+	for id, uri := range media.GetExtensions() {
+		snapshot.Extensions[id] = uri
+	}
+	return snapshot
+}
+
+func (m *MediaSnapshot) mediaInfo() *sdp.MediaInfo {
+
+	if m == nil {
+		return nil
+	}
+
+	media := sdp.NewMediaInfo(m.ID, m.Type)
+	for _, codec := range m.Codecs {
+		codecInfo := sdp.NewCodecInfo(codec.Codec, codec.Type)
+		if codec.RTX > 0 {
+			codecInfo.SetRTX(codec.RTX)
+		}
+		codecInfo.AddParams(codec.Params)
+		media.AddCodec(codecInfo)
+	}
+	for id, uri := range m.Extensions {
+		media.AddExtension(id, uri)
+	}
+	return media
+}
+
+func snapshotStream(info *sdp.StreamInfo) StreamSnapshot {
+
+	snapshot := StreamSnapshot{ID: info.GetID(), Tracks: []TrackSnapshot{}}
+
+	for _, track := range info.GetTracks() {
+		trackSnapshot := TrackSnapshot{
+			ID:      track.GetID(),
+			Media:   track.GetMedia(),
+			MediaID: track.GetMediaID(),
+			SSRCs:   append([]uint{}, track.GetSSRCS()...),
+		}
+		for _, group := range track.GetSourceGroupS() {
+			trackSnapshot.Groups = append(trackSnapshot.Groups, SourceGroupSnapshot{
+				Semantics: group.GetSemantics(),
+				SSRCs:     append([]uint{}, group.GetSSRCs()...),
+			})
+		}
+		for _, alternatives := range track.GetEncodings() {
+			encodings := []EncodingSnapshot{}
+			for _, encoding := range alternatives {
+				encodings = append(encodings, EncodingSnapshot{ID: encoding.GetID(), Paused: encoding.IsPaused()})
+			}
+			trackSnapshot.Encodings = append(trackSnapshot.Encodings, encodings)
+		}
+		snapshot.Tracks = append(snapshot.Tracks, trackSnapshot)
+	}
+	return snapshot
+}
+
+func (s *StreamSnapshot) streamInfo() *sdp.StreamInfo {
+
+	info := sdp.NewStreamInfo(s.ID)
+
+	for _, track := range s.Tracks {
+		trackInfo := sdp.NewTrackInfo(track.ID, track.Media)
+		trackInfo.SetMediaID(track.MediaID)
+		for _, ssrc := range track.SSRCs {
+			trackInfo.AddSSRC(ssrc)
+		}
+		for _, group := range track.Groups {
+			trackInfo.AddSourceGroup(sdp.NewSourceGroupInfo(group.Semantics, group.SSRCs))
+		}
+		for _, alternatives := range track.Encodings {
+			encodings := []*sdp.TrackEncodingInfo{}
+			for _, encoding := range alternatives {
+				encodings = append(encodings, sdp.NewTrackEncodingInfo(encoding.ID, encoding.Paused))
+			}
+			trackInfo.AddAlternativeEncodings(encodings)
+		}
+		info.AddTrack(trackInfo)
+	}
+	return info
+}
+
+func snapshotIncomingStream(stream *IncomingStream) StreamSnapshot {
+
+	snapshot := snapshotStream(stream.GetStreamInfo())
+	snapshot.Metadata = emptyAsNil(stream.GetAllMetadata())
+	for i := range snapshot.Tracks {
+		if track := stream.GetTrack(snapshot.Tracks[i].ID); track != nil {
+			snapshot.Tracks[i].Metadata = emptyAsNil(track.GetAllMetadata())
+		}
+	}
+	return snapshot
+}
+
+func snapshotOutgoingStream(stream *OutgoingStream) StreamSnapshot {
+
+	snapshot := snapshotStream(stream.GetStreamInfo())
+	snapshot.Metadata = emptyAsNil(stream.GetAllMetadata())
+	for i := range snapshot.Tracks {
+		if track := stream.GetTrack(snapshot.Tracks[i].ID); track != nil {
+			snapshot.Tracks[i].Metadata = emptyAsNil(track.GetAllMetadata())
+		}
+	}
+	return snapshot
+}
+
+func emptyAsNil(values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func restoreIncomingMetadata(stream *IncomingStream, snapshot *StreamSnapshot) {
+
+	for key, value := range snapshot.Metadata {
+		stream.SetMetadata(key, value)
+	}
+	for _, trackSnapshot := range snapshot.Tracks {
+		if track := stream.GetTrack(trackSnapshot.ID); track != nil {
+			for key, value := range trackSnapshot.Metadata {
+				track.SetMetadata(key, value)
+			}
+		}
+	}
+}
+
+func restoreOutgoingMetadata(stream *OutgoingStream, snapshot *StreamSnapshot) {
+
+	for key, value := range snapshot.Metadata {
+		stream.SetMetadata(key, value)
+	}
+	for _, trackSnapshot := range snapshot.Tracks {
+		if track := stream.GetTrack(trackSnapshot.ID); track != nil {
+			for key, value := range trackSnapshot.Metadata {
+				track.SetMetadata(key, value)
+			}
+		}
+	}
+}
+
+// Snapshot save the remote peer parameters and stream layouts of this Transport, see Endpoint.RestoreTransport
+func (t *Transport) Snapshot() *TransportSnapshot {
+
+	t.Lock()
+	snapshot := &TransportSnapshot{
+		RemoteUfrag:       t.remoteIce.GetUfrag(),
+		RemotePassword:    t.remoteIce.GetPassword(),
+		RemoteSetup:       t.remoteDtls.GetSetup().String(),
+		RemoteHash:        t.remoteDtls.GetHash(),
+		RemoteFingerprint: t.remoteDtls.GetFingerprint(),
+		ICEMode:           t.iceMode,
+		RemoteAudio:       snapshotMedia(t.remoteAudio),
+		RemoteVideo:       snapshotMedia(t.remoteVideo),
+		LocalAudio:        snapshotMedia(t.localAudio),
+		LocalVideo:        snapshotMedia(t.localVideo),
+	}
+
+	for _, candidate := range t.remoteCandidates {
+		snapshot.RemoteCandidates = append(snapshot.RemoteCandidates, CandidateSnapshot{
+			Foundation:  candidate.GetFoundation(),
+			ComponentID: candidate.GetComponentID(),
+			Transport:   candidate.GetTransport(),
+			Priority:    candidate.GetPriority(),
+			Address:     candidate.GetAddress(),
+			Port:        candidate.GetPort(),
+			Type:        candidate.GetType(),
+			RelAddr:     candidate.GetRelAddr(),
+			RelPort:     candidate.GetRelPort(),
+		})
+	}
+
+	incomingStreams := make([]*IncomingStream, 0, len(t.incomingStreams))
+	for _, stream := range t.incomingStreams {
+		incomingStreams = append(incomingStreams, stream)
+	}
+	outgoingStreams := make([]*OutgoingStream, 0, len(t.outgoingStreams))
+	for _, stream := range t.outgoingStreams {
+		outgoingStreams = append(outgoingStreams, stream)
+	}
+	t.Unlock()
+
+	for _, stream := range incomingStreams {
+		snapshot.IncomingStreams = append(snapshot.IncomingStreams, snapshotIncomingStream(stream))
+	}
+	for _, stream := range outgoingStreams {
+		snapshot.OutgoingStreams = append(snapshot.OutgoingStreams, snapshotOutgoingStream(stream))
+	}
+
+	return snapshot
+}
+
+// Marshal encode the snapshot as json
+func (s *TransportSnapshot) Marshal() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// UnmarshalTransportSnapshot decode a json snapshot
+func UnmarshalTransportSnapshot(data []byte) (*TransportSnapshot, error) {
+	snapshot := &TransportSnapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// RestoreTransport recreate a Transport and its streams from a snapshot, ie after a process restart
+// New local ICE credentials are generated and the DTLS fingerprint is the one of this process,
+// so the local description must be sent again to the remote peer, which will do an ICE restart and a new DTLS handshake
+func (e *Endpoint) RestoreTransport(snapshot *TransportSnapshot) (*Transport, error) {
+
+	remote := sdp.NewSDPInfo()
+	remote.SetICE(sdp.NewICEInfo(snapshot.RemoteUfrag, snapshot.RemotePassword))
+	remote.SetDTLS(sdp.NewDTLSInfo(sdp.SetupByValue(snapshot.RemoteSetup), snapshot.RemoteHash, snapshot.RemoteFingerprint))
+
+	for _, candidate := range snapshot.RemoteCandidates {
+		remote.AddCandidate(sdp.NewCandidateInfo(candidate.Foundation, candidate.ComponentID, candidate.Transport,
+			candidate.Priority, candidate.Address, candidate.Port, candidate.Type, candidate.RelAddr, candidate.RelPort))
+	}
+
+	transport := e.createTransport(remote, nil, snapshot.ICEMode, false)
+
+	if snapshot.RemoteAudio != nil || snapshot.RemoteVideo != nil {
+		transport.SetRemoteProperties(snapshot.RemoteAudio.mediaInfo(), snapshot.RemoteVideo.mediaInfo())
+	}
+
+	if snapshot.LocalAudio != nil || snapshot.LocalVideo != nil {
+		transport.SetLocalProperties(snapshot.LocalAudio.mediaInfo(), snapshot.LocalVideo.mediaInfo())
+	}
+
+	for i := range snapshot.IncomingStreams {
+		stream, err := transport.CreateIncomingStreamChecked(snapshot.IncomingStreams[i].streamInfo())
+		if err != nil {
+			transport.Stop()
+			return nil, err
+		}
+		restoreIncomingMetadata(stream, &snapshot.IncomingStreams[i])
+	}
+
+	for i := range snapshot.OutgoingStreams {
+		stream, err := transport.CreateOutgoingStreamChecked(snapshot.OutgoingStreams[i].streamInfo())
+		if err != nil {
+			transport.Stop()
+			return nil, err
+		}
+		restoreOutgoingMetadata(stream, &snapshot.OutgoingStreams[i])
+	}
+
+	return transport, nil
+}
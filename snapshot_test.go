@@ -0,0 +1,119 @@
+package mediaserver
+
+import (
+	"testing"
+
+	"github.com/notedit/sdp"
+)
+
+func Test_SnapshotMarshal(t *testing.T) {
+
+	snapshot := &TransportSnapshot{
+		RemoteUfrag:    "ez5G",
+		RemotePassword: "1F1qS++jzWLSQi0qQDZkX/QV",
+		RemoteSetup:    "actpass",
+		RemoteHash:     "sha-256",
+		IncomingStreams: []StreamSnapshot{{
+			ID:       "stream",
+			Metadata: map[string]string{"name": "alice"},
+			Tracks: []TrackSnapshot{{
+				ID:        "video",
+				Media:     "video",
+				MediaID:   "1",
+				SSRCs:     []uint{1, 2},
+				Groups:    []SourceGroupSnapshot{{Semantics: "FID", SSRCs: []uint{1, 2}}},
+				Encodings: [][]EncodingSnapshot{{{ID: "hi"}}, {{ID: "lo", Paused: true}}},
+				Metadata:  map[string]string{"label": "camera"},
+			}},
+		}},
+	}
+
+	data, err := snapshot.Marshal()
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	restored, err := UnmarshalTransportSnapshot(data)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	if restored.RemoteUfrag != snapshot.RemoteUfrag || len(restored.IncomingStreams) != 1 {
+		t.Fatal("unexpected snapshot", restored)
+	}
+
+	stream := restored.IncomingStreams[0]
+	if stream.Metadata["name"] != "alice" || len(stream.Tracks) != 1 || stream.Tracks[0].Metadata["label"] != "camera" {
+		t.Error("expected the metadata restored", stream)
+	}
+
+	info := stream.streamInfo()
+	track := info.GetTrack("video")
+	if info.GetID() != "stream" || track == nil {
+		t.Fatal("unexpected stream info", info)
+	}
+	if track.GetMediaID() != "1" || len(track.GetSSRCS()) != 2 || len(track.GetSourceGroupS()) != 1 {
+		t.Error("unexpected track info", track)
+	}
+	if encodings := track.GetEncodings(); len(encodings) != 2 || encodings[1][0].GetID() != "lo" || !encodings[1][0].IsPaused() {
+		t.Error("unexpected encodings", encodings)
+	}
+
+	again := snapshotStream(info)
+	if again.ID != stream.ID || len(again.Tracks) != 1 || len(again.Tracks[0].Encodings) != 2 {
+		t.Error("expected the stream info round trip", again)
+	}
+
+	if _, err := UnmarshalTransportSnapshot([]byte("not json")); err == nil {
+		t.Error("expected unmarshal error")
+	}
+}
+
+func Test_SnapshotMetadata(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	defer transport.Stop()
+
+	info := sdp.NewStreamInfo("stream")
+	info.AddTrack(newTestTrackInfo("video", 1))
+	stream := transport.CreateIncomingStream(info)
+	stream.SetMetadata("name", "alice")
+	stream.GetTrack("video").SetMetadata("label", "camera")
+
+	outgoing := transport.CreateOutgoingStreamWithID("out", false, true)
+	outgoing.SetMetadata("room", "lobby")
+
+	snapshot := transport.Snapshot()
+	if len(snapshot.IncomingStreams) != 1 || snapshot.IncomingStreams[0].Metadata["name"] != "alice" ||
+		snapshot.IncomingStreams[0].Tracks[0].Metadata["label"] != "camera" {
+		t.Fatal("expected the incoming metadata in the snapshot", snapshot.IncomingStreams)
+	}
+	if len(snapshot.OutgoingStreams) != 1 || snapshot.OutgoingStreams[0].Metadata["room"] != "lobby" {
+		t.Fatal("expected the outgoing metadata in the snapshot", snapshot.OutgoingStreams)
+	}
+
+	// the track ssrcs are read back from the native sources, which have none without the native library
+	if len(snapshot.IncomingStreams[0].Tracks[0].SSRCs) == 0 {
+		snapshot.IncomingStreams[0].Tracks[0].SSRCs = []uint{1}
+	}
+
+	restored, err := endpoint.RestoreTransport(snapshot)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	defer restored.Stop()
+
+	incoming := restored.GetIncomingStream("stream")
+	if incoming == nil {
+		t.Fatal("expected the incoming stream restored")
+	}
+	if name, _ := incoming.GetMetadata("name"); name != "alice" {
+		t.Error("expected the stream metadata restored", incoming.GetAllMetadata())
+	}
+	if label, _ := incoming.GetTrack("video").GetMetadata("label"); label != "camera" {
+		t.Error("expected the track metadata restored")
+	}
+	if room, _ := restored.GetOutgoingStream("out").GetMetadata("room"); room != "lobby" {
+		t.Error("expected the outgoing stream metadata restored")
+	}
+}
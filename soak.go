@@ -0,0 +1,203 @@
+package mediaserver
+
+import (
+	"errors"
+	"log"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type soakKind int
+
+const (
+	soakTransports soakKind = iota
+	soakIncomingSourceGroups
+	soakOutgoingSourceGroups
+	soakKinds
+)
+
+var soakKindNames = [soakKinds]string{"transports", "incoming source groups", "outgoing source groups"}
+
+// soakSentinel is referenced only by the Go object owning the native ones, so its finalizer runs when the owner is
+// collected even if the owner is part of a cycle, finalizers are not run for objects in cycles
+type soakSentinel struct {
+	kind       soakKind
+	count      int64
+	generation int32
+	released   int32
+}
+
+var soakState struct {
+	enabled int32
+	// generation of the running soak test, the objects tracked by a previous one are ignored
+	generation int32
+	owned      [soakKinds]int64
+	leaked     [soakKinds]int64
+}
+
+// soakTrack track count native objects of kind owned by a new Go object, nil if no soak test is running
+func soakTrack(kind soakKind, count int64) *soakSentinel {
+
+	if atomic.LoadInt32(&soakState.enabled) == 0 {
+		return nil
+	}
+
+	sentinel := &soakSentinel{kind: kind, count: count, generation: atomic.LoadInt32(&soakState.generation)}
+	atomic.AddInt64(&soakState.owned[kind], count)
+	runtime.SetFinalizer(sentinel, (*soakSentinel).collected)
+	return sentinel
+}
+
+// release the native objects were deleted by the owner
+func (s *soakSentinel) release() {
+	if s != nil && s.current() && atomic.CompareAndSwapInt32(&s.released, 0, 1) {
+		atomic.AddInt64(&soakState.owned[s.kind], -s.count)
+	}
+}
+
+// collected the owner was collected, its native objects leak if they were not released
+func (s *soakSentinel) collected() {
+	if s.current() && atomic.CompareAndSwapInt32(&s.released, 0, 1) {
+		atomic.AddInt64(&soakState.owned[s.kind], -s.count)
+		atomic.AddInt64(&soakState.leaked[s.kind], s.count)
+	}
+}
+
+func (s *soakSentinel) current() bool {
+	return s.generation == atomic.LoadInt32(&soakState.generation)
+}
+
+// SoakConfig diagnostic mode checking that the native objects are released with their Go objects
+type SoakConfig struct {
+	// Interval between the reports, 10s if zero
+	Interval time.Duration
+	// Logger called with the divergences found, log.Printf if nil
+	Logger func(format string, v ...interface{})
+}
+
+// SoakReport native objects counts of each kind: "transports", "incoming source groups" and "outgoing source groups"
+type SoakReport struct {
+	Native *NativeMemStats
+	// Owned native objects created since the start owned by a Go object that was not stopped nor collected
+	Owned map[string]int64
+	// Leaked native objects whose Go object was collected without being stopped, they are never released
+	Leaked map[string]int64
+	// Drift native objects created since the start not owned by any Go object, leaked ones included
+	Drift map[string]int64
+}
+
+// SoakTest a running soak test, see StartSoakTest
+type SoakTest struct {
+	config   SoakConfig
+	baseline [soakKinds]int64
+	last     *SoakReport
+	stop     chan struct{}
+	sync.Mutex
+}
+
+func nativeCounts(stats *NativeMemStats) [soakKinds]int64 {
+	return [soakKinds]int64{stats.Transports, stats.IncomingSourceGroups, stats.OutgoingSourceGroups}
+}
+
+// StartSoakTest periodically compare the native objects counts with the Go objects owning them and log the divergence,
+// to catch native objects leaked by Go objects dropped without calling Stop. Only the objects created after the start are tracked.
+// It forces a garbage collection on each report, it is meant for soak tests and not for production
+func StartSoakTest(config SoakConfig) (*SoakTest, error) {
+
+	if config.Interval <= 0 {
+		config.Interval = 10 * time.Second
+	}
+	if config.Logger == nil {
+		config.Logger = log.Printf
+	}
+
+	if !atomic.CompareAndSwapInt32(&soakState.enabled, 0, 1) {
+		return nil, errors.New("soak test already running")
+	}
+
+	soak := &SoakTest{
+		config:   config,
+		baseline: nativeCounts(MemStats()),
+		stop:     make(chan struct{}),
+	}
+	go soak.run(soak.stop)
+
+	return soak, nil
+}
+
+func (s *SoakTest) run(stop chan struct{}) {
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		report := s.Report()
+
+		s.Lock()
+		last := s.last
+		s.last = report
+		s.Unlock()
+
+		for kind := soakKind(0); kind < soakKinds; kind++ {
+			name := soakKindNames[kind]
+			if last != nil && report.Leaked[name] == last.Leaked[name] && report.Drift[name] == last.Drift[name] {
+				continue
+			}
+			if report.Leaked[name] != 0 || report.Drift[name] != 0 {
+				s.config.Logger("soak: %s native %d owned %d leaked %d drift %d", name, nativeCounts(report.Native)[kind], report.Owned[name], report.Leaked[name], report.Drift[name])
+			}
+		}
+	}
+}
+
+// Report collect garbage and compare the counts now, objects collected are only accounted once their finalizers ran,
+// usually on the next report
+func (s *SoakTest) Report() *SoakReport {
+
+	runtime.GC()
+
+	report := &SoakReport{
+		Native: MemStats(),
+		Owned:  map[string]int64{},
+		Leaked: map[string]int64{},
+		Drift:  map[string]int64{},
+	}
+
+	native := nativeCounts(report.Native)
+	for kind := soakKind(0); kind < soakKinds; kind++ {
+		name := soakKindNames[kind]
+		owned := atomic.LoadInt64(&soakState.owned[kind])
+		report.Owned[name] = owned
+		report.Leaked[name] = atomic.LoadInt64(&soakState.leaked[kind])
+		report.Drift[name] = native[kind] - s.baseline[kind] - owned
+	}
+	return report
+}
+
+// Stop stop the soak test, the objects created while it was running are not tracked anymore
+func (s *SoakTest) Stop() {
+
+	s.Lock()
+	if s.stop == nil {
+		s.Unlock()
+		return
+	}
+	close(s.stop)
+	s.stop = nil
+	s.Unlock()
+
+	atomic.AddInt32(&soakState.generation, 1)
+	for kind := soakKind(0); kind < soakKinds; kind++ {
+		atomic.StoreInt64(&soakState.owned[kind], 0)
+		atomic.StoreInt64(&soakState.leaked[kind], 0)
+	}
+	atomic.StoreInt32(&soakState.enabled, 0)
+}
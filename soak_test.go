@@ -0,0 +1,41 @@
+package mediaserver
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func Test_SoakLeak(t *testing.T) {
+
+	soak, err := StartSoakTest(SoakConfig{Interval: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer soak.Stop()
+
+	if _, err := StartSoakTest(SoakConfig{}); err == nil {
+		t.Fatal("expected a single soak test")
+	}
+
+	released := soakTrack(soakTransports, 1)
+	released.release()
+
+	// dropped without release, like a transport never stopped
+	soakTrack(soakOutgoingSourceGroups, 2)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for soak.Report().Leaked["outgoing source groups"] != 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("leak not detected")
+		}
+		runtime.Gosched()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	report := soak.Report()
+	if report.Leaked["transports"] != 0 || report.Owned["transports"] != 0 || report.Owned["outgoing source groups"] != 0 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	runtime.KeepAlive(released)
+}
@@ -0,0 +1,128 @@
+package mediaserver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SRTP protection profiles negotiated with DTLS-SRTP, in OpenSSL naming
+const (
+	SRTPAEADAES256GCM   = "SRTP_AEAD_AES_256_GCM"
+	SRTPAEADAES128GCM   = "SRTP_AEAD_AES_128_GCM"
+	SRTPAES128CMSHA1_80 = "SRTP_AES128_CM_SHA1_80"
+	SRTPAES128CMSHA1_32 = "SRTP_AES128_CM_SHA1_32"
+)
+
+// all the profiles in the native preference order, used when the restriction is removed
+var defaultSRTPProfiles = []string{SRTPAEADAES256GCM, SRTPAEADAES128GCM, SRTPAES128CMSHA1_80, SRTPAES128CMSHA1_32}
+
+var srtpProfiles = map[string]bool{
+	SRTPAEADAES256GCM:   true,
+	SRTPAEADAES128GCM:   true,
+	SRTPAES128CMSHA1_80: true,
+	SRTPAES128CMSHA1_32: true,
+}
+
+// SRTPNegotiationError is reported when the DTLS handshake fails while the SRTP profiles are restricted,
+// most likely because the remote peer does not support any of them
+type SRTPNegotiationError struct {
+	// Profiles allowed profiles, in preference order
+	Profiles []string
+}
+
+func (e *SRTPNegotiationError) Error() string {
+	return fmt.Sprintf("dtls-srtp negotiation failed, allowed profiles %v", e.Profiles)
+}
+
+// validateSRTPProfiles check the profiles are known and not duplicated
+func validateSRTPProfiles(profiles []string) error {
+
+	seen := map[string]bool{}
+	for _, profile := range profiles {
+		if !srtpProfiles[profile] {
+			return fmt.Errorf("unknown srtp profile %q", profile)
+		}
+		if seen[profile] {
+			return fmt.Errorf("duplicated srtp profile %q", profile)
+		}
+		seen[profile] = true
+	}
+	return nil
+}
+
+// SetSRTPProtectionProfiles restrict the SRTP profiles offered in the DTLS handshake of the new transports, in preference order
+// ie []string{SRTPAEADAES256GCM} to require AES-256-GCM. An empty list restores the native defaults.
+// Transports failing the negotiation report a *SRTPNegotiationError in Transport.GetDTLSError
+func (e *Endpoint) SetSRTPProtectionProfiles(profiles []string) error {
+
+	if err := validateSRTPProfiles(profiles); err != nil {
+		return err
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	e.srtpProfiles = append([]string{}, profiles...)
+	return nil
+}
+
+// GetSRTPProtectionProfiles get the SRTP profiles allowed for new transports, nil if not restricted
+func (e *Endpoint) GetSRTPProtectionProfiles() []string {
+	e.Lock()
+	defer e.Unlock()
+	if len(e.srtpProfiles) == 0 {
+		return nil
+	}
+	return append([]string{}, e.srtpProfiles...)
+}
+
+// setSRTPProtectionProfiles apply the SRTP profiles to the native transport, must be called before the DTLS handshake
+func (t *Transport) setSRTPProtectionProfiles(profiles []string) {
+
+	if len(profiles) == 0 {
+		return
+	}
+
+	t.Lock()
+	t.srtpProfiles = profiles
+	t.Unlock()
+
+	t.transport.SetSRTPProtectionProfiles(strings.Join(profiles, ":"))
+}
+
+// SetSRTPProtectionProfiles restrict the SRTP profiles of this transport, overriding the endpoint ones, an empty list allows all of them.
+// It must be called right after the transport is created, before the DTLS handshake starts, see WithSRTPProtectionProfiles
+func (t *Transport) SetSRTPProtectionProfiles(profiles []string) error {
+
+	if err := validateSRTPProfiles(profiles); err != nil {
+		return err
+	}
+
+	if len(profiles) == 0 {
+		t.Lock()
+		t.srtpProfiles = nil
+		t.Unlock()
+		t.transport.SetSRTPProtectionProfiles(strings.Join(defaultSRTPProfiles, ":"))
+		return nil
+	}
+
+	t.setSRTPProtectionProfiles(append([]string{}, profiles...))
+	return nil
+}
+
+// GetSRTPProtectionProfile get the SRTP profile negotiated, the native transport does not report it
+// so it is only known once DTLS is connected with a single allowed profile, empty otherwise
+func (t *Transport) GetSRTPProtectionProfile() string {
+	t.Lock()
+	defer t.Unlock()
+	if t.dtlsState == "connected" && len(t.srtpProfiles) == 1 {
+		return t.srtpProfiles[0]
+	}
+	return ""
+}
+
+// GetDTLSError get the reason of the DTLS failure, nil if DTLS has not failed
+func (t *Transport) GetDTLSError() error {
+	t.Lock()
+	defer t.Unlock()
+	return t.dtlsError
+}
@@ -0,0 +1,108 @@
+package mediaserver
+
+import (
+	"time"
+)
+
+// IncomingStatsDelta counters increase of an incoming source between two stats snapshots, and the rates over the elapsed time
+type IncomingStatsDelta struct {
+	LostPackets    uint
+	DropPackets    uint
+	NumPackets     uint
+	NumRTCPPackets uint
+	TotalBytes     uint
+	TotalRTCPBytes uint
+	TotalPLIs      uint
+	TotalNACKs     uint
+	// Bitrate in bps and PacketRate in packets per second, zero if the elapsed time is unknown
+	Bitrate    uint
+	PacketRate float64
+	// LossRate fraction of the expected packets that were lost
+	LossRate float64
+}
+
+// IncomingStatsDiff difference between two stats snapshots of the same encoding
+type IncomingStatsDiff struct {
+	Elapsed time.Duration
+	Media   *IncomingStatsDelta
+	Rtx     *IncomingStatsDelta
+	Fec     *IncomingStatsDelta
+	// Total bitrate in bps of media, rtx and fec
+	Total uint
+}
+
+// counterDelta increase of a native 32 bits counter, wrapping around. A counter going backwards, ie after a source reset, counts as no increase
+func counterDelta(prev, cur uint) uint {
+	delta := uint32(cur) - uint32(prev)
+	if delta >= 1<<31 {
+		return 0
+	}
+	return uint(delta)
+}
+
+func incomingStatsDelta(prev, cur *IncomingStats, elapsed time.Duration) *IncomingStatsDelta {
+
+	if prev == nil {
+		prev = &IncomingStats{}
+	}
+	if cur == nil {
+		cur = &IncomingStats{}
+	}
+
+	delta := &IncomingStatsDelta{
+		LostPackets:    counterDelta(prev.LostPackets, cur.LostPackets),
+		DropPackets:    counterDelta(prev.DropPackets, cur.DropPackets),
+		NumPackets:     counterDelta(prev.NumPackets, cur.NumPackets),
+		NumRTCPPackets: counterDelta(prev.NumRTCPPackets, cur.NumRTCPPackets),
+		TotalBytes:     counterDelta(prev.TotalBytes, cur.TotalBytes),
+		TotalRTCPBytes: counterDelta(prev.TotalRTCPBytes, cur.TotalRTCPBytes),
+		TotalPLIs:      counterDelta(prev.TotalPLIs, cur.TotalPLIs),
+		TotalNACKs:     counterDelta(prev.TotalNACKs, cur.TotalNACKs),
+	}
+
+	if expected := delta.NumPackets + delta.LostPackets; expected > 0 {
+		delta.LossRate = float64(delta.LostPackets) / float64(expected)
+	}
+
+	if elapsed > 0 {
+		delta.Bitrate = uint(float64(delta.TotalBytes) * 8 / elapsed.Seconds())
+		delta.PacketRate = float64(delta.NumPackets) / elapsed.Seconds()
+	}
+	return delta
+}
+
+// StatsDiff get the counters increase and rates between two stats snapshots of the same encoding, as returned by GetStats.
+// The elapsed time is the time between both snapshots were taken
+func StatsDiff(prev, cur *IncomingAllStats) *IncomingStatsDiff {
+
+	elapsed := time.Duration(0)
+	if prev.timestamp > 0 && cur.timestamp > prev.timestamp {
+		elapsed = time.Duration(cur.timestamp - prev.timestamp)
+	}
+	return StatsDiffWithElapsed(prev, cur, elapsed)
+}
+
+// StatsDiffWithElapsed like StatsDiff for snapshots that do not keep when they were taken, ie decoded from json
+func StatsDiffWithElapsed(prev, cur *IncomingAllStats, elapsed time.Duration) *IncomingStatsDiff {
+
+	diff := &IncomingStatsDiff{
+		Elapsed: elapsed,
+		Media:   incomingStatsDelta(prev.Media, cur.Media, elapsed),
+		Rtx:     incomingStatsDelta(prev.Rtx, cur.Rtx, elapsed),
+		Fec:     incomingStatsDelta(prev.Fec, cur.Fec, elapsed),
+	}
+	diff.Total = diff.Media.Bitrate + diff.Rtx.Bitrate + diff.Fec.Bitrate
+	return diff
+}
+
+// StatsDiffAll StatsDiff of each encoding present in both snapshots of a track
+func StatsDiffAll(prev, cur map[string]*IncomingAllStats) map[string]*IncomingStatsDiff {
+
+	diffs := map[string]*IncomingStatsDiff{}
+	for id, stats := range cur {
+		if previous, ok := prev[id]; ok {
+			diffs[id] = StatsDiff(previous, stats)
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,33 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_StatsDiff(t *testing.T) {
+
+	prev := &IncomingAllStats{
+		Media:     &IncomingStats{NumPackets: 1<<32 - 10, TotalBytes: 1<<32 - 1000, LostPackets: 5},
+		timestamp: int64(time.Second),
+	}
+	cur := &IncomingAllStats{
+		Media:     &IncomingStats{NumPackets: 90, TotalBytes: 124000, LostPackets: 3},
+		timestamp: int64(3 * time.Second),
+	}
+
+	diff := StatsDiff(prev, cur)
+
+	if diff.Elapsed != 2*time.Second {
+		t.Error("unexpected elapsed", diff.Elapsed)
+	}
+	if diff.Media.NumPackets != 100 || diff.Media.TotalBytes != 125000 {
+		t.Error("wraparound not handled", diff.Media.NumPackets, diff.Media.TotalBytes)
+	}
+	if diff.Media.LostPackets != 0 {
+		t.Error("counter going backwards not ignored", diff.Media.LostPackets)
+	}
+	if diff.Media.Bitrate != 500000 || diff.Media.PacketRate != 50 || diff.Total != 500000 {
+		t.Error("unexpected rates", diff.Media.Bitrate, diff.Media.PacketRate, diff.Total)
+	}
+}
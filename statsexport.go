@@ -0,0 +1,378 @@
+package mediaserver
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StatsPoint one measurement collected by the StatsCollector
+type StatsPoint struct {
+	Name   string
+	Tags   map[string]string
+	Fields map[string]float64
+	Time   time.Time
+}
+
+// StatsSink receive the points collected by the StatsCollector on each flush
+type StatsSink interface {
+	Write(points []*StatsPoint) error
+}
+
+// StatsErrorListener called when a sink fails to write the points
+type StatsErrorListener func(sink StatsSink, err error)
+
+// StatsCollector periodically collect the stats of the registered transports and flush them to the sinks
+type StatsCollector struct {
+	transports map[string]*Transport
+	sinks      []StatsSink
+	onError    []StatsErrorListener
+	ticker     *time.Ticker
+	stop       chan struct{}
+	sync.Mutex
+}
+
+// NewStatsCollector create and start a collector flushing every interval
+func NewStatsCollector(interval time.Duration) *StatsCollector {
+
+	collector := &StatsCollector{
+		transports: make(map[string]*Transport),
+		ticker:     time.NewTicker(interval),
+		stop:       make(chan struct{}),
+	}
+
+	go func() {
+		for {
+			select {
+			case <-collector.ticker.C:
+				collector.Flush()
+			case <-collector.stop:
+				return
+			}
+		}
+	}()
+
+	return collector
+}
+
+// AddTransport collect the stats of the transport, name is used as the "transport" tag
+func (c *StatsCollector) AddTransport(name string, transport *Transport) {
+	c.Lock()
+	c.transports[name] = transport
+	c.Unlock()
+}
+
+// RemoveTransport stop collecting the stats of the transport
+func (c *StatsCollector) RemoveTransport(name string) {
+	c.Lock()
+	delete(c.transports, name)
+	c.Unlock()
+}
+
+// AddSink add a sink the points are flushed to
+func (c *StatsCollector) AddSink(sink StatsSink) {
+	c.Lock()
+	c.sinks = append(c.sinks, sink)
+	c.Unlock()
+}
+
+// OnError register a listener called when a sink fails
+func (c *StatsCollector) OnError(listener StatsErrorListener) {
+	c.Lock()
+	c.onError = append(c.onError, listener)
+	c.Unlock()
+}
+
+// Collect get the current stats of all the transports and the native memory
+func (c *StatsCollector) Collect() []*StatsPoint {
+
+	c.Lock()
+	transports := make(map[string]*Transport, len(c.transports))
+	for name, transport := range c.transports {
+		transports[name] = transport
+	}
+	c.Unlock()
+
+	now := time.Now()
+
+	mem := MemStats()
+	points := []*StatsPoint{{
+		Name: "native",
+		Tags: map[string]string{},
+		Fields: map[string]float64{
+			"transports":             float64(mem.Transports),
+			"incoming_source_groups": float64(mem.IncomingSourceGroups),
+			"outgoing_source_groups": float64(mem.OutgoingSourceGroups),
+			"recorders":              float64(mem.Recorders),
+		},
+		Time: now,
+	}}
+
+	for name, transport := range transports {
+
+		ice := transport.GetICEStats()
+		feedback := transport.GetFeedbackStats()
+
+		points = append(points, &StatsPoint{
+			Name: "transport",
+			Tags: map[string]string{"transport": name},
+			Fields: map[string]float64{
+				"ice_requests_sent":      float64(ice.RequestsSent),
+				"ice_requests_received":  float64(ice.RequestsReceived),
+				"ice_responses_sent":     float64(ice.ResponsesSent),
+				"ice_responses_received": float64(ice.ResponsesReceived),
+				"plis_sent":              float64(feedback.PLIsSent),
+				"nacks_sent":             float64(feedback.NACKsSent),
+				"plis_received":          float64(feedback.PLIsReceived),
+				"rembs_received":         float64(feedback.REMBsReceived),
+				"remb":                   float64(feedback.Remb),
+			},
+			Time: now,
+		})
+
+		for _, stream := range transport.GetIncomingStreams() {
+			for _, track := range stream.GetTracks() {
+				for encoding, stats := range track.GetStats() {
+					points = append(points, &StatsPoint{
+						Name: "incoming_track",
+						Tags: map[string]string{
+							"transport": name,
+							"stream":    stream.GetID(),
+							"track":     track.GetID(),
+							"media":     track.GetMedia(),
+							"encoding":  encoding,
+						},
+						Fields: map[string]float64{
+							"bitrate":      float64(stats.Bitrate),
+							"total":        float64(stats.Total),
+							"rtt":          float64(stats.Rtt),
+							"packets":      float64(stats.Media.NumPackets),
+							"bytes":        float64(stats.Media.TotalBytes),
+							"lost_packets": float64(stats.Media.LostPackets),
+							"drop_packets": float64(stats.Media.DropPackets),
+							"plis":         float64(stats.Media.TotalPLIs),
+							"nacks":        float64(stats.Media.TotalNACKs),
+						},
+						Time: now,
+					})
+				}
+			}
+		}
+
+		for _, stream := range transport.GetOutgoingStreams() {
+			for _, track := range stream.GetTracks() {
+				stats := track.GetStats()
+				points = append(points, &StatsPoint{
+					Name: "outgoing_track",
+					Tags: map[string]string{
+						"transport": name,
+						"stream":    stream.GetID(),
+						"track":     track.GetID(),
+						"media":     track.GetMedia(),
+					},
+					Fields: map[string]float64{
+						"bitrate":        float64(stats.Media.Bitrate + stats.Rtx.Bitrate + stats.Fec.Bitrate),
+						"packets":        float64(stats.Media.NumPackets),
+						"bytes":          float64(stats.Media.TotalBytes),
+						"rtx_packets":    float64(stats.Rtx.NumPackets),
+						"plis_received":  float64(stats.Feedback.PLIsReceived),
+						"rembs_received": float64(stats.Feedback.REMBsReceived),
+					},
+					Time: now,
+				})
+			}
+		}
+	}
+
+	return points
+}
+
+// Flush collect the stats and write them to all the sinks
+func (c *StatsCollector) Flush() {
+
+	points := c.Collect()
+
+	c.Lock()
+	sinks := c.sinks
+	listeners := c.onError
+	c.Unlock()
+
+	for _, sink := range sinks {
+		if err := sink.Write(points); err != nil {
+			for _, listener := range listeners {
+				listener(sink, err)
+			}
+		}
+	}
+}
+
+// Stop stop the periodic flush
+func (c *StatsCollector) Stop() {
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.ticker == nil {
+		return
+	}
+
+	c.ticker.Stop()
+	c.ticker = nil
+	close(c.stop)
+}
+
+// maxStatsPacket lines are written in chunks below this size, so sinks can write to an udp socket
+const maxStatsPacket = 1400
+
+// writeLines write the lines to w in chunks of at most maxStatsPacket bytes, unless a single line is bigger
+func writeLines(w io.Writer, lines []string) error {
+
+	var buffer bytes.Buffer
+
+	for _, line := range lines {
+		if buffer.Len() > 0 && buffer.Len()+len(line) > maxStatsPacket {
+			if _, err := w.Write(buffer.Bytes()); err != nil {
+				return err
+			}
+			buffer.Reset()
+		}
+		buffer.WriteString(line)
+	}
+
+	if buffer.Len() > 0 {
+		if _, err := w.Write(buffer.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedFields(fields map[string]float64) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// InfluxSink write the points using the InfluxDB line protocol
+type InfluxSink struct {
+	w io.Writer
+}
+
+// NewInfluxSink create an influx sink writing to w, ie a file or a net.Conn to an udp or http listener
+func NewInfluxSink(w io.Writer) *InfluxSink {
+	return &InfluxSink{w: w}
+}
+
+var influxNameEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+var influxTagEscaper = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+
+// FormatInfluxLine format a point as an influx line with nanosecond precision
+func FormatInfluxLine(point *StatsPoint) string {
+
+	var line strings.Builder
+
+	line.WriteString(influxNameEscaper.Replace(point.Name))
+
+	for _, key := range sortedKeys(point.Tags) {
+		if point.Tags[key] == "" {
+			continue
+		}
+		line.WriteString(",")
+		line.WriteString(influxTagEscaper.Replace(key))
+		line.WriteString("=")
+		line.WriteString(influxTagEscaper.Replace(point.Tags[key]))
+	}
+
+	for i, key := range sortedFields(point.Fields) {
+		if i == 0 {
+			line.WriteString(" ")
+		} else {
+			line.WriteString(",")
+		}
+		line.WriteString(influxTagEscaper.Replace(key))
+		line.WriteString("=")
+		line.WriteString(strconv.FormatFloat(point.Fields[key], 'f', -1, 64))
+	}
+
+	fmt.Fprintf(&line, " %d\n", point.Time.UnixNano())
+
+	return line.String()
+}
+
+// Write implements StatsSink
+func (s *InfluxSink) Write(points []*StatsPoint) error {
+
+	lines := make([]string, 0, len(points))
+	for _, point := range points {
+		if len(point.Fields) > 0 {
+			lines = append(lines, FormatInfluxLine(point))
+		}
+	}
+	return writeLines(s.w, lines)
+}
+
+// GraphiteSink write the points using the Graphite plaintext protocol
+// The metric path is prefix.name.<tag values sorted by tag key>.field
+type GraphiteSink struct {
+	w      io.Writer
+	prefix string
+}
+
+// NewGraphiteSink create a graphite sink writing to w, ie a net.Conn to carbon, prefix may be empty
+func NewGraphiteSink(w io.Writer, prefix string) *GraphiteSink {
+	return &GraphiteSink{w: w, prefix: prefix}
+}
+
+var graphiteEscaper = strings.NewReplacer(".", "_", " ", "_", "/", "_")
+
+// FormatGraphiteLines format a point as graphite lines, one per field, with second precision
+func FormatGraphiteLines(prefix string, point *StatsPoint) []string {
+
+	path := []string{}
+	if prefix != "" {
+		path = append(path, prefix)
+	}
+	path = append(path, graphiteEscaper.Replace(point.Name))
+
+	for _, key := range sortedKeys(point.Tags) {
+		if point.Tags[key] != "" {
+			path = append(path, graphiteEscaper.Replace(point.Tags[key]))
+		}
+	}
+
+	base := strings.Join(path, ".")
+	lines := []string{}
+
+	for _, key := range sortedFields(point.Fields) {
+		value := strconv.FormatFloat(point.Fields[key], 'f', -1, 64)
+		lines = append(lines, fmt.Sprintf("%s.%s %s %d\n", base, graphiteEscaper.Replace(key), value, point.Time.Unix()))
+	}
+	return lines
+}
+
+// Write implements StatsSink
+func (s *GraphiteSink) Write(points []*StatsPoint) error {
+
+	lines := []string{}
+	for _, point := range points {
+		lines = append(lines, FormatGraphiteLines(s.prefix, point)...)
+	}
+	return writeLines(s.w, lines)
+}
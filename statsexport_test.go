@@ -0,0 +1,26 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_StatsSinkFormat(t *testing.T) {
+
+	point := &StatsPoint{
+		Name:   "incoming_track",
+		Tags:   map[string]string{"transport": "peer 1", "track": "video", "encoding": ""},
+		Fields: map[string]float64{"bitrate": 512000, "rtt": 12.5},
+		Time:   time.Unix(1600000000, 0),
+	}
+
+	influx := FormatInfluxLine(point)
+	if influx != "incoming_track,track=video,transport=peer\\ 1 bitrate=512000,rtt=12.5 1600000000000000000\n" {
+		t.Error("unexpected influx line", influx)
+	}
+
+	graphite := FormatGraphiteLines("media", point)
+	if len(graphite) != 2 || graphite[0] != "media.incoming_track.video.peer_1.bitrate 512000 1600000000\n" {
+		t.Error("unexpected graphite lines", graphite)
+	}
+}
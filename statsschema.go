@@ -0,0 +1,145 @@
+package mediaserver
+
+import (
+	"time"
+)
+
+// StatsSchemaVersion version of the normalized stats schema, bumped on any incompatible change of TrackStats
+// The legacy IncomingAllStats and OutgoingStatss returned by GetStats are kept unchanged
+const StatsSchemaVersion = 2
+
+// Bps a bitrate in bits per second
+type Bps uint64
+
+// Ms a duration in milliseconds
+type Ms float64
+
+// Duration convert to a time.Duration
+func (m Ms) Duration() time.Duration {
+	return time.Duration(float64(m) * float64(time.Millisecond))
+}
+
+// SourceStats normalized counters of a media, rtx or fec source, same fields for both directions.
+// Receive only counters are zero for outgoing sources
+type SourceStats struct {
+	Packets        uint64 `json:"packets"`
+	Bytes          uint64 `json:"bytes"`
+	RTCPPackets    uint64 `json:"rtcpPackets"`
+	RTCPBytes      uint64 `json:"rtcpBytes"`
+	LostPackets    uint64 `json:"lostPackets"`
+	DroppedPackets uint64 `json:"droppedPackets"`
+	PLIs           uint64 `json:"plis"`
+	NACKs          uint64 `json:"nacks"`
+	Bitrate        Bps    `json:"bitrateBps"`
+}
+
+// WaitTimeStats time packets waited in the jitter buffer
+type WaitTimeStats struct {
+	Min Ms `json:"minMs"`
+	Max Ms `json:"maxMs"`
+	Avg Ms `json:"avgMs"`
+}
+
+// TrackStats normalized stats of an incoming encoding or an outgoing track
+type TrackStats struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Direction     string `json:"direction"`
+	Encoding      string `json:"encoding,omitempty"`
+	// Bitrate total of media, rtx and fec
+	Bitrate       Bps               `json:"bitrateBps"`
+	Rtt           Ms                `json:"rttMs"`
+	WaitTime      *WaitTimeStats    `json:"waitTime,omitempty"`
+	Jitter        *HistogramStats   `json:"jitterMs,omitempty"`
+	FrameInterval *HistogramStats   `json:"frameIntervalMs,omitempty"`
+	Media         *SourceStats      `json:"media"`
+	Rtx           *SourceStats      `json:"rtx"`
+	Fec           *SourceStats      `json:"fec"`
+	Feedback      *FeedbackStats    `json:"feedback,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	Timestamp     time.Time         `json:"timestamp"`
+}
+
+func normalizeIncomingStats(stats *IncomingStats) *SourceStats {
+	if stats == nil {
+		return &SourceStats{}
+	}
+	return &SourceStats{
+		Packets:        uint64(stats.NumPackets),
+		Bytes:          uint64(stats.TotalBytes),
+		RTCPPackets:    uint64(stats.NumRTCPPackets),
+		RTCPBytes:      uint64(stats.TotalRTCPBytes),
+		LostPackets:    uint64(stats.LostPackets),
+		DroppedPackets: uint64(stats.DropPackets),
+		PLIs:           uint64(stats.TotalPLIs),
+		NACKs:          uint64(stats.TotalNACKs),
+		Bitrate:        Bps(stats.Bitrate),
+	}
+}
+
+func normalizeOutgoingStats(stats *OutgoingStats) *SourceStats {
+	if stats == nil {
+		return &SourceStats{}
+	}
+	return &SourceStats{
+		Packets:     uint64(stats.NumPackets),
+		Bytes:       uint64(stats.TotalBytes),
+		RTCPPackets: uint64(stats.NumRTCPPackets),
+		RTCPBytes:   uint64(stats.TotalRTCPBytes),
+		Bitrate:     Bps(stats.Bitrate),
+	}
+}
+
+// Normalize convert the legacy incoming stats of an encoding to the normalized schema
+func (s *IncomingAllStats) Normalize(encoding string) *TrackStats {
+	return &TrackStats{
+		SchemaVersion: StatsSchemaVersion,
+		Direction:     "incoming",
+		Encoding:      encoding,
+		Bitrate:       Bps(s.Total),
+		Rtt:           Ms(s.Rtt),
+		WaitTime: &WaitTimeStats{
+			Min: Ms(s.MinWaitTime),
+			Max: Ms(s.MaxWaitTime),
+			Avg: Ms(s.AvgWaitTime),
+		},
+		Jitter:        s.Jitter,
+		FrameInterval: s.FrameInterval,
+		Media:         normalizeIncomingStats(s.Media),
+		Rtx:           normalizeIncomingStats(s.Rtx),
+		Fec:           normalizeIncomingStats(s.Fec),
+		Metadata:      s.Metadata,
+		Timestamp:     time.Unix(0, s.timestamp),
+	}
+}
+
+// Normalize convert the legacy outgoing stats to the normalized schema
+func (s *OutgoingStatss) Normalize() *TrackStats {
+
+	stats := &TrackStats{
+		SchemaVersion: StatsSchemaVersion,
+		Direction:     "outgoing",
+		Media:         normalizeOutgoingStats(s.Media),
+		Rtx:           normalizeOutgoingStats(s.Rtx),
+		Fec:           normalizeOutgoingStats(s.Fec),
+		Feedback:      s.Feedback,
+		Metadata:      s.Metadata,
+		Timestamp:     time.Unix(0, s.timestamp),
+	}
+	stats.Bitrate = stats.Media.Bitrate + stats.Rtx.Bitrate + stats.Fec.Bitrate
+	return stats
+}
+
+// GetNormalizedStats get the stats of each encoding in the normalized schema
+func (i *IncomingStreamTrack) GetNormalizedStats() map[string]*TrackStats {
+
+	normalized := map[string]*TrackStats{}
+	for encoding, stats := range i.GetStats() {
+		normalized[encoding] = stats.Normalize(encoding)
+	}
+	return normalized
+}
+
+// GetNormalizedStats get the stats in the normalized schema
+func (o *OutgoingStreamTrack) GetNormalizedStats() *TrackStats {
+	return o.GetStats().Normalize()
+}
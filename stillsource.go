@@ -0,0 +1,174 @@
+package mediaserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	// decoders of the images accepted by SetImage
+	_ "image/jpeg"
+)
+
+// StillSourceConfig configuration of a StillSource
+type StillSourceConfig struct {
+	// Path of the ffmpeg binary, "ffmpeg" if empty
+	Path string
+	// Codec of the video, "vp8" or "h264", vp8 if empty
+	Codec string
+	// Width and Height of the video, the image is scaled and letterboxed to fit, 1280x720 if zero
+	Width  int
+	Height int
+	// FPS frames per second, there is one keyframe per second, 2 if zero
+	FPS int
+	// Bitrate in kbps, 300 if zero
+	Bitrate int
+}
+
+// StillSource encode a still image into a low frame rate video track, ie for slides or "be right back" cards.
+// The image can be replaced while the source is running
+type StillSource struct {
+	config  StillSourceConfig
+	process *FFmpegProcess
+	dir     string
+	image   string
+	started bool
+	sync.Mutex
+}
+
+// NewStillSource create a still image source, set the image before starting it
+func NewStillSource(config StillSourceConfig) (*StillSource, error) {
+
+	if config.FPS == 0 {
+		config.FPS = 2
+	}
+
+	dir, err := ioutil.TempDir("", "media-server-go-still")
+	if err != nil {
+		return nil, err
+	}
+
+	source := &StillSource{
+		config: config,
+		dir:    dir,
+		image:  filepath.Join(dir, "image.png"),
+	}
+
+	encoding := &videoSourceEncoding{Path: config.Path, Codec: config.Codec, Width: config.Width, Height: config.Height, FPS: config.FPS, Bitrate: config.Bitrate}
+
+	// image2 opens the file for every frame, so the image can be replaced at any time
+	input := []string{"-re", "-f", "image2", "-loop", "1", "-framerate", strconv.Itoa(config.FPS), "-i", source.image}
+
+	encoding.setDefaults()
+	filter := fmt.Sprintf("scale=%[1]d:%[2]d:force_original_aspect_ratio=decrease,pad=%[1]d:%[2]d:(ow-iw)/2:(oh-ih)/2", encoding.Width, encoding.Height)
+
+	process, err := encoding.newProcess(input, filter)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	source.process = process
+
+	return source, nil
+}
+
+// SetImage set the png or jpeg image to show
+func (s *StillSource) SetImage(data []byte) error {
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	// Always write a png so ffmpeg does not depend on the format of the image it started with
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.process == nil {
+		return errors.New("still source stopped")
+	}
+
+	// Write and rename so ffmpeg never reads a partial image
+	tmp := s.image + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.image)
+}
+
+// SetImageFile set the png or jpeg image to show from a file
+func (s *StillSource) SetImageFile(filename string) error {
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return s.SetImage(data)
+}
+
+// Start launch the encoding, an image must have been set
+func (s *StillSource) Start() error {
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.process == nil {
+		return errors.New("still source stopped")
+	}
+
+	if s.started {
+		return errors.New("still source already started")
+	}
+
+	if _, err := os.Stat(s.image); err != nil {
+		return errors.New("still source has no image")
+	}
+
+	if err := s.process.Start(); err != nil {
+		return err
+	}
+
+	s.started = true
+
+	return nil
+}
+
+// GetIncomingStreamTrack get the video track, attach it to an OutgoingStreamTrack to send it
+func (s *StillSource) GetIncomingStreamTrack() *IncomingStreamTrack {
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.process == nil {
+		return nil
+	}
+	return s.process.GetIncomingStreamTrack("video")
+}
+
+// Stop stop encoding and remove the image
+func (s *StillSource) Stop() {
+
+	s.Lock()
+	process := s.process
+	s.process = nil
+	s.Unlock()
+
+	if process == nil {
+		return
+	}
+
+	process.Stop()
+	os.RemoveAll(s.dir)
+}
@@ -0,0 +1,109 @@
+package mediaserver
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/notedit/sdp"
+)
+
+// SubscribePolicy what SubscribeAll sends of each incoming stream
+type SubscribePolicy struct {
+	Audio bool
+	Video bool
+	// SkipExisting skip the incoming streams already sent, instead of failing, the outgoing streams use the incoming stream ids
+	SkipExisting bool
+	// Concurrency number of streams attached at the same time, 1 if zero
+	Concurrency int
+}
+
+// SubscribeResult outcome of the subscription to one incoming stream
+type SubscribeResult struct {
+	Incoming *IncomingStream
+	Outgoing *OutgoingStream
+	Err      error
+}
+
+// SubscribeAll create an outgoing stream for each incoming stream and attach them, ie when a viewer joins a room.
+// It is only a convenience wrapper around CreateOutgoingStreamChecked and AttachToChecked: each attach runs its own
+// native operations on the media thread, they are not batched in a single time service task.
+// The outgoing streams are created first so the sdp can be renegotiated while the attaches run, the ValidateOutgoingStream
+// error is returned for the ones that can not be created.
+// Results are in the order of the incoming streams, an outgoing stream whose attach failed is kept with the error
+func (t *Transport) SubscribeAll(incomingStreams []*IncomingStream, policy SubscribePolicy) []*SubscribeResult {
+
+	capabilities := map[string]*sdp.Capability{
+		"audio": {Rtx: hasRTX(t.localAudio)},
+		"video": {Rtx: hasRTX(t.localVideo)},
+	}
+
+	results := make([]*SubscribeResult, len(incomingStreams))
+
+	for i, incoming := range incomingStreams {
+
+		result := &SubscribeResult{Incoming: incoming}
+		results[i] = result
+
+		if existing := t.GetOutgoingStream(incoming.GetID()); existing != nil {
+			if !policy.SkipExisting {
+				result.Err = fmt.Errorf("stream %s already subscribed", incoming.GetID())
+			}
+			continue
+		}
+
+		audios, videos := 0, 0
+		if policy.Audio {
+			audios = len(incoming.GetAudioTracks())
+		}
+		if policy.Video {
+			videos = len(incoming.GetVideoTracks())
+		}
+
+		if audios+videos == 0 {
+			continue
+		}
+
+		result.Outgoing, result.Err = t.CreateOutgoingStreamChecked(newOutgoingStreamInfo(incoming.GetID(), audios, videos, capabilities))
+	}
+
+	concurrency := policy.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan *SubscribeResult)
+	var wg sync.WaitGroup
+
+	for n := 0; n < concurrency; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for result := range jobs {
+				_, result.Err = result.Outgoing.AttachToChecked(result.Incoming)
+			}
+		}()
+	}
+
+	for _, result := range results {
+		if result.Outgoing != nil && result.Err == nil {
+			jobs <- result
+		}
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	return results
+}
+
+func hasRTX(media *sdp.MediaInfo) bool {
+	if media == nil {
+		return false
+	}
+	for _, codec := range media.GetCodecs() {
+		if codec.HasRTX() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+package mediaserver
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// TextSourceConfig configuration of a TextSource
+type TextSourceConfig struct {
+	// Path of the ffmpeg binary, "ffmpeg" if empty
+	Path string
+	// Codec of the video, "vp8" or "h264", vp8 if empty
+	Codec string
+	// Width and Height of the video, 1280x720 if zero
+	Width  int
+	Height int
+	// FPS frames per second, 10 if zero
+	FPS int
+	// Bitrate in kbps, 300 if zero
+	Bitrate int
+	// FontFile ttf font to use, the fontconfig default if empty
+	FontFile string
+	// FontSize in pixels, 48 if zero
+	FontSize int
+	// FontColor and Background ffmpeg colors, white on black if empty
+	FontColor  string
+	Background string
+	// Ticker scroll the text from right to left at TickerSpeed pixels per second instead of centering it
+	Ticker      bool
+	TickerSpeed int
+}
+
+// TextSource render text into a video track, ie for scoreboards, clocks, countdowns or tickers.
+// The text can be changed while the source is running
+type TextSource struct {
+	config  TextSourceConfig
+	process *FFmpegProcess
+	dir     string
+	text    string
+	render  func(now time.Time) string
+	ticker  *time.Ticker
+	done    chan struct{}
+	started bool
+	sync.Mutex
+}
+
+// NewTextSource create a text source showing an empty text
+func NewTextSource(config TextSourceConfig) (*TextSource, error) {
+
+	if config.FPS == 0 {
+		config.FPS = 10
+	}
+	if config.FontSize == 0 {
+		config.FontSize = 48
+	}
+	if config.FontColor == "" {
+		config.FontColor = "white"
+	}
+	if config.Background == "" {
+		config.Background = "black"
+	}
+	if config.TickerSpeed == 0 {
+		config.TickerSpeed = 100
+	}
+
+	dir, err := ioutil.TempDir("", "media-server-go-text")
+	if err != nil {
+		return nil, err
+	}
+
+	source := &TextSource{
+		config: config,
+		dir:    dir,
+		text:   filepath.Join(dir, "text.txt"),
+	}
+
+	if err := source.write(""); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	encoding := &videoSourceEncoding{Path: config.Path, Codec: config.Codec, Width: config.Width, Height: config.Height, FPS: config.FPS, Bitrate: config.Bitrate}
+
+	encoding.setDefaults()
+
+	input := []string{"-re", "-f", "lavfi", "-i", fmt.Sprintf("color=c=%s:s=%dx%d:r=%d", config.Background, encoding.Width, encoding.Height, config.FPS)}
+
+	x := "(w-tw)/2"
+	if config.Ticker {
+		x = "w-mod(t*" + strconv.Itoa(config.TickerSpeed) + "\\,w+tw)"
+	}
+
+	// The text file is reloaded on every frame and is not expanded, so any text can be shown
+	filter := fmt.Sprintf("drawtext=textfile='%s':reload=1:expansion=none:fontcolor=%s:fontsize=%d:x=%s:y=(h-th)/2",
+		escapeFilterValue(source.text), config.FontColor, config.FontSize, x)
+	if config.FontFile != "" {
+		filter += ":fontfile='" + escapeFilterValue(config.FontFile) + "'"
+	}
+
+	process, err := encoding.newProcess(input, filter)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	source.process = process
+
+	return source, nil
+}
+
+// SetText show a fixed text, stopping any clock or countdown
+func (t *TextSource) SetText(text string) error {
+	t.Lock()
+	defer t.Unlock()
+
+	t.render = nil
+	return t.write(text)
+}
+
+// SetClock show the current time formatted with the time package layout, updated every second
+func (t *TextSource) SetClock(layout string) error {
+	return t.SetRenderer(func(now time.Time) string {
+		return now.Format(layout)
+	})
+}
+
+// SetCountdown show the time left until deadline, updated every second, and 00:00 once it is reached
+func (t *TextSource) SetCountdown(deadline time.Time) error {
+	return t.SetRenderer(func(now time.Time) string {
+		return FormatCountdown(deadline.Sub(now))
+	})
+}
+
+// SetRenderer show the text returned by render, called every second
+func (t *TextSource) SetRenderer(render func(now time.Time) string) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.process == nil {
+		return errors.New("text source stopped")
+	}
+
+	t.render = render
+	if t.ticker == nil {
+		t.ticker = time.NewTicker(time.Second)
+		t.done = make(chan struct{})
+		go t.run(t.ticker, t.done)
+	}
+
+	return t.write(render(time.Now()))
+}
+
+// Start launch the encoding
+func (t *TextSource) Start() error {
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.process == nil {
+		return errors.New("text source stopped")
+	}
+
+	if t.started {
+		return errors.New("text source already started")
+	}
+
+	if err := t.process.Start(); err != nil {
+		return err
+	}
+
+	t.started = true
+
+	return nil
+}
+
+// GetIncomingStreamTrack get the video track, attach it to an OutgoingStreamTrack to send it
+func (t *TextSource) GetIncomingStreamTrack() *IncomingStreamTrack {
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.process == nil {
+		return nil
+	}
+	return t.process.GetIncomingStreamTrack("video")
+}
+
+// Stop stop encoding and remove the text file
+func (t *TextSource) Stop() {
+
+	t.Lock()
+	process := t.process
+	t.process = nil
+	if t.ticker != nil {
+		t.ticker.Stop()
+		close(t.done)
+		t.ticker = nil
+	}
+	t.Unlock()
+
+	if process == nil {
+		return
+	}
+
+	process.Stop()
+	os.RemoveAll(t.dir)
+}
+
+func (t *TextSource) run(ticker *time.Ticker, done chan struct{}) {
+	for {
+		select {
+		case now := <-ticker.C:
+			t.Lock()
+			if t.render != nil && t.process != nil {
+				t.write(t.render(now))
+			}
+			t.Unlock()
+		case <-done:
+			return
+		}
+	}
+}
+
+// write must be called with the lock held, the file is renamed so ffmpeg never reads a partial text
+func (t *TextSource) write(text string) error {
+	tmp := t.text + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(text), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, t.text)
+}
+
+// FormatCountdown format a remaining duration as mm:ss, or hh:mm:ss over an hour, negative durations are 00:00
+func FormatCountdown(left time.Duration) string {
+
+	if left < 0 {
+		left = 0
+	}
+
+	// Round up so the countdown shows 00:00 only when the deadline is reached
+	seconds := int((left + time.Second - 1) / time.Second)
+
+	if seconds >= 3600 {
+		return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,367 @@
+package mediaserver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// timeShiftSegments number of segments maxDuration is split into, a segment is removed once all its frames are out of the window
+const timeShiftSegments = 4
+
+// timeShiftSegment file holding the frames buffered during a part of the time shift window
+type timeShiftSegment struct {
+	file  *os.File
+	start time.Time
+	size  int64
+}
+
+func (s *timeShiftSegment) remove() {
+	s.file.Close()
+	os.Remove(s.file.Name())
+}
+
+// timeShiftEntry position of a buffered frame in its segment file
+type timeShiftEntry struct {
+	at      time.Time
+	segment *timeShiftSegment
+	offset  int64
+	size    int
+	key     bool
+}
+
+// TimeShifter buffer the frames of an incoming h264 or opus track to disk so they can be played back with a delay
+type TimeShifter struct {
+	reader      *TrackReader
+	filename    string
+	codec       string
+	maxDuration time.Duration
+	segments    []*timeShiftSegment
+	sequence    int
+	entries     []timeShiftEntry
+	players     map[*TimeShiftPlayer]bool
+	stopped     bool
+	sync.RWMutex
+}
+
+// NewTimeShifter start buffering the track frames into segment files named filename.<n>, keeping at most maxDuration of media available.
+// The segments older than maxDuration are removed, with a zero maxDuration a single segment grows without limit.
+// codec must be "h264" or "opus"
+func NewTimeShifter(track *IncomingStreamTrack, codec string, filename string, maxDuration time.Duration) (*TimeShifter, error) {
+
+	codec = strings.ToLower(codec)
+	if codec != "h264" && codec != "opus" {
+		return nil, errors.New("time shift only supports h264 and opus")
+	}
+
+	shifter := &TimeShifter{
+		filename:    filename,
+		codec:       codec,
+		maxDuration: maxDuration,
+		players:     make(map[*TimeShiftPlayer]bool),
+	}
+
+	if _, err := shifter.rotate(time.Now()); err != nil {
+		return nil, err
+	}
+
+	shifter.reader = newTrackReader(track, nil, func(frame []byte, timestamp uint64) []byte {
+		shifter.add(frame)
+		return nil
+	})
+
+	return shifter, nil
+}
+
+func (t *TimeShifter) add(frame []byte) {
+
+	key := true
+	if t.codec == "h264" {
+		annexb, err := annexbConvert(frame)
+		if err != nil {
+			return
+		}
+		frame = annexb
+		key = isH264KeyFrame(frame)
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.stopped {
+		return
+	}
+
+	now := time.Now()
+
+	segment := t.segments[len(t.segments)-1]
+	// start a new segment on a key frame so the oldest ones can be removed without breaking playback
+	if t.maxDuration > 0 && key && now.Sub(segment.start) >= t.maxDuration/timeShiftSegments {
+		next, err := t.rotate(now)
+		if err == nil {
+			segment = next
+		}
+	}
+
+	if _, err := segment.file.WriteAt(frame, segment.size); err != nil {
+		return
+	}
+
+	t.entries = append(t.entries, timeShiftEntry{at: now, segment: segment, offset: segment.size, size: len(frame), key: key})
+	segment.size += int64(len(frame))
+
+	if t.maxDuration > 0 {
+		t.expire(now)
+	}
+}
+
+// rotate open a new segment file, called locked
+func (t *TimeShifter) rotate(now time.Time) (*timeShiftSegment, error) {
+
+	file, err := os.Create(fmt.Sprintf("%s.%d", t.filename, t.sequence))
+	if err != nil {
+		return nil, err
+	}
+	t.sequence++
+
+	segment := &timeShiftSegment{file: file, start: now}
+	t.segments = append(t.segments, segment)
+	return segment, nil
+}
+
+// expire forget the frames out of the window and remove the segments left without frames, called locked
+func (t *TimeShifter) expire(now time.Time) {
+
+	drop := sort.Search(len(t.entries), func(i int) bool {
+		return now.Sub(t.entries[i].at) <= t.maxDuration
+	})
+	t.entries = t.entries[drop:]
+
+	for len(t.segments) > 1 && (len(t.entries) == 0 || t.entries[0].segment != t.segments[0]) {
+		t.segments[0].remove()
+		t.segments = t.segments[1:]
+	}
+}
+
+// isH264KeyFrame check if an Annex-B access unit contains an IDR slice
+func isH264KeyFrame(annexb []byte) bool {
+	for i := 0; i+3 < len(annexb); i++ {
+		if annexb[i] == 0 && annexb[i+1] == 0 && annexb[i+2] == 1 && annexb[i+3]&0x1F == 5 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBufferedDuration get the duration of media available for playback
+func (t *TimeShifter) GetBufferedDuration() time.Duration {
+	t.RLock()
+	defer t.RUnlock()
+	if len(t.entries) == 0 {
+		return 0
+	}
+	return time.Since(t.entries[0].at)
+}
+
+// find the index of the first frame to play at the given time, starting on a key frame
+func (t *TimeShifter) find(at time.Time) int {
+
+	t.RLock()
+	defer t.RUnlock()
+
+	index := sort.Search(len(t.entries), func(i int) bool {
+		return !t.entries[i].at.Before(at)
+	})
+
+	for index > 0 && index < len(t.entries) && !t.entries[index].key {
+		index--
+	}
+	return index
+}
+
+// get the entry at index and read its frame, the index may have shifted if old frames were dropped
+func (t *TimeShifter) read(at time.Time) (*timeShiftEntry, []byte) {
+
+	t.RLock()
+	defer t.RUnlock()
+
+	index := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].at.After(at)
+	})
+	if index == len(t.entries) {
+		return nil, nil
+	}
+
+	entry := t.entries[index]
+	frame := make([]byte, entry.size)
+	if _, err := entry.segment.file.ReadAt(frame, entry.offset); err != nil {
+		return nil, nil
+	}
+	return &entry, frame
+}
+
+// NewPlayer create a player of the buffered media delayed by offset, attach its incoming track to the OutgoingStreamTrack to serve
+func (t *TimeShifter) NewPlayer(offset time.Duration) (*TimeShiftPlayer, error) {
+
+	var writer *TrackWriter
+	var clockRate float64
+	if t.codec == "h264" {
+		var err error
+		if writer, err = NewH264Writer(30); err != nil {
+			return nil, err
+		}
+		clockRate = 90000
+	} else {
+		writer = NewOpusWriter()
+		clockRate = 48000
+	}
+
+	player := &TimeShiftPlayer{
+		shifter:   t,
+		writer:    writer,
+		clockRate: clockRate,
+		offset:    offset,
+		seek:      make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+	}
+
+	t.Lock()
+	t.players[player] = true
+	t.Unlock()
+
+	go player.run()
+
+	return player, nil
+}
+
+// Stop stop buffering, stop the players and remove the segment files
+func (t *TimeShifter) Stop() {
+
+	if t.reader != nil {
+		t.reader.Close()
+	}
+
+	t.Lock()
+	players := t.players
+	t.players = make(map[*TimeShiftPlayer]bool)
+	t.Unlock()
+
+	for player := range players {
+		player.Stop()
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.stopped {
+		return
+	}
+	t.stopped = true
+
+	for _, segment := range t.segments {
+		segment.remove()
+	}
+	t.segments = nil
+	t.entries = nil
+}
+
+// TimeShiftPlayer play the buffered media of a TimeShifter with a delay
+type TimeShiftPlayer struct {
+	shifter   *TimeShifter
+	writer    *TrackWriter
+	clockRate float64
+	offset    time.Duration
+	seek      chan struct{}
+	stop      chan struct{}
+	once      sync.Once
+	sync.Mutex
+}
+
+// GetIncomingStreamTrack get the track with the delayed media
+func (p *TimeShiftPlayer) GetIncomingStreamTrack() *IncomingStreamTrack {
+	return p.writer.GetIncomingStreamTrack()
+}
+
+// GetOffset get the current delay behind the live media
+func (p *TimeShiftPlayer) GetOffset() time.Duration {
+	p.Lock()
+	defer p.Unlock()
+	return p.offset
+}
+
+// Seek change the delay behind the live media, playback restarts on the nearest previous key frame
+func (p *TimeShiftPlayer) Seek(offset time.Duration) {
+
+	p.Lock()
+	p.offset = offset
+	p.Unlock()
+
+	select {
+	case p.seek <- struct{}{}:
+	default:
+	}
+}
+
+func (p *TimeShiftPlayer) run() {
+
+	for {
+		offset := p.GetOffset()
+
+		// start on a key frame at or before the playback position
+		index := p.shifter.find(time.Now().Add(-offset))
+		var last time.Time
+		p.shifter.RLock()
+		if index < len(p.shifter.entries) {
+			last = p.shifter.entries[index].at.Add(-time.Nanosecond)
+		}
+		p.shifter.RUnlock()
+
+		if last.IsZero() {
+			last = time.Now().Add(-offset)
+		}
+
+		seeked := false
+		for !seeked {
+			entry, frame := p.shifter.read(last)
+			if entry == nil {
+				// wait for new frames
+				select {
+				case <-p.stop:
+					return
+				case <-p.seek:
+					seeked = true
+				case <-time.After(10 * time.Millisecond):
+				}
+				continue
+			}
+
+			select {
+			case <-p.stop:
+				return
+			case <-p.seek:
+				seeked = true
+				continue
+			case <-time.After(time.Until(entry.at.Add(offset))):
+			}
+
+			duration := uint32(entry.at.Sub(last).Seconds() * p.clockRate)
+			p.writer.writeFrame(frame, duration)
+			last = entry.at
+		}
+	}
+}
+
+// Stop stop the playback
+func (p *TimeShiftPlayer) Stop() {
+	p.once.Do(func() {
+		close(p.stop)
+		p.writer.Close()
+		p.shifter.Lock()
+		delete(p.shifter.players, p)
+		p.shifter.Unlock()
+	})
+}
@@ -0,0 +1,59 @@
+package mediaserver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_TimeShifterSegments(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "timeshift")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	shifter := &TimeShifter{
+		filename:    filepath.Join(dir, "buffer"),
+		codec:       "opus",
+		maxDuration: 40 * time.Millisecond,
+		players:     make(map[*TimeShiftPlayer]bool),
+	}
+	if _, err := shifter.rotate(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		shifter.add([]byte{byte(i)})
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "buffer.*"))
+	if len(files) > timeShiftSegments+1 || len(files) != len(shifter.segments) {
+		t.Error("expected the old segments removed", files)
+	}
+
+	if shifter.GetBufferedDuration() > 2*shifter.maxDuration {
+		t.Error("expected the buffer bounded by the max duration", shifter.GetBufferedDuration())
+	}
+
+	entry, frame := shifter.read(start)
+	if entry == nil || len(frame) != 1 || frame[0] != byte(20-len(shifter.entries)) {
+		t.Error("expected the oldest buffered frame", entry, frame)
+	}
+
+	last := shifter.entries[len(shifter.entries)-1]
+	if entry, _ := shifter.read(last.at); entry != nil {
+		t.Error("expected no frame after the last one", entry)
+	}
+
+	shifter.Stop()
+
+	if files, _ := filepath.Glob(filepath.Join(dir, "buffer.*")); len(files) != 0 {
+		t.Error("expected the segments removed", files)
+	}
+}
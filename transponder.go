@@ -4,6 +4,8 @@ import (
 	"errors"
 	"math"
 	"sort"
+	"sync"
+	"time"
 
 	native "github.com/notedit/media-server-go/wrapper"
 )
@@ -28,6 +30,13 @@ type Transponder struct {
 	temporalLayerId    int
 	maxSpatialLayerId  int
 	maxTemporalLayerId int
+	feedback           FeedbackStats
+	keyframe           *KeyframeConfig
+	keyframeWatcher    *keyframeWatcher
+	keyframeTimer      *time.Timer
+	keyframeLock       sync.Mutex
+	maxBitrate         func() uint
+	target             *transponderTarget
 	onStopListeners    []func()
 }
 
@@ -57,6 +66,7 @@ func (t *Transponder) SetIncomingTrack(incomingTrack *IncomingStreamTrack) error
 	}
 
 	if t.track != nil {
+		t.track.removeTransponder(t)
 		t.track.Detached()
 	}
 
@@ -79,8 +89,11 @@ func (t *Transponder) SetIncomingTrack(incomingTrack *IncomingStreamTrack) error
 	t.maxSpatialLayerId = MaxLayerId
 	t.maxTemporalLayerId = MaxLayerId
 
+	t.track.addTransponder(t)
 	t.track.Attached()
 
+	t.onSourceChange(encoding)
+
 	return nil
 }
 
@@ -104,7 +117,8 @@ func (t *Transponder) Mute(muting bool) {
 
 	if t.muted != muting {
 		t.muted = muting
-		if t.transponder != nil {
+		// Stay muted natively until the keyframe we are waiting for
+		if t.transponder != nil && (muting || !t.isWaitingKeyframe()) {
 			t.transponder.Mute(muting)
 		}
 	}
@@ -117,8 +131,44 @@ func getSpatialLayerId(layer *Layer) int {
 	return layer.SimulcastIdx
 }
 
+// transponderTarget the last target bitrate requested, so the layers can be selected again when the cap changes
+type transponderTarget struct {
+	bitrate   uint
+	traversal BitrateTraversal
+	strict    bool
+}
+
+// SetTargetBitrate select the layer with the highest bitrate under the target, capped to the share of the track of the
+// transport outgoing bitrate cap. If none fits, the lowest layer is selected, or the transponder is muted when strict.
+// Returns the bitrate of the selected layer
 func (t *Transponder) SetTargetBitrate(bitrate uint, traversal BitrateTraversal, strict bool) uint {
 
+	t.target = &transponderTarget{bitrate: bitrate, traversal: traversal, strict: strict}
+
+	if t.maxBitrate != nil {
+		if max := t.maxBitrate(); max > 0 && bitrate > max {
+			bitrate = max
+		}
+	}
+
+	return t.selectTargetBitrate(bitrate, traversal, strict)
+}
+
+// retarget select the layers again for the last target, or for the cap when no target has been set
+func (t *Transponder) retarget() {
+	if t.target != nil {
+		t.SetTargetBitrate(t.target.bitrate, t.target.traversal, t.target.strict)
+		return
+	}
+	if t.maxBitrate != nil {
+		if max := t.maxBitrate(); max > 0 {
+			t.selectTargetBitrate(max, TraversalDefault, false)
+		}
+	}
+}
+
+func (t *Transponder) selectTargetBitrate(bitrate uint, traversal BitrateTraversal, strict bool) uint {
+
 	if t.track == nil {
 		return 0
 	}
@@ -244,7 +294,7 @@ func (t *Transponder) SetTargetBitrate(bitrate uint, traversal BitrateTraversal,
 // SelectEncoding by Id
 func (t *Transponder) SelectEncoding(encodingId string) {
 
-	if t.encodingId == encodingId {
+	if t.transponder == nil || t.track == nil || t.encodingId == encodingId {
 		return
 	}
 	encoding := t.track.GetEncoding(encodingId)
@@ -253,6 +303,8 @@ func (t *Transponder) SelectEncoding(encodingId string) {
 	}
 	t.transponder.SetIncoming(encoding.GetSource(), t.track.receiver)
 	t.encodingId = encodingId
+
+	t.onSourceChange(encoding)
 }
 
 // GetSelectedEncoding get selected encoding Id
@@ -273,6 +325,10 @@ func (t *Transponder) GetSelectedTemporalLayerId() int {
 // SelectLayer Select SVC temporatl and spatial layers. Only available for VP9 Media.
 func (t *Transponder) SelectLayer(spatialLayerId, temporalLayerId int) {
 
+	if t.transponder == nil {
+		return
+	}
+
 	spatialLayerId = Min(spatialLayerId, t.maxSpatialLayerId)
 	temporalLayerId = Min(temporalLayerId, t.maxTemporalLayerId)
 
@@ -282,6 +338,18 @@ func (t *Transponder) SelectLayer(spatialLayerId, temporalLayerId int) {
 
 	t.transponder.SelectLayer(spatialLayerId, temporalLayerId)
 
+	// Going up a spatial layer needs a keyframe
+	if spatialLayerId > t.spatialLayerId && t.track != nil {
+		t.keyframeLock.Lock()
+		config := t.keyframe
+		t.keyframeLock.Unlock()
+		if config != nil && config.RequestPLI {
+			if encoding := t.track.GetEncoding(t.encodingId); encoding != nil {
+				t.track.requestKeyframe(encoding, config.MinPLIInterval)
+			}
+		}
+	}
+
 	t.spatialLayerId = spatialLayerId
 	t.temporalLayerId = temporalLayerId
 }
@@ -296,6 +364,65 @@ func (t *Transponder) SetMaximumLayers(maxSpatialLayerId, maxTemporalLayerId int
 	t.maxTemporalLayerId = maxTemporalLayerId
 }
 
+// getFeedbackStats get the RTCP feedback received by the outgoing track while attached
+func (t *Transponder) getFeedbackStats() *FeedbackStats {
+
+	// the feedback of the native transponders already closed by Stop or rebind
+	feedback := t.feedback
+	if t.transponder != nil {
+		feedback.add(&FeedbackStats{
+			PLIsReceived:  t.transponder.GetTotalPLIRequests(),
+			REMBsReceived: t.transponder.GetTotalREMBs(),
+			Remb:          t.transponder.GetLastREMB(),
+		})
+	}
+	return &feedback
+}
+
+// rebind replace the native transponder by facade, ie when the sender of the outgoing track changes.
+// The incoming track, the selected encoding and layers, mute, target and keyframe config are kept,
+// so the Transponder held by the application stays usable
+func (t *Transponder) rebind(facade native.RTPStreamTransponderFacade) {
+
+	if t.transponder == nil {
+		native.DeleteRTPStreamTransponderFacade(facade)
+		return
+	}
+
+	t.keyframeLock.Lock()
+	t.cancelWaitKeyframe()
+	t.keyframeLock.Unlock()
+
+	old := t.transponder
+	old.Close()
+	t.feedback = *t.getFeedbackStats()
+	t.transponder = facade
+	native.DeleteRTPStreamTransponderFacade(old)
+
+	if t.muted {
+		facade.Mute(true)
+	}
+
+	if t.track == nil {
+		return
+	}
+
+	encoding := t.track.GetEncoding(t.encodingId)
+	if encoding == nil {
+		if encoding = t.track.GetFirstEncoding(); encoding == nil {
+			return
+		}
+		t.encodingId = encoding.GetID()
+	}
+	facade.SetIncoming(encoding.GetSource(), t.track.receiver)
+
+	if t.spatialLayerId != MaxLayerId || t.temporalLayerId != MaxLayerId {
+		facade.SelectLayer(t.spatialLayerId, t.temporalLayerId)
+	}
+
+	t.onSourceChange(encoding)
+}
+
 // Stop stop this transponder
 func (t *Transponder) Stop() {
 
@@ -304,11 +431,18 @@ func (t *Transponder) Stop() {
 	}
 
 	if t.track != nil {
+		t.track.removeTransponder(t)
 		t.track.Detached()
 	}
 
+	t.keyframeLock.Lock()
+	t.cancelWaitKeyframe()
+	t.keyframeLock.Unlock()
+
 	t.transponder.Close()
 
+	t.feedback = *t.getFeedbackStats()
+
 	native.DeleteRTPStreamTransponderFacade(t.transponder)
 
 	t.transponder = nil
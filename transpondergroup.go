@@ -0,0 +1,197 @@
+package mediaserver
+
+import (
+	"sort"
+	"sync"
+)
+
+// TransponderGroup share a bandwidth budget between the transponders sending to one subscriber.
+// The budget goes to the transponders by priority, and only MaxHigh of them may send their top layer,
+// ie one HD tile at a time, instead of each transponder adapting on its own
+type TransponderGroup struct {
+	bitrate   uint
+	traversal BitrateTraversal
+	maxHigh   int
+	members   []*groupMember
+	sync.Mutex
+}
+
+type groupMember struct {
+	transponder *Transponder
+	priority    int
+	bitrate     uint
+}
+
+// NewTransponderGroup create a group sharing bitrate bps, with no limit on the transponders sending their top layer
+func NewTransponderGroup(bitrate uint, traversal BitrateTraversal) *TransponderGroup {
+	return &TransponderGroup{
+		bitrate:   bitrate,
+		traversal: traversal,
+		maxHigh:   -1,
+	}
+}
+
+// Add add a transponder to the group, higher priorities are served first
+func (g *TransponderGroup) Add(transponder *Transponder, priority int) {
+	g.Lock()
+	defer g.Unlock()
+
+	for _, member := range g.members {
+		if member.transponder == transponder {
+			member.priority = priority
+			return
+		}
+	}
+	g.members = append(g.members, &groupMember{transponder: transponder, priority: priority})
+}
+
+// SetPriority change the priority of a transponder, ie to promote the active speaker
+func (g *TransponderGroup) SetPriority(transponder *Transponder, priority int) {
+	g.Add(transponder, priority)
+}
+
+// Remove remove a transponder from the group, its layers are left as they are
+func (g *TransponderGroup) Remove(transponder *Transponder) {
+	g.Lock()
+	defer g.Unlock()
+
+	for i, member := range g.members {
+		if member.transponder == transponder {
+			g.members = append(g.members[:i], g.members[i+1:]...)
+			return
+		}
+	}
+}
+
+// SetBitrate set the bandwidth budget of the group in bps, ie from the subscriber estimation
+func (g *TransponderGroup) SetBitrate(bitrate uint) {
+	g.Lock()
+	g.bitrate = bitrate
+	g.Unlock()
+
+	g.Update()
+}
+
+// SetMaxHigh set how many transponders may send their top layer at the same time, -1 for no limit
+func (g *TransponderGroup) SetMaxHigh(maxHigh int) {
+	g.Lock()
+	g.maxHigh = maxHigh
+	g.Unlock()
+
+	g.Update()
+}
+
+// GetBitrate get the bitrate allocated to a transponder in the last update
+func (g *TransponderGroup) GetBitrate(transponder *Transponder) uint {
+	g.Lock()
+	defer g.Unlock()
+
+	for _, member := range g.members {
+		if member.transponder == transponder {
+			return member.bitrate
+		}
+	}
+	return 0
+}
+
+// Update split the budget again with the current layers of the incoming tracks, call it periodically as the layer bitrates change.
+// Stopped transponders are removed from the group
+func (g *TransponderGroup) Update() {
+
+	g.Lock()
+	defer g.Unlock()
+
+	members := g.members[:0]
+	for _, member := range g.members {
+		if member.transponder.transponder != nil {
+			members = append(members, member)
+		}
+	}
+	g.members = members
+
+	sort.SliceStable(g.members, func(i, j int) bool {
+		return g.members[i].priority > g.members[j].priority
+	})
+
+	candidates := make([][]uint, len(g.members))
+	for i, member := range g.members {
+		candidates[i] = getLayerBitrates(member.transponder)
+	}
+
+	allocated := allocateGroupBitrate(g.bitrate, candidates, g.maxHigh)
+
+	for i, member := range g.members {
+		member.bitrate = allocated[i]
+		if len(candidates[i]) == 0 {
+			// Audio or no layer info, nothing to select
+			continue
+		}
+		// A zero allocation mutes the transponder
+		member.transponder.SetTargetBitrate(allocated[i], g.traversal, true)
+	}
+}
+
+// getLayerBitrates get the distinct bitrates of the active layers of the transponder track in ascending order
+func getLayerBitrates(transponder *Transponder) []uint {
+
+	layers := transponder.GetAvailableLayers()
+	if layers == nil {
+		return nil
+	}
+
+	seen := map[uint]bool{}
+	bitrates := []uint{}
+	for _, layer := range layers.Layers {
+		if layer.Bitrate > 0 && !seen[layer.Bitrate] {
+			seen[layer.Bitrate] = true
+			bitrates = append(bitrates, layer.Bitrate)
+		}
+	}
+
+	sort.Slice(bitrates, func(i, j int) bool { return bitrates[i] < bitrates[j] })
+
+	return bitrates
+}
+
+// allocateGroupBitrate split budget between candidates, the ascending layer bitrates of each member by priority.
+// Every member gets its lowest layer first while the budget allows, then the members are upgraded in priority order,
+// only the first maxHigh upgraded members can get their top layer when there is more than one layer
+func allocateGroupBitrate(budget uint, candidates [][]uint, maxHigh int) []uint {
+
+	allocated := make([]uint, len(candidates))
+	left := budget
+
+	for i, bitrates := range candidates {
+		if len(bitrates) > 0 && bitrates[0] <= left {
+			allocated[i] = bitrates[0]
+			left -= bitrates[0]
+		}
+	}
+
+	high := 0
+	for i, bitrates := range candidates {
+
+		if allocated[i] == 0 {
+			continue
+		}
+
+		top := len(bitrates) - 1
+		if top > 0 && maxHigh >= 0 && high >= maxHigh {
+			top--
+		}
+
+		available := left + allocated[i]
+		for l := top; l >= 0; l-- {
+			if bitrates[l] <= available {
+				left = available - bitrates[l]
+				allocated[i] = bitrates[l]
+				if l == len(bitrates)-1 && l > 0 {
+					high++
+				}
+				break
+			}
+		}
+	}
+
+	return allocated
+}
@@ -0,0 +1,27 @@
+package mediaserver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_AllocateGroupBitrate(t *testing.T) {
+
+	layers := []uint{150000, 500000, 1500000}
+	candidates := [][]uint{layers, layers, layers, nil}
+
+	// Enough for everybody in HD, but only one allowed
+	if allocated := allocateGroupBitrate(10000000, candidates, 1); !reflect.DeepEqual(allocated, []uint{1500000, 500000, 500000, 0}) {
+		t.Error("expected only the first member in HD", allocated)
+	}
+
+	// The first member gets what is left after the lowest layers of the others
+	if allocated := allocateGroupBitrate(2000000, candidates, -1); !reflect.DeepEqual(allocated, []uint{1500000, 150000, 150000, 0}) {
+		t.Error("expected the budget served by priority", allocated)
+	}
+
+	// Not enough for all the lowest layers
+	if allocated := allocateGroupBitrate(400000, candidates, -1); !reflect.DeepEqual(allocated, []uint{150000, 150000, 0, 0}) {
+		t.Error("expected the last member muted", allocated)
+	}
+}
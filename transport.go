@@ -3,6 +3,8 @@ package mediaserver
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gofrs/uuid"
 	native "github.com/notedit/media-server-go/wrapper"
@@ -23,11 +25,12 @@ func (r *goSenderSideEstimatorListener) deleteSenderSideEstimatorListener() {
 }
 
 type overwrittenSenderSideEstimatorListener struct {
-	p native.SenderSideEstimatorListener
+	p         native.SenderSideEstimatorListener
+	transport *Transport
 }
 
 func (p *overwrittenSenderSideEstimatorListener) OnTargetBitrateRequested(bitrate uint) {
-	fmt.Println(bitrate)
+	p.transport.onTargetBitrate(bitrate)
 }
 
 type dtlsICETransportListener interface {
@@ -44,11 +47,12 @@ func (d *goDTLSICETransportListener) deleteDTLSICETransportListener() {
 }
 
 type overwrittenDTLSICETransportListener struct {
-	p native.DTLSICETransportListener
+	p         native.DTLSICETransportListener
+	transport *Transport
 }
 
 func (p *overwrittenDTLSICETransportListener) OnDTLSStateChange(state uint) {
-	fmt.Println("OnDTLSStateChange", state)
+	p.transport.onDTLSStateChange(state)
 }
 
 type (
@@ -62,6 +66,23 @@ type (
 	DTLSStateListener func(state string)
 )
 
+// ICEMode ICE agent mode of a Transport
+type ICEMode int
+
+const (
+	// ICELite the server only answers the connectivity checks of the remote peer
+	ICELite ICEMode = iota
+	// ICEFull the server also sends connectivity checks to the remote candidates, needed when the remote peer is ICE-lite too
+	ICEFull
+)
+
+func (m ICEMode) String() string {
+	if m == ICEFull {
+		return "full"
+	}
+	return "lite"
+}
+
 // ICEStats ice stats for this connection
 type ICEStats struct {
 	RequestsSent      int64
@@ -78,10 +99,21 @@ type Transport struct {
 	remoteIce        *sdp.ICEInfo
 	remoteDtls       *sdp.DTLSInfo
 	remoteCandidates []*sdp.CandidateInfo
+	remoteAudio      *sdp.MediaInfo
+	remoteVideo      *sdp.MediaInfo
+	localAudio       *sdp.MediaInfo
+	localVideo       *sdp.MediaInfo
 	bundle           native.RTPBundleTransport
 	transport        native.DTLSICETransport
 	connection       native.RTPBundleTransportConnection
 	dtlsState        string
+	dtlsConnected    bool
+	dtlsError        error
+	srtpProfiles     []string
+	iceMode          ICEMode
+	endpoint         *Endpoint
+	stopping         bool
+	mdns             *MDNSResolver
 
 	username             string
 	incomingStreams      map[string]*IncomingStream
@@ -89,13 +121,45 @@ type Transport struct {
 	incomingStreamTracks map[string]*IncomingStreamTrack
 	outgoingStreamTracks map[string]*OutgoingStreamTrack
 
-	iceStats *ICEStats
+	iceStats        *ICEStats
+	limits          Limits
+	autoGenerateIDs bool
 
 	senderSideListener       senderSideEstimatorListener
 	dtlsICEListener          dtlsICETransportListener
 	outDTLSStateListener     DTLSStateListener
-	onIncomingTrackListeners []IncomingTrackListener
-	onOutgoingTrackListeners []OutgoingTrackListener
+	onIncomingTrackListeners emitter
+	onOutgoingTrackListeners emitter
+
+	onDTLSRenegotiatedListeners emitter
+	dtlsStateListeners          emitter
+	incomingStreamListeners     emitter
+
+	senderReportConfig *SenderReportConfig
+	senderReportStop   chan struct{}
+
+	rembConfig *REMBConfig
+	rembStop   chan struct{}
+
+	pacer        native.RTPPacer
+	pacerSender  native.RTPSenderFacade
+	pacerConfig  *PacerConfig
+	pacerBitrate uint
+	pacerStop    chan struct{}
+	pacerForCap  bool
+
+	maxOutgoingBitrate uint
+
+	stateMonitor *transportStateMonitor
+	soak         *soakSentinel
+
+	senderSideEstimation   bool
+	probing                ProbingConfig
+	targetBitrate          uint
+	targetBitrateListeners emitter
+	feedbacks              uint
+	lastFeedback           time.Time
+	feedbackInterval       time.Duration
 	sync.Mutex
 }
 
@@ -110,6 +174,8 @@ func NewTransport(bundle native.RTPBundleTransport, remoteIce *sdp.ICEInfo, remo
 	transport.localDtls = localDtls
 	transport.bundle = bundle
 	transport.dtlsState = "new"
+	transport.limits = DefaultLimits
+	transport.senderSideEstimation = true
 
 	properties := native.NewPropertiesFacade()
 
@@ -118,7 +184,7 @@ func NewTransport(bundle native.RTPBundleTransport, remoteIce *sdp.ICEInfo, remo
 	properties.SetPropertyStr("ice.remoteUsername", remoteIce.GetUfrag())
 	properties.SetPropertyStr("ice.remotePassword", remoteIce.GetPassword())
 
-	properties.SetPropertyStr("dtls.setup", remoteDtls.GetSetup().String())
+	properties.SetPropertyStr("dtls.setup", remoteSetupFor(localDtls.GetSetup(), remoteDtls.GetSetup()).String())
 	properties.SetPropertyStr("dtls.hash", remoteDtls.GetHash())
 	properties.SetPropertyStr("dtls.fingerprint", remoteDtls.GetFingerprint())
 
@@ -129,38 +195,38 @@ func NewTransport(bundle native.RTPBundleTransport, remoteIce *sdp.ICEInfo, remo
 
 	properties.SetPropertyBool("disableSTUNKeepAlive", stunKeepAlive)
 
+	// TODO: ICE restart, the native bundle needs a call to move the connection to the new username, see README
 	transport.username = localIce.GetUfrag() + ":" + remoteIce.GetUfrag()
 	transport.connection = bundle.AddICETransport(transport.username, properties)
 	transport.transport = transport.connection.GetTransport()
 
 	transport.iceStats = &ICEStats{}
 
+	atomic.AddInt64(&numTransports, 1)
+	transport.soak = soakTrack(soakTransports, 1)
+
 	native.DeletePropertiesFacade(properties)
 
-	sseListener := &overwrittenSenderSideEstimatorListener{}
+	sseListener := &overwrittenSenderSideEstimatorListener{transport: transport}
 	p := native.NewDirectorSenderSideEstimatorListener(sseListener)
 	sseListener.p = p
 
 	transport.senderSideListener = &goSenderSideEstimatorListener{SenderSideEstimatorListener: p}
 	transport.transport.SetSenderSideEstimatorListener(transport.senderSideListener)
 
-	dtlsListener := &overwrittenDTLSICETransportListener{}
+	dtlsListener := &overwrittenDTLSICETransportListener{transport: transport}
 	dtlsl := native.NewDirectorDTLSICETransportListener(dtlsListener)
 	dtlsListener.p = dtlsl
 
 	transport.dtlsICEListener = &goDTLSICETransportListener{DTLSICETransportListener: dtlsl}
 	transport.transport.SetListener(transport.dtlsICEListener)
 
-	var address string
-	var port int
 	for _, candidate := range remoteCandidates {
-		if candidate.GetType() == "relay" {
-			address = candidate.GetRelAddr()
-			port = candidate.GetRelPort()
-		} else {
-			address = candidate.GetAddress()
-			port = candidate.GetPort()
+		// resolved later, see SetMDNSResolver
+		if isMDNSCandidate(candidate) {
+			continue
 		}
+		address, port := candidateAddress(candidate)
 		bundle.AddRemoteCandidate(transport.username, address, uint16(port))
 	}
 
@@ -173,14 +239,15 @@ func NewTransport(bundle native.RTPBundleTransport, remoteIce *sdp.ICEInfo, remo
 	transport.incomingStreamTracks = make(map[string]*IncomingStreamTrack)
 	transport.outgoingStreamTracks = make(map[string]*OutgoingStreamTrack)
 
-	transport.onIncomingTrackListeners = make([]IncomingTrackListener, 0)
-	transport.onOutgoingTrackListeners = make([]OutgoingTrackListener, 0)
-
 	return transport
 }
 
 // Dump  dump incoming and outgoint rtp and rtcp packets into a pcap file
+// The packets are written decrypted, so the file can be analyzed with wireshark. Only one dump can run at a time, see StopDump
 func (t *Transport) Dump(filename string, incoming bool, outgoing bool, rtcp bool) bool {
+	if t.transport == nil {
+		return false
+	}
 	ret := t.transport.Dump(filename, incoming, outgoing, rtcp)
 	if ret == 0 {
 		return false
@@ -188,20 +255,36 @@ func (t *Transport) Dump(filename string, incoming bool, outgoing bool, rtcp boo
 	return true
 }
 
+// StopDump stop dumping the packets and close the pcap file, the transport can be dumped again afterwards
+func (t *Transport) StopDump() bool {
+	if t.transport == nil {
+		return false
+	}
+	return t.transport.StopDump() != 0
+}
+
 // SetBandwidthProbing Enable/Disable bitrate probing
 // This will send padding only RTX packets to allow bandwidth estimation algortithm to probe bitrate beyonf current sent values.
 // The ammoung of probing bitrate would be limited by the sender bitrate estimation and the limit set on the setMaxProbing Bitrate.
 func (t *Transport) SetBandwidthProbing(probe bool) {
-	t.transport.SetBandwidthProbing(probe)
+	t.Lock()
+	t.probing.Enabled = probe
+	t.Unlock()
+	t.transport.SetBandwidthProbing(probe && t.IsSenderSideEstimationEnabled())
 }
 
 // SetMaxProbingBitrate Set the maximum bitrate to be used if probing is enabled.
 func (t *Transport) SetMaxProbingBitrate(bitrate uint) {
+	t.Lock()
+	t.probing.MaxBitrate = bitrate
+	t.Unlock()
 	t.transport.SetMaxProbingBitrate(bitrate)
 }
 
 // GetDTLSState  get dtls state
 func (t *Transport) GetDTLSState() string {
+	t.Lock()
+	defer t.Unlock()
 	return t.dtlsState
 }
 
@@ -220,6 +303,11 @@ func (t *Transport) GetICEStats() *ICEStats {
 func (t *Transport) SetRemoteProperties(audio *sdp.MediaInfo, video *sdp.MediaInfo) {
 	properties := native.NewPropertiesFacade()
 	defer native.DeletePropertiesFacade(properties)
+
+	t.Lock()
+	t.remoteAudio = audio
+	t.remoteVideo = video
+	t.Unlock()
 	if audio != nil {
 		num := 0
 		for _, codec := range audio.GetCodecs() {
@@ -270,9 +358,24 @@ func (t *Transport) SetRemoteProperties(audio *sdp.MediaInfo, video *sdp.MediaIn
 
 }
 
+// getRemoteCodecs get the media codecs supported by the remote peer
+func (t *Transport) getRemoteCodecs(media string) []string {
+	t.Lock()
+	defer t.Unlock()
+	if media == "video" {
+		return getMediaCodecs(t.remoteVideo)
+	}
+	return getMediaCodecs(t.remoteAudio)
+}
+
 // SetLocalProperties Set local RTP properties
 func (t *Transport) SetLocalProperties(audio *sdp.MediaInfo, video *sdp.MediaInfo) {
 
+	t.Lock()
+	t.localAudio = audio
+	t.localVideo = video
+	t.Unlock()
+
 	properties := native.NewPropertiesFacade()
 	defer native.DeletePropertiesFacade(properties)
 
@@ -335,6 +438,11 @@ func (t *Transport) GetLocalICEInfo() *sdp.ICEInfo {
 	return t.localIce
 }
 
+// GetICEMode get the ICE mode this Transport was created with
+func (t *Transport) GetICEMode() ICEMode {
+	return t.iceMode
+}
+
 // GetLocalCandidates Get local ICE candidates for this Transport
 func (t *Transport) GetLocalCandidates() []*sdp.CandidateInfo {
 
@@ -343,57 +451,232 @@ func (t *Transport) GetLocalCandidates() []*sdp.CandidateInfo {
 
 // GetRemoteCandidates Get remote ICE candidates for this Transport
 func (t *Transport) GetRemoteCandidates() []*sdp.CandidateInfo {
-	return t.remoteCandidates
+	t.Lock()
+	defer t.Unlock()
+	return append([]*sdp.CandidateInfo(nil), t.remoteCandidates...)
 }
 
 // AddRemoteCandidate register a remote candidate Info. Only needed for ice-lite to ice-lite endpoints
+// Invalid candidates, and candidates over DefaultOfferLimits.MaxCandidates, are ignored
 func (t *Transport) AddRemoteCandidate(candidate *sdp.CandidateInfo) {
 
-	var address string
-	var port int
+	t.Lock()
+	max := DefaultOfferLimits.MaxCandidates
+	full := max > 0 && len(t.remoteCandidates) >= max
+	resolver := t.mdns
+	t.Unlock()
 
-	if candidate.GetType() == "relay" {
-		address = candidate.GetRelAddr()
-		port = candidate.GetRelPort()
-	} else {
-		address = candidate.GetAddress()
-		port = candidate.GetPort()
+	if full || len(DefaultOfferLimits.SanitizeCandidates([]*sdp.CandidateInfo{candidate})) == 0 {
+		return
 	}
 
+	if isMDNSCandidate(candidate) {
+		if resolver != nil {
+			t.Lock()
+			t.remoteCandidates = append(t.remoteCandidates, candidate)
+			t.Unlock()
+			go t.addMDNSCandidate(resolver, candidate)
+		}
+		return
+	}
+
+	address, port := candidateAddress(candidate)
+
 	if t.bundle.AddRemoteCandidate(t.username, address, uint16(port)) != 0 {
 		return
 	}
 
+	t.Lock()
 	t.remoteCandidates = append(t.remoteCandidates, candidate)
+	t.Unlock()
+}
+
+// SetLimits set the limits enforced on streams created in this Transport
+func (t *Transport) SetLimits(limits Limits) {
+	t.Lock()
+	defer t.Unlock()
+	t.limits = limits
+}
+
+// GetLimits get the limits enforced on streams created in this Transport
+func (t *Transport) GetLimits() Limits {
+	t.Lock()
+	defer t.Unlock()
+	return t.limits
+}
+
+// SetAutoGenerateIDs when enabled, invalid or duplicated stream and track ids passed to CreateIncomingStream and CreateOutgoingStream
+// are replaced by generated UUIDs instead of failing. Use the returned stream to get the final ids.
+func (t *Transport) SetAutoGenerateIDs(auto bool) {
+	t.Lock()
+	defer t.Unlock()
+	t.autoGenerateIDs = auto
+}
+
+func (t *Transport) isAutoGenerateIDs() bool {
+	t.Lock()
+	defer t.Unlock()
+	return t.autoGenerateIDs
+}
+
+func (t *Transport) isIncomingStreamIDTaken(id string) bool {
+	t.Lock()
+	defer t.Unlock()
+	_, ok := t.incomingStreams[id]
+	return ok
+}
+
+func (t *Transport) isOutgoingStreamIDTaken(id string) bool {
+	t.Lock()
+	defer t.Unlock()
+	_, ok := t.outgoingStreams[id]
+	return ok
+}
+
+func (t *Transport) isIncomingTrackIDTaken(id string) bool {
+	t.Lock()
+	defer t.Unlock()
+	_, ok := t.incomingStreamTracks[id]
+	return ok
+}
+
+func (t *Transport) isOutgoingTrackIDTaken(id string) bool {
+	t.Lock()
+	defer t.Unlock()
+	_, ok := t.outgoingStreamTracks[id]
+	return ok
+}
+
+func (t *Transport) registerIncomingTrack(track *IncomingStreamTrack) {
+	t.Lock()
+	defer t.Unlock()
+	t.incomingStreamTracks[track.GetID()] = track
+}
+
+func (t *Transport) unregisterIncomingTrack(track *IncomingStreamTrack) {
+	t.Lock()
+	defer t.Unlock()
+	if t.incomingStreamTracks[track.GetID()] == track {
+		delete(t.incomingStreamTracks, track.GetID())
+	}
+}
+
+func (t *Transport) registerOutgoingTrack(track *OutgoingStreamTrack) {
+	t.Lock()
+	defer t.Unlock()
+	t.outgoingStreamTracks[track.GetID()] = track
+	track.owner = t
+}
+
+func (t *Transport) unregisterOutgoingTrack(track *OutgoingStreamTrack) {
+	t.Lock()
+	defer t.Unlock()
+	if t.outgoingStreamTracks[track.GetID()] == track {
+		delete(t.outgoingStreamTracks, track.GetID())
+	}
+}
+
+// validateStreamIDs check the stream and track ids are valid and not used yet in this Transport
+func validateStreamIDs(streamInfo *sdp.StreamInfo, streamTaken func(id string) bool, trackTaken func(id string) bool) error {
+
+	if err := ValidateID(streamInfo.GetID()); err != nil {
+		return err
+	}
+
+	if streamTaken(streamInfo.GetID()) {
+		return fmt.Errorf("%w: stream %q", ErrDuplicateID, streamInfo.GetID())
+	}
+
+	for _, track := range streamInfo.GetTracks() {
+		if err := ValidateID(track.GetID()); err != nil {
+			return err
+		}
+		if trackTaken(track.GetID()) {
+			return fmt.Errorf("%w: track %q", ErrDuplicateID, track.GetID())
+		}
+	}
+	return nil
+}
+
+// ValidateIncomingStream check the stream Info ids and the Transport limits
+// Returns an error wrapping ErrInvalidID or ErrDuplicateID, or a *LimitError if a limit is exceeded
+func (t *Transport) ValidateIncomingStream(streamInfo *sdp.StreamInfo) error {
+
+	if err := validateStreamIDs(streamInfo, t.isIncomingStreamIDTaken, t.isIncomingTrackIDTaken); err != nil {
+		return err
+	}
+
+	t.Lock()
+	limits := t.limits
+	count := len(t.incomingStreams)
+	t.Unlock()
+
+	if err := checkLimit("streams", limits.MaxStreamsPerTransport, count+1); err != nil {
+		return err
+	}
+	return limits.CheckStreamInfo(streamInfo)
+}
+
+// ValidateOutgoingStream check the stream Info ids and the Transport limits
+// Returns an error wrapping ErrInvalidID or ErrDuplicateID, or a *LimitError if a limit is exceeded
+func (t *Transport) ValidateOutgoingStream(streamInfo *sdp.StreamInfo) error {
+
+	if err := validateStreamIDs(streamInfo, t.isOutgoingStreamIDTaken, t.isOutgoingTrackIDTaken); err != nil {
+		return err
+	}
+
+	t.Lock()
+	limits := t.limits
+	count := len(t.outgoingStreams)
+	t.Unlock()
+
+	if err := checkLimit("streams", limits.MaxStreamsPerTransport, count+1); err != nil {
+		return err
+	}
+	return checkLimit("tracks", limits.MaxTracksPerStream, len(streamInfo.GetTracks()))
 }
 
 // CreateOutgoingStream Create new outgoing stream in this Transport using StreamInfo
+// Returns nil if the ids are invalid or already used, or the Transport limits are exceeded, see CreateOutgoingStreamChecked
 func (t *Transport) CreateOutgoingStream(streamInfo *sdp.StreamInfo) *OutgoingStream {
+	outgoingStream, _ := t.CreateOutgoingStreamChecked(streamInfo)
+	return outgoingStream
+}
 
-	if _, ok := t.outgoingStreams[streamInfo.GetID()]; ok {
-		return nil
+// CreateOutgoingStreamChecked Create new outgoing stream like CreateOutgoingStream
+// Returns the ValidateOutgoingStream error if the stream can not be created
+func (t *Transport) CreateOutgoingStreamChecked(streamInfo *sdp.StreamInfo) (*OutgoingStream, error) {
+
+	if t.isAutoGenerateIDs() {
+		streamInfo = normalizeStreamInfo(streamInfo, t.isOutgoingStreamIDTaken, t.isOutgoingTrackIDTaken)
+	}
+
+	if err := t.ValidateOutgoingStream(streamInfo); err != nil {
+		return nil, err
 	}
 
 	info := streamInfo.Clone()
 	outgoingStream := NewOutgoingStream(t.transport, info)
 
+	outgoingStream.owner = t
+	for _, track := range outgoingStream.GetTracks() {
+		track.codecs = t.getRemoteCodecs
+		t.registerOutgoingTrack(track)
+	}
+
 	t.Lock()
 	t.outgoingStreams[outgoingStream.GetID()] = outgoingStream
 	t.Unlock()
 
 	outgoingStream.OnTrack(func(track *OutgoingStreamTrack) {
-		for _, trackFunc := range t.onOutgoingTrackListeners {
-			trackFunc(track, outgoingStream)
-		}
+		t.emitOutgoingTrack(track, outgoingStream)
 	})
 
 	for _, track := range outgoingStream.GetTracks() {
-		for _, trackFunc := range t.onOutgoingTrackListeners {
-			trackFunc(track, outgoingStream)
-		}
+		t.emitOutgoingTrack(track, outgoingStream)
 	}
 
-	return outgoingStream
+	return outgoingStream, nil
 }
 
 // CreateOutgoingStreamWithID  alias CreateOutgoingStream
@@ -418,6 +701,46 @@ func (t *Transport) CreateOutgoingStreamWithID(streamID string, audio bool, vide
 	return stream
 }
 
+// newOutgoingStreamInfo build a stream Info with new track ids and ssrcs, adding a FID group when the media capability has rtx
+func newOutgoingStreamInfo(streamID string, audioCount int, videoCount int, capabilities map[string]*sdp.Capability) *sdp.StreamInfo {
+
+	streamInfo := sdp.NewStreamInfo(streamID)
+
+	addTracks := func(media string, count int) {
+		capability := capabilities[media]
+		for n := 0; n < count; n++ {
+			track := sdp.NewTrackInfo(generateID(), media)
+			ssrc := NextSSRC()
+			track.AddSSRC(ssrc)
+			if capability != nil && capability.Rtx {
+				rtx := NextSSRC()
+				track.AddSSRC(rtx)
+				track.AddSourceGroup(sdp.NewSourceGroupInfo("FID", []uint{ssrc, rtx}))
+			}
+			streamInfo.AddTrack(track)
+		}
+	}
+
+	addTracks("audio", audioCount)
+	addTracks("video", videoCount)
+
+	return streamInfo
+}
+
+// CreateOutgoingStreamWithTracks Create new outgoing stream with audioCount audio tracks and videoCount video tracks
+// Track ids and ssrcs are allocated here, rtx ssrcs are added for the medias whose capability enables rtx.
+// Returns nil if the Transport limits are exceeded
+func (t *Transport) CreateOutgoingStreamWithTracks(audioCount int, videoCount int, capabilities map[string]*sdp.Capability) *OutgoingStream {
+
+	if audioCount < 0 || videoCount < 0 {
+		return nil
+	}
+
+	streamInfo := newOutgoingStreamInfo(generateID(), audioCount, videoCount, capabilities)
+
+	return t.CreateOutgoingStream(streamInfo)
+}
+
 // CreateOutgoingStreamTrack Create new outgoing track in this Transport
 func (t *Transport) CreateOutgoingStreamTrack(media string, trackId string, ssrcs map[string]uint) *OutgoingStreamTrack {
 
@@ -427,7 +750,11 @@ func (t *Transport) CreateOutgoingStreamTrack(media string, trackId string, ssrc
 	}
 
 	if trackId == "" {
-		trackId = uuid.Must(uuid.NewV4()).String()
+		trackId = generateID()
+	}
+
+	if ValidateID(trackId) != nil || t.isOutgoingTrackIDTaken(trackId) {
+		return nil
 	}
 
 	source := native.NewRTPOutgoingSourceGroup(mediaType)
@@ -453,29 +780,48 @@ func (t *Transport) CreateOutgoingStreamTrack(media string, trackId string, ssrc
 	// todo error handle
 	t.transport.AddOutgoingSourceGroup(source)
 
+	atomic.AddInt64(&numOutgoingSourceGroups, 1)
+
 	outgoingTrack := newOutgoingStreamTrack(media, trackId, native.TransportToSender(t.transport), source)
+	outgoingTrack.soak = soakTrack(soakOutgoingSourceGroups, 1)
+	outgoingTrack.codecs = t.getRemoteCodecs
+	t.registerOutgoingTrack(outgoingTrack)
 
-	for _, trackFunc := range t.onOutgoingTrackListeners {
-		trackFunc(outgoingTrack, nil)
-	}
+	t.emitOutgoingTrack(outgoingTrack, nil)
 
 	return outgoingTrack
 }
 
 // CreateIncomingStream Create an incoming stream object from the Media stream Info objet
+// Returns nil if the ids are invalid or already used, or the Transport limits are exceeded, see CreateIncomingStreamChecked
 func (t *Transport) CreateIncomingStream(streamInfo *sdp.StreamInfo) *IncomingStream {
+	incomingStream, _ := t.CreateIncomingStreamChecked(streamInfo)
+	return incomingStream
+}
 
-	if _, ok := t.incomingStreams[streamInfo.GetID()]; ok {
-		return nil
+// CreateIncomingStreamChecked Create an incoming stream like CreateIncomingStream
+// Returns the ValidateIncomingStream error if the stream can not be created
+func (t *Transport) CreateIncomingStreamChecked(streamInfo *sdp.StreamInfo) (*IncomingStream, error) {
+
+	if t.isAutoGenerateIDs() {
+		streamInfo = normalizeStreamInfo(streamInfo, t.isIncomingStreamIDTaken, t.isIncomingTrackIDTaken)
 	}
 
-	incomingStream := newIncomingStream(t.transport, native.TransportToReceiver(t.transport), streamInfo)
+	if err := t.ValidateIncomingStream(streamInfo); err != nil {
+		return nil, err
+	}
+
+	incomingStream := newIncomingStream(t.transport, native.TransportToReceiver(t.transport), streamInfo, t)
 
 	t.Lock()
 	t.incomingStreams[incomingStream.GetID()] = incomingStream
 	t.Unlock()
 
-	return incomingStream
+	t.incomingStreamListeners.emit(func(listener interface{}) {
+		listener.(func(*IncomingStream))(incomingStream)
+	})
+
+	return incomingStream, nil
 }
 
 // CreateIncomingStreamTrack Create new incoming stream in this Transport. TODO: Simulcast is still not supported
@@ -488,7 +834,11 @@ func (t *Transport) CreateIncomingStreamTrack(media string, trackId string, ssrc
 	}
 
 	if trackId == "" {
-		trackId = uuid.Must(uuid.NewV4()).String()
+		trackId = generateID()
+	}
+
+	if ValidateID(trackId) != nil || t.isIncomingTrackIDTaken(trackId) {
+		return nil
 	}
 
 	source := native.NewRTPIncomingSourceGroup(mediaType, t.transport.GetTimeService())
@@ -516,21 +866,44 @@ func (t *Transport) CreateIncomingStreamTrack(media string, trackId string, ssrc
 	sources := map[string]native.RTPIncomingSourceGroup{"": source}
 
 	incomingTrack := NewIncomingStreamTrack(media, trackId, native.TransportToReceiver(t.transport), sources)
+	incomingTrack.codecs = t.getRemoteCodecs
+	t.registerIncomingTrack(incomingTrack)
 
-	for _, trackFunc := range t.onIncomingTrackListeners {
-		trackFunc(incomingTrack, nil)
-	}
+	t.emitIncomingTrack(incomingTrack, nil)
 
 	return incomingTrack
 }
 
+// RemoveIncomingStream remove the incoming stream and its tracks from this Transport
 func (t *Transport) RemoveIncomingStream(incomingStream *IncomingStream) {
 
+	for _, track := range incomingStream.GetTracks() {
+		t.unregisterIncomingTrack(track)
+	}
+
 	t.Lock()
 	delete(t.incomingStreams, incomingStream.GetID())
 	t.Unlock()
 }
 
+// removeIncomingStream forget a stopped incoming stream, its tracks are already unregistered
+func (t *Transport) removeIncomingStream(incomingStream *IncomingStream) {
+	t.Lock()
+	defer t.Unlock()
+	if t.incomingStreams[incomingStream.GetID()] == incomingStream {
+		delete(t.incomingStreams, incomingStream.GetID())
+	}
+}
+
+// removeOutgoingStream forget a stopped outgoing stream, its tracks are already unregistered
+func (t *Transport) removeOutgoingStream(outgoingStream *OutgoingStream) {
+	t.Lock()
+	defer t.Unlock()
+	if t.outgoingStreams[outgoingStream.GetID()] == outgoingStream {
+		delete(t.outgoingStreams, outgoingStream.GetID())
+	}
+}
+
 // GetIncomingStreams get all incoming streams
 func (t *Transport) GetIncomingStreams() []*IncomingStream {
 	incomings := []*IncomingStream{}
@@ -564,17 +937,25 @@ func (t *Transport) GetOutgoingStream(streamId string) *OutgoingStream {
 }
 
 // OnIncomingTrack register incoming track
-func (t *Transport) OnIncomingTrack(listener IncomingTrackListener) {
-	t.Lock()
-	defer t.Unlock()
-	t.onIncomingTrackListeners = append(t.onIncomingTrackListeners, listener)
+func (t *Transport) OnIncomingTrack(listener IncomingTrackListener) ListenerOff {
+	return t.onIncomingTrackListeners.on(listener)
 }
 
 // OnOutgoingTrack register outgoing track
-func (t *Transport) OnOutgoingTrack(listener OutgoingTrackListener) {
-	t.Lock()
-	defer t.Unlock()
-	t.onOutgoingTrackListeners = append(t.onOutgoingTrackListeners, listener)
+func (t *Transport) OnOutgoingTrack(listener OutgoingTrackListener) ListenerOff {
+	return t.onOutgoingTrackListeners.on(listener)
+}
+
+func (t *Transport) emitIncomingTrack(track *IncomingStreamTrack, stream *IncomingStream) {
+	t.onIncomingTrackListeners.emit(func(listener interface{}) {
+		listener.(IncomingTrackListener)(track, stream)
+	})
+}
+
+func (t *Transport) emitOutgoingTrack(track *OutgoingStreamTrack, stream *OutgoingStream) {
+	t.onOutgoingTrackListeners.emit(func(listener interface{}) {
+		listener.(OutgoingTrackListener)(track, stream)
+	})
 }
 
 // OnDTLSICEState  OnDTLSICEState
@@ -584,6 +965,16 @@ func (t *Transport) OnDTLSICEState(listener DTLSStateListener) {
 	t.outDTLSStateListener = listener
 }
 
+// addIncomingStreamListener internal listeners called when an incoming stream is created
+func (t *Transport) addIncomingStreamListener(listener func(*IncomingStream)) ListenerOff {
+	return t.incomingStreamListeners.on(listener)
+}
+
+// addDTLSStateListener internal listeners, not replaced by OnDTLSICEState
+func (t *Transport) addDTLSStateListener(listener DTLSStateListener) ListenerOff {
+	return t.dtlsStateListeners.on(listener)
+}
+
 func (t *Transport) GetLastActiveTime() uint64 {
 
 	return t.transport.GetLastActiveTime()
@@ -592,18 +983,38 @@ func (t *Transport) GetLastActiveTime() uint64 {
 // Stop stop this Transport
 func (t *Transport) Stop() {
 
-	if t.bundle == nil {
+	// the endpoint may stop it from a Watchdog while the application does
+	t.Lock()
+	if t.bundle == nil || t.stopping {
+		t.Unlock()
 		return
 	}
-
+	t.stopping = true
+	// the streams remove themselves from the maps when stopped
+	incomings := make([]*IncomingStream, 0, len(t.incomingStreams))
 	for _, incoming := range t.incomingStreams {
+		incomings = append(incomings, incoming)
+	}
+	outgoings := make([]*OutgoingStream, 0, len(t.outgoingStreams))
+	for _, outgoing := range t.outgoingStreams {
+		outgoings = append(outgoings, outgoing)
+	}
+	t.Unlock()
+
+	t.stopStateMonitor()
+	t.stopSenderReports()
+	t.DisableREMB()
+
+	for _, incoming := range incomings {
 		incoming.Stop()
 	}
 
-	for _, outgoing := range t.outgoingStreams {
+	for _, outgoing := range outgoings {
 		outgoing.Stop()
 	}
 
+	t.DisablePacing()
+
 	if t.senderSideListener != nil {
 		t.senderSideListener.deleteSenderSideEstimatorListener()
 		t.senderSideListener = nil
@@ -616,13 +1027,22 @@ func (t *Transport) Stop() {
 
 	t.bundle.RemoveICETransport(t.username)
 
+	atomic.AddInt64(&numTransports, -1)
+	t.soak.release()
+
 	t.incomingStreams = nil
 	t.outgoingStreams = nil
+	t.incomingStreamTracks = nil
+	t.outgoingStreamTracks = nil
 
+	t.Lock()
 	t.connection = nil
 	t.transport = nil
-
 	t.username = ""
 	t.bundle = nil
+	t.Unlock()
 
+	if t.endpoint != nil {
+		t.endpoint.unregisterTransport(t)
+	}
 }
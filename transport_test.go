@@ -3,6 +3,7 @@ package mediaserver
 import (
 	"fmt"
 	"log"
+	"sync"
 	"testing"
 
 	"github.com/notedit/sdp"
@@ -183,6 +184,10 @@ func Test_TransportStop(t *testing.T) {
 	transport := endpoint.CreateTransport(sdpInfo, nil)
 
 	transport.Stop()
+
+	if transport.Dump("stopped.pcap", true, true, true) || transport.StopDump() {
+		t.Error("expected no dump of a stopped transport")
+	}
 }
 
 func Test_TransportCreateStream(t *testing.T) {
@@ -211,3 +216,44 @@ func Test_TransportCreateStream(t *testing.T) {
 	transport.Stop()
 
 }
+
+func Test_WithPublicIP(t *testing.T) {
+
+	host := sdp.NewCandidateInfo("1", 1, "UDP", 33554431, "10.0.0.1", 40000, "host", "", 0)
+	relay := sdp.NewCandidateInfo("2", 1, "UDP", 16777215, "198.51.100.1", 3478, "relay", "10.0.0.1", 40000)
+
+	candidates := withPublicIP([]*sdp.CandidateInfo{host, relay}, "203.0.113.7")
+
+	if candidates[0].GetAddress() != "203.0.113.7" || candidates[0].GetPort() != 40000 {
+		t.Fatalf("expected the host candidate at 203.0.113.7:40000, got %s:%d", candidates[0].GetAddress(), candidates[0].GetPort())
+	}
+	if candidates[1] != relay {
+		t.Fatal("expected the relay candidate to be kept")
+	}
+	if host.GetAddress() != "10.0.0.1" {
+		t.Fatal("expected the endpoint candidate to be left untouched")
+	}
+}
+
+func Test_AddRemoteCandidateConcurrent(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	defer transport.Stop()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			transport.AddRemoteCandidate(sdp.NewCandidateInfo(fmt.Sprint(i), 1, "UDP", 33554431, fmt.Sprintf("10.0.0.%d", i+1), 40000, "host", "", 0))
+			transport.GetRemoteCandidates()
+		}(i)
+	}
+	wg.Wait()
+
+	if candidates := transport.GetRemoteCandidates(); len(candidates) != 4 {
+		t.Error("expected the candidates added", len(candidates))
+	}
+}
@@ -0,0 +1,98 @@
+package mediaserver
+
+import (
+	"time"
+)
+
+// TransportCCStats sender side congestion control stats computed from the transport-cc feedback of the remote peer
+type TransportCCStats struct {
+	// EstimatedBitrate target bitrate in bps of the sender side estimation, zero before the first feedback
+	EstimatedBitrate uint
+	// RTT round trip time in ms
+	RTT uint
+	// Feedbacks transport-cc feedbacks processed by the estimation
+	Feedbacks uint
+	// FeedbackInterval smoothed interval between the feedbacks
+	FeedbackInterval time.Duration
+	// RetransmissionRatio rtx packets sent per media packet sent by the outgoing tracks, the loss reported
+	// by the remote peer with NACKs, as the native estimation does not expose the transport-cc loss
+	RetransmissionRatio float64
+}
+
+// TransportStats stats of a transport and all its tracks
+type TransportStats struct {
+	ICE         *ICEStats
+	Feedback    *FeedbackStats
+	TransportCC *TransportCCStats
+	// SRTPProfile negotiated, empty when not known, see GetSRTPProtectionProfile
+	SRTPProfile string
+}
+
+// TransportCCStatsListener called with the congestion control stats of the transport
+type TransportCCStatsListener func(stats *TransportCCStats)
+
+// onFeedback update the feedback counters, called locked for each estimation update of the native transport
+func (t *Transport) onFeedback(now time.Time) {
+
+	if !t.lastFeedback.IsZero() {
+		interval := now.Sub(t.lastFeedback)
+		if t.feedbackInterval == 0 {
+			t.feedbackInterval = interval
+		} else {
+			// smoothed like the rtt of RFC 6298
+			t.feedbackInterval += (interval - t.feedbackInterval) / 8
+		}
+	}
+	t.feedbacks++
+	t.lastFeedback = now
+}
+
+// GetTransportCCStats get the sender side congestion control stats
+func (t *Transport) GetTransportCCStats() *TransportCCStats {
+
+	var media, rtx uint
+	for _, stream := range t.GetOutgoingStreams() {
+		for _, track := range stream.GetTracks() {
+			stats := track.GetStats()
+			media += stats.Media.NumPackets
+			rtx += stats.Rtx.NumPackets
+		}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	stats := &TransportCCStats{
+		EstimatedBitrate: t.targetBitrate,
+		Feedbacks:        t.feedbacks,
+		FeedbackInterval: t.feedbackInterval,
+	}
+
+	if t.transport != nil {
+		stats.RTT = t.transport.GetRTT()
+	}
+
+	if media > 0 {
+		stats.RetransmissionRatio = float64(rtx) / float64(media)
+	}
+	return stats
+}
+
+// GetStats get the stats of the transport, see GetICEStats, GetFeedbackStats and GetTransportCCStats
+func (t *Transport) GetStats() *TransportStats {
+
+	ice := *t.GetICEStats()
+
+	return &TransportStats{
+		ICE:         &ice,
+		Feedback:    t.GetFeedbackStats(),
+		TransportCC: t.GetTransportCCStats(),
+		SRTPProfile: t.GetSRTPProtectionProfile(),
+	}
+}
+
+// OnTransportCCStats register a listener called every second with the congestion control stats,
+// so scheduling decisions can follow the estimation without polling
+func (t *Transport) OnTransportCCStats(listener TransportCCStatsListener) ListenerOff {
+	return t.getStateMonitor().transportCCListeners.on(listener)
+}
@@ -0,0 +1,272 @@
+package mediaserver
+
+import (
+	"sync"
+	"time"
+)
+
+// TransportState connection state of a Transport
+type TransportState string
+
+// Transport states
+const (
+	TransportStateNew          TransportState = "new"
+	TransportStateConnecting   TransportState = "connecting"
+	TransportStateConnected    TransportState = "connected"
+	TransportStateDisconnected TransportState = "disconnected"
+	TransportStateFailed       TransportState = "failed"
+	TransportStateClosed       TransportState = "closed"
+)
+
+// TransportStateListener called when the transport state changes
+type TransportStateListener func(state TransportState)
+
+// default time without ICE checks from the remote peer before it is considered disconnected or failed,
+// browsers send consent checks every 5s
+const (
+	defaultDisconnectedTimeout = 10 * time.Second
+	defaultFailedTimeout       = 30 * time.Second
+	transportStateInterval     = time.Second
+)
+
+// transportStateMonitor derive the transport state from the native DTLS state and the ICE activity of the remote peer
+type transportStateMonitor struct {
+	transport     *Transport
+	state         TransportState
+	dtls          string
+	checks        int64
+	lastActivity  time.Time
+	disconnected  time.Duration
+	failed        time.Duration
+	listeners     emitter
+	inactivity    *inactivityTimer
+	rembs         uint
+	rembListeners emitter
+	ticker        *time.Ticker
+	stop          chan struct{}
+
+	transportCCListeners emitter
+	nackStorm            *nackStormBreaker
+	sync.Mutex
+}
+
+func newTransportStateMonitor(transport *Transport) *transportStateMonitor {
+
+	monitor := &transportStateMonitor{
+		transport:    transport,
+		state:        TransportStateNew,
+		lastActivity: time.Now(),
+		disconnected: defaultDisconnectedTimeout,
+		failed:       defaultFailedTimeout,
+		ticker:       time.NewTicker(transportStateInterval),
+		stop:         make(chan struct{}),
+	}
+
+	ticker := monitor.ticker
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				monitor.poll()
+			case <-monitor.stop:
+				return
+			}
+		}
+	}()
+
+	return monitor
+}
+
+// iceChecks count of the checks and responses received from the remote peer
+func (m *transportStateMonitor) iceChecks() int64 {
+	stats := m.transport.GetICEStats()
+	return stats.RequestsReceived + stats.ResponsesReceived
+}
+
+// poll check the ICE activity, called with the transport running
+func (m *transportStateMonitor) poll() {
+
+	m.Lock()
+	if m.ticker == nil {
+		m.Unlock()
+		return
+	}
+
+	now := time.Now()
+	if checks := m.iceChecks(); checks != m.checks {
+		m.checks = checks
+		m.lastActivity = now
+	}
+
+	var event *InactivityEvent
+	inactivity := m.inactivity
+	if inactivity != nil {
+		event = inactivity.check(m.transport, m.lastActivity, now)
+	}
+
+	var remb uint
+	if m.rembListeners.len() > 0 {
+		if rembs, bitrate := m.transport.receivedREMBs(); rembs != m.rembs {
+			m.rembs = rembs
+			remb = bitrate
+		}
+	}
+
+	transportCC := m.transportCCListeners.len() > 0
+
+	var stormActions []nackStormAction
+	nackStorm := m.nackStorm
+	if nackStorm != nil {
+		stormActions = nackStorm.check(m.transport, now)
+	}
+
+	m.update(now)
+
+	if len(stormActions) > 0 {
+		nackStorm.apply(stormActions)
+	}
+
+	if transportCC {
+		stats := m.transport.GetTransportCCStats()
+		m.transportCCListeners.emit(func(listener interface{}) {
+			listener.(TransportCCStatsListener)(stats)
+		})
+	}
+
+	if event != nil {
+		inactivity.fire(m.transport, event)
+	}
+
+	if remb > 0 {
+		m.rembListeners.emit(func(listener interface{}) {
+			listener.(REMBListener)(remb)
+		})
+	}
+}
+
+// onDTLSState update with the native DTLS state
+func (m *transportStateMonitor) onDTLSState(dtls string) {
+
+	m.Lock()
+	if m.ticker == nil {
+		m.Unlock()
+		return
+	}
+
+	m.dtls = dtls
+	if dtls == "connected" {
+		m.lastActivity = time.Now()
+	}
+	m.update(time.Now())
+}
+
+// update compute the new state and call the listeners if it changed, called locked and unlocks
+func (m *transportStateMonitor) update(now time.Time) {
+
+	state := m.state
+	inactive := now.Sub(m.lastActivity)
+
+	switch {
+	case m.dtls == "failed":
+		state = TransportStateFailed
+	case m.dtls == "closed":
+		state = TransportStateClosed
+	case state == TransportStateFailed:
+	case inactive >= m.failed:
+		state = TransportStateFailed
+	case m.dtls == "connected" && inactive >= m.disconnected:
+		state = TransportStateDisconnected
+	case m.dtls == "connected":
+		state = TransportStateConnected
+	case m.dtls == "connecting" || m.checks > 0:
+		state = TransportStateConnecting
+	}
+
+	m.emit(state)
+}
+
+// emit set the state and call the listeners if it changed, called locked and unlocks
+func (m *transportStateMonitor) emit(state TransportState) {
+
+	changed := state != m.state
+	m.state = state
+	m.Unlock()
+
+	if !changed {
+		return
+	}
+
+	m.listeners.emit(func(listener interface{}) {
+		listener.(TransportStateListener)(state)
+	})
+}
+
+// close stop polling and emit the closed state, it waits for a poll in progress
+func (m *transportStateMonitor) close() {
+
+	m.Lock()
+	if m.ticker == nil {
+		m.Unlock()
+		return
+	}
+	m.ticker.Stop()
+	m.ticker = nil
+	close(m.stop)
+	m.emit(TransportStateClosed)
+}
+
+// getStateMonitor get the state monitor, starting it on first use
+func (t *Transport) getStateMonitor() *transportStateMonitor {
+
+	t.Lock()
+	if t.stateMonitor != nil {
+		monitor := t.stateMonitor
+		t.Unlock()
+		return monitor
+	}
+	if t.bundle == nil {
+		t.stateMonitor = &transportStateMonitor{transport: t, state: TransportStateClosed}
+		t.Unlock()
+		return t.stateMonitor
+	}
+	monitor := newTransportStateMonitor(t)
+	t.stateMonitor = monitor
+	t.Unlock()
+
+	t.addDTLSStateListener(monitor.onDTLSState)
+	monitor.onDTLSState(t.GetDTLSState())
+
+	return monitor
+}
+
+// OnStateChange register a listener of the transport connection state.
+// The remote peer is disconnected when no ICE check is received for 10s and failed after 30s, see SetStateTimeouts
+func (t *Transport) OnStateChange(listener TransportStateListener) ListenerOff {
+	return t.getStateMonitor().listeners.on(listener)
+}
+
+// GetState get the transport connection state
+func (t *Transport) GetState() TransportState {
+	monitor := t.getStateMonitor()
+	monitor.Lock()
+	defer monitor.Unlock()
+	return monitor.state
+}
+
+// SetStateTimeouts set the time without ICE checks from the remote peer before it is considered disconnected or failed
+func (t *Transport) SetStateTimeouts(disconnected, failed time.Duration) {
+	monitor := t.getStateMonitor()
+	monitor.Lock()
+	monitor.disconnected = disconnected
+	monitor.failed = failed
+	monitor.Unlock()
+}
+
+func (t *Transport) stopStateMonitor() {
+	t.Lock()
+	monitor := t.stateMonitor
+	t.Unlock()
+	if monitor != nil {
+		monitor.close()
+	}
+}
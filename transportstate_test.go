@@ -0,0 +1,56 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_TransportStateMonitor(t *testing.T) {
+
+	states := []TransportState{}
+	start := time.Now()
+
+	monitor := &transportStateMonitor{
+		state:        TransportStateNew,
+		lastActivity: start,
+		disconnected: 10 * time.Second,
+		failed:       30 * time.Second,
+	}
+	monitor.listeners.on(TransportStateListener(func(state TransportState) { states = append(states, state) }))
+
+	step := func(dtls string, checks int64, at time.Duration) {
+		monitor.Lock()
+		monitor.dtls = dtls
+		if checks != monitor.checks {
+			monitor.checks = checks
+			monitor.lastActivity = start.Add(at)
+		}
+		monitor.update(start.Add(at))
+	}
+
+	step("new", 0, 0)
+	step("connecting", 1, time.Second)
+	step("connected", 2, 2*time.Second)
+	step("connected", 2, 13*time.Second)
+	step("connected", 3, 14*time.Second)
+	step("connected", 3, 50*time.Second)
+	step("connected", 4, 51*time.Second)
+
+	expected := []TransportState{
+		TransportStateConnecting,
+		TransportStateConnected,
+		TransportStateDisconnected,
+		TransportStateConnected,
+		TransportStateFailed,
+	}
+
+	if len(states) != len(expected) {
+		t.Fatal("unexpected states", states)
+	}
+	for i := range expected {
+		if states[i] != expected[i] {
+			t.Error("unexpected states", states)
+			break
+		}
+	}
+}
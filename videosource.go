@@ -0,0 +1,80 @@
+package mediaserver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/notedit/sdp"
+)
+
+// videoSourceEncoding encoding of the video generated by ffmpeg for the still and text sources
+type videoSourceEncoding struct {
+	Path    string
+	Codec   string
+	Width   int
+	Height  int
+	FPS     int
+	Bitrate int
+}
+
+var videoSourceEncoders = map[string][]string{
+	"vp8":  {"-c:v", "libvpx", "-deadline", "realtime", "-cpu-used", "8"},
+	"h264": {"-c:v", "libx264", "-preset", "ultrafast", "-tune", "stillimage", "-profile:v", "baseline"},
+}
+
+// setDefaults set the codec, size and bitrate not configured
+func (e *videoSourceEncoding) setDefaults() {
+	if e.Codec == "" {
+		e.Codec = "vp8"
+	}
+	if e.Width == 0 || e.Height == 0 {
+		e.Width, e.Height = 1280, 720
+	}
+	if e.Bitrate == 0 {
+		e.Bitrate = 300
+	}
+}
+
+// newProcess create the ffmpeg process encoding the input after the filter,
+// its rtp output is received by the incoming track of the process
+func (e *videoSourceEncoding) newProcess(input []string, filter string) (*FFmpegProcess, error) {
+
+	e.setDefaults()
+
+	encoder, ok := videoSourceEncoders[e.Codec]
+	if !ok {
+		return nil, fmt.Errorf("unsupported video source codec %s", e.Codec)
+	}
+
+	media := sdp.NewMediaInfo("video", "video")
+	codec := sdp.NewCodecInfo(e.Codec, 96)
+	if e.Codec == "h264" {
+		codec.AddParam("packetization-mode", "1")
+	}
+	media.AddCodec(codec)
+
+	process, err := NewFFmpegProcess(FFmpegConfig{
+		Path:      e.Path,
+		Medias:    []*sdp.MediaInfo{media},
+		InputArgs: input,
+		Restart:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"-an", "-vf", filter + ",format=yuv420p"}
+	args = append(args, encoder...)
+	// One keyframe per second so new viewers do not wait
+	args = append(args, "-b:v", strconv.Itoa(e.Bitrate)+"k", "-g", strconv.Itoa(e.FPS),
+		"-payload_type", "96", "-f", "rtp", fmt.Sprintf("rtp://127.0.0.1:%d?pkt_size=1200", process.GetLocalPort("video")))
+	process.config.OutputArgs = args
+
+	return process, nil
+}
+
+// escapeFilterValue escape a value used in an ffmpeg filter option
+func escapeFilterValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`, `:`, `\:`).Replace(value)
+}
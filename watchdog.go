@@ -0,0 +1,219 @@
+package mediaserver
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	native "github.com/notedit/media-server-go/wrapper"
+)
+
+// WatchdogAction action taken by the Watchdog when the endpoint loop is stalled
+type WatchdogAction int
+
+const (
+	// WatchdogNotify only call the stall listeners
+	WatchdogNotify WatchdogAction = iota
+	// WatchdogIsolate stop the stalled endpoint, all its transports are stopped and it does not accept new ones
+	WatchdogIsolate
+	// WatchdogRestart replace the stalled endpoint loop with a new one listening on a new port, all its transports are stopped
+	WatchdogRestart
+)
+
+func (a WatchdogAction) String() string {
+	switch a {
+	case WatchdogIsolate:
+		return "isolate"
+	case WatchdogRestart:
+		return "restart"
+	}
+	return "notify"
+}
+
+// WatchdogEvent diagnostics of a stalled endpoint loop
+type WatchdogEvent struct {
+	Endpoint *Endpoint
+	// Stalled time the probe task has been waiting to be serviced
+	Stalled time.Duration
+	// Action taken by the watchdog
+	Action     WatchdogAction
+	Goroutines int
+	MemStats   *NativeMemStats
+	Time       time.Time
+	// Err error applying the endpoint socket options, ie the DSCP or the interface, to the restarted endpoint
+	Err error
+}
+
+// WatchdogListener called when the endpoint loop stalls or recovers
+type WatchdogListener func(event *WatchdogEvent)
+
+// Watchdog detect when the native loop of an Endpoint stops servicing its timers, ie deadlock or overload
+// A probe task is posted to the loop every interval, the loop is stalled when it is not run within threshold
+type Watchdog struct {
+	endpoint  *Endpoint
+	probe     native.TimeServiceProbe
+	interval  time.Duration
+	threshold time.Duration
+	action    WatchdogAction
+	stalled   bool
+	onStall   []WatchdogListener
+	onRecover []WatchdogListener
+	ticker    *time.Ticker
+	stop      chan struct{}
+	sync.Mutex
+}
+
+// NewWatchdog create and start a watchdog for the endpoint loop
+func NewWatchdog(endpoint *Endpoint, interval time.Duration, threshold time.Duration) *Watchdog {
+
+	watchdog := &Watchdog{
+		endpoint:  endpoint,
+		probe:     native.NewTimeServiceProbe(endpoint.bundle.GetTimeService()),
+		interval:  interval,
+		threshold: threshold,
+		ticker:    time.NewTicker(interval),
+		stop:      make(chan struct{}),
+	}
+
+	go watchdog.run()
+
+	return watchdog
+}
+
+// SetAction set the action taken when the loop is stalled, WatchdogNotify by default
+func (w *Watchdog) SetAction(action WatchdogAction) {
+	w.Lock()
+	w.action = action
+	w.Unlock()
+}
+
+// OnStall register a listener called once each time the loop stalls, it is a critical event
+func (w *Watchdog) OnStall(listener WatchdogListener) {
+	w.Lock()
+	w.onStall = append(w.onStall, listener)
+	w.Unlock()
+}
+
+// OnRecover register a listener called when a stalled loop services its timers again
+func (w *Watchdog) OnRecover(listener WatchdogListener) {
+	w.Lock()
+	w.onRecover = append(w.onRecover, listener)
+	w.Unlock()
+}
+
+// IsStalled check if the endpoint loop is currently stalled
+func (w *Watchdog) IsStalled() bool {
+	w.Lock()
+	defer w.Unlock()
+	return w.stalled
+}
+
+func (w *Watchdog) run() {
+
+	for {
+		select {
+		case <-w.ticker.C:
+			w.check()
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+func (w *Watchdog) check() {
+
+	w.Lock()
+
+	if w.probe == nil {
+		w.Unlock()
+		return
+	}
+
+	pending := time.Duration(w.probe.GetPendingTime()) * time.Millisecond
+
+	if pending < w.threshold {
+		recovered := w.stalled
+		w.stalled = false
+		w.probe.Ping()
+		listeners := w.onRecover
+		w.Unlock()
+
+		if recovered {
+			event := w.event(pending, WatchdogNotify)
+			for _, listener := range listeners {
+				listener(event)
+			}
+		}
+		return
+	}
+
+	if w.stalled {
+		w.Unlock()
+		return
+	}
+
+	w.stalled = true
+	action := w.action
+	listeners := w.onStall
+
+	// the probe task may never run on a dead loop, the probe state is kept alive by the task itself
+	if action != WatchdogNotify {
+		native.DeleteTimeServiceProbe(w.probe)
+		w.probe = nil
+	}
+	w.Unlock()
+
+	event := w.event(pending, action)
+
+	switch action {
+	case WatchdogIsolate:
+		w.endpoint.isolate(nil)
+	case WatchdogRestart:
+		restarted, err := w.endpoint.restart()
+		event.Err = err
+		if restarted {
+			w.Lock()
+			w.stalled = false
+			w.probe = native.NewTimeServiceProbe(w.endpoint.bundle.GetTimeService())
+			w.Unlock()
+		}
+	}
+
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+func (w *Watchdog) event(stalled time.Duration, action WatchdogAction) *WatchdogEvent {
+	return &WatchdogEvent{
+		Endpoint:   w.endpoint,
+		Stalled:    stalled,
+		Action:     action,
+		Goroutines: runtime.NumGoroutine(),
+		MemStats:   MemStats(),
+		Time:       time.Now(),
+	}
+}
+
+// Stop stop the watchdog, the endpoint is not stopped
+func (w *Watchdog) Stop() {
+
+	w.Lock()
+	defer w.Unlock()
+
+	if w.ticker == nil {
+		return
+	}
+
+	w.ticker.Stop()
+	w.ticker = nil
+	close(w.stop)
+
+	if w.probe != nil {
+		native.DeleteTimeServiceProbe(w.probe)
+		w.probe = nil
+	}
+
+	w.onStall = nil
+	w.onRecover = nil
+}
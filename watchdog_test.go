@@ -0,0 +1,78 @@
+package mediaserver
+
+import (
+	"testing"
+	"time"
+
+	native "github.com/notedit/media-server-go/wrapper"
+	"github.com/notedit/sdp"
+)
+
+func newTestTransport(endpoint *Endpoint) *Transport {
+	sdpInfo := sdp.NewSDPInfo()
+	sdpInfo.SetICE(sdp.ICEInfoGenerate(true))
+	sdpInfo.SetDTLS(sdp.NewDTLSInfo(sdp.SETUPACTPASS, "sha-256", "F2:AA:0E:C3:22:59:5E:14:95:69:92:3D:13:B4:84:24:2C:C2:A2:C0:3E:FD:34:8E:5E:EA:6F:AF:52:CE:E6:0F"))
+	return endpoint.CreateTransport(sdpInfo, nil)
+}
+
+func isStopped(transport *Transport) bool {
+	transport.Lock()
+	defer transport.Unlock()
+	return transport.bundle == nil
+}
+
+func waitReleased(t *testing.T, done chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("bundle not released")
+	}
+}
+
+func Test_EndpointIsolate(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	transports := []*Transport{newTestTransport(endpoint), newTestTransport(endpoint)}
+
+	done := make(chan struct{})
+	endpoint.isolate(func() { close(done) })
+	waitReleased(t, done)
+
+	for _, transport := range transports {
+		if !isStopped(transport) {
+			t.Fatal("expected the transports to be stopped before the bundle is released")
+		}
+		// stopping again must not touch the released bundle
+		transport.Stop()
+	}
+
+	if !endpoint.IsIsolated() || len(endpoint.transports) != 0 {
+		t.Fatalf("expected an isolated endpoint without transports, got %d", len(endpoint.transports))
+	}
+}
+
+func Test_EndpointRestart(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	old := newTestTransport(endpoint)
+
+	done := make(chan struct{})
+	endpoint.Lock()
+	endpoint.replaceBundle(native.NewRTPBundleTransport(), func() { close(done) })
+	endpoint.Unlock()
+	waitReleased(t, done)
+
+	if !isStopped(old) {
+		t.Fatal("expected the transport of the replaced bundle to be stopped")
+	}
+
+	transport := newTestTransport(endpoint)
+	if isStopped(transport) || !endpoint.transports[transport] || endpoint.transports[old] {
+		t.Fatal("expected only the new transport to be registered")
+	}
+
+	endpoint.Stop()
+	if !isStopped(transport) {
+		t.Fatal("expected Stop to stop the transports of the endpoint")
+	}
+}
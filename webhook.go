@@ -0,0 +1,247 @@
+package mediaserver
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Webhook event types
+const (
+	WebhookStreamStarted     = "stream.started"
+	WebhookStreamEnded       = "stream.ended"
+	WebhookRecordingFinished = "recording.finished"
+	WebhookTransportFailed   = "transport.failed"
+)
+
+// ErrWebhookQueueFull is returned by Send when the events can not be delivered as fast as they are emitted
+var ErrWebhookQueueFull = errors.New("webhook queue full")
+
+// ErrWebhookStopped is returned by Send after Stop
+var ErrWebhookStopped = errors.New("webhook dispatcher stopped")
+
+// WebhookEvent body posted as json to the webhook url
+type WebhookEvent struct {
+	Type      string            `json:"type"`
+	Time      time.Time         `json:"time"`
+	Transport string            `json:"transport,omitempty"`
+	Stream    string            `json:"stream,omitempty"`
+	Filename  string            `json:"filename,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// WebhookErrorListener called when an event could not be delivered after all the retries
+type WebhookErrorListener func(event *WebhookEvent, err error)
+
+// WebhookDispatcher post lifecycle events to an url, in order and in background
+// Each request is signed with the X-Webhook-Signature header, see SignWebhook
+type WebhookDispatcher struct {
+	url        string
+	secret     []byte
+	client     *http.Client
+	retries    int
+	retryDelay time.Duration
+	queue      chan *WebhookEvent
+	done       chan struct{}
+	stopped    bool
+	onError    []WebhookErrorListener
+	sync.Mutex
+}
+
+// NewWebhookDispatcher create and start a dispatcher posting to url, the secret is used to sign the requests
+func NewWebhookDispatcher(url string, secret string) *WebhookDispatcher {
+
+	dispatcher := &WebhookDispatcher{
+		url:        url,
+		secret:     []byte(secret),
+		client:     &http.Client{Timeout: 5 * time.Second},
+		retries:    3,
+		retryDelay: time.Second,
+		queue:      make(chan *WebhookEvent, 256),
+		done:       make(chan struct{}),
+	}
+
+	go dispatcher.run()
+
+	return dispatcher
+}
+
+// SetRetries set how many times a failed delivery is retried, the delay doubles after each retry
+func (w *WebhookDispatcher) SetRetries(retries int, delay time.Duration) {
+	w.Lock()
+	w.retries = retries
+	w.retryDelay = delay
+	w.Unlock()
+}
+
+// OnError register a listener called when an event is dropped
+func (w *WebhookDispatcher) OnError(listener WebhookErrorListener) {
+	w.Lock()
+	w.onError = append(w.onError, listener)
+	w.Unlock()
+}
+
+// Send queue an event, it does not block
+func (w *WebhookDispatcher) Send(event *WebhookEvent) error {
+
+	w.Lock()
+	defer w.Unlock()
+
+	if w.stopped {
+		return ErrWebhookStopped
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	select {
+	case w.queue <- event:
+		return nil
+	default:
+		return ErrWebhookQueueFull
+	}
+}
+
+// SignWebhook signature of a webhook request, hex encoded HMAC-SHA256 of the timestamp header, a dot and the body
+func SignWebhook(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *WebhookDispatcher) run() {
+
+	defer close(w.done)
+
+	for event := range w.queue {
+
+		body, err := json.Marshal(event)
+		if err == nil {
+			err = w.deliver(event, body)
+		}
+
+		if err != nil {
+			w.Lock()
+			listeners := w.onError
+			w.Unlock()
+			for _, listener := range listeners {
+				listener(event, err)
+			}
+		}
+	}
+}
+
+func (w *WebhookDispatcher) deliver(event *WebhookEvent, body []byte) error {
+
+	w.Lock()
+	retries := w.retries
+	delay := w.retryDelay
+	w.Unlock()
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+
+		if attempt > 0 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+
+		var retry bool
+		if retry, err = w.post(event, body); err == nil || !retry {
+			return err
+		}
+	}
+	return err
+}
+
+// post the event once, returns if a failure can be retried
+func (w *WebhookDispatcher) post(event *WebhookEvent, body []byte) (bool, error) {
+
+	request, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Webhook-Event", event.Type)
+	request.Header.Set("X-Webhook-Timestamp", timestamp)
+	request.Header.Set("X-Webhook-Signature", SignWebhook(string(w.secret), timestamp, body))
+
+	response, err := w.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return false, nil
+	}
+
+	err = fmt.Errorf("webhook %s: unexpected status %d", event.Type, response.StatusCode)
+
+	return response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests, err
+}
+
+// WatchTransport send the stream started/ended events of the incoming streams created afterwards and the transport failed event
+// name identifies the transport in the events. A stream has ended when it is stopped, with the transport or by the application.
+// The transport has failed on a DTLS failure or when the remote peer stops answering ICE checks, see Transport.OnStateChange
+func (w *WebhookDispatcher) WatchTransport(name string, transport *Transport) {
+
+	transport.addIncomingStreamListener(func(stream *IncomingStream) {
+
+		streamID := stream.GetID()
+
+		w.Send(&WebhookEvent{Type: WebhookStreamStarted, Transport: name, Stream: streamID, Metadata: stream.GetAllMetadata()})
+
+		stream.OnStop(func() {
+			w.Send(&WebhookEvent{Type: WebhookStreamEnded, Transport: name, Stream: streamID})
+		})
+	})
+
+	transport.OnStateChange(func(state TransportState) {
+		if state != TransportStateFailed {
+			return
+		}
+		event := &WebhookEvent{Type: WebhookTransportFailed, Transport: name, Error: "ice connectivity lost"}
+		if err := transport.GetDTLSError(); err != nil {
+			event.Error = err.Error()
+		}
+		w.Send(event)
+	})
+}
+
+// WatchRecorder send the recording finished event when the recorder is stopped
+func (w *WebhookDispatcher) WatchRecorder(recorder *Recorder) {
+	recorder.OnStop(func() {
+		w.Send(&WebhookEvent{Type: WebhookRecordingFinished, Filename: recorder.GetFilename()})
+	})
+}
+
+// Stop stop accepting events and wait until the queued ones are delivered or dropped
+func (w *WebhookDispatcher) Stop() {
+
+	w.Lock()
+	if w.stopped {
+		w.Unlock()
+		return
+	}
+	w.stopped = true
+	close(w.queue)
+	w.Unlock()
+
+	<-w.done
+}
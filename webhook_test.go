@@ -0,0 +1,97 @@
+package mediaserver
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/notedit/sdp"
+)
+
+func Test_WebhookDispatcher(t *testing.T) {
+
+	var attempts int32
+	received := make(chan string, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Header.Get("X-Webhook-Signature") != SignWebhook("secret", r.Header.Get("X-Webhook-Timestamp"), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		received <- r.Header.Get("X-Webhook-Event")
+	}))
+	defer server.Close()
+
+	dispatcher := NewWebhookDispatcher(server.URL, "secret")
+	dispatcher.SetRetries(2, 10*time.Millisecond)
+	defer dispatcher.Stop()
+
+	dispatcher.Send(&WebhookEvent{Type: WebhookStreamStarted, Stream: "stream"})
+
+	select {
+	case event := <-received:
+		if event != WebhookStreamStarted {
+			t.Error("unexpected event", event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Error("webhook not delivered after retry")
+	}
+}
+
+func nextWebhookEvent(t *testing.T, dispatcher *WebhookDispatcher, expected string) *WebhookEvent {
+	t.Helper()
+	select {
+	case event := <-dispatcher.queue:
+		if event.Type != expected {
+			t.Error("unexpected event", event.Type, "expected", expected)
+		}
+		return event
+	default:
+		t.Fatal("expected event", expected)
+		return nil
+	}
+}
+
+func Test_WebhookWatchTransport(t *testing.T) {
+
+	endpoint := NewEndpoint("127.0.0.1")
+	defer endpoint.Stop()
+	transport := newTestTransport(endpoint)
+	defer transport.Stop()
+
+	// not started, the events stay queued
+	dispatcher := &WebhookDispatcher{queue: make(chan *WebhookEvent, 16)}
+	dispatcher.WatchTransport("peer", transport)
+
+	// a stream without tracks ends when it is stopped
+	stream := transport.CreateIncomingStream(sdp.NewStreamInfo("empty"))
+	if event := nextWebhookEvent(t, dispatcher, WebhookStreamStarted); event.Stream != "empty" || event.Transport != "peer" {
+		t.Error("unexpected event", event)
+	}
+	stream.Stop()
+	if event := nextWebhookEvent(t, dispatcher, WebhookStreamEnded); event.Stream != "empty" {
+		t.Error("unexpected event", event)
+	}
+	stream.Stop()
+	if len(dispatcher.queue) != 0 {
+		t.Error("expected the stream ended once")
+	}
+
+	// the remote peer stopped answering the ice checks
+	monitor := transport.getStateMonitor()
+	monitor.Lock()
+	monitor.lastActivity = time.Now().Add(-time.Minute)
+	monitor.update(time.Now())
+
+	if event := nextWebhookEvent(t, dispatcher, WebhookTransportFailed); event.Error == "" {
+		t.Error("expected the failure reason", event)
+	}
+}
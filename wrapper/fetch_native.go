@@ -0,0 +1,318 @@
+//go:build ignore
+// +build ignore
+
+// fetch_native downloads the prebuilt native library pinned by Version and native.lock for this platform,
+// verifies its checksum and extracts it where the cgo flags of module.go expect it, so the manual build is not needed.
+// No archive is published yet, native.lock has no url nor checksum until the first release is packed and uploaded.
+//
+//	go run fetch_native.go
+//
+// Maintainers create the archive of a platform from a local make and record its checksum in native.lock with
+//
+//	go run fetch_native.go -pack
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const lockFile = "native.lock"
+
+// stamp file recording the extracted archive checksum
+const stampFile = "../media-server/bin/release/.prebuilt"
+
+// the paths used by the cgo flags of module.go, relative to the repository root
+var archived = []string{
+	"media-server/bin/release/libmediaserver.a",
+	"media-server/include",
+	"media-server/src",
+	"media-server/ext/crc32c/include",
+	"media-server/ext/libdatachannels/src",
+	"thirdparty/openssl/build/include",
+	"thirdparty/openssl/build/libssl.a",
+	"thirdparty/openssl/build/libcrypto.a",
+	"thirdparty/libsrtp/build/include",
+	"thirdparty/libsrtp/build/libsrtp2.a",
+	"thirdparty/mp4v2/build/include",
+	"thirdparty/mp4v2/build/libmp4v2.a",
+}
+
+type lock struct {
+	URL    string            `json:"url"`
+	SHA256 map[string]string `json:"sha256"`
+}
+
+// version read the Version constant of version.go, so it is only pinned in one place
+func version() (string, error) {
+
+	file, err := parser.ParseFile(token.NewFileSet(), "version.go", nil, 0)
+	if err != nil {
+		return "", err
+	}
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if len(value.Names) == 1 && value.Names[0].Name == "Version" && len(value.Values) == 1 {
+				if lit, ok := value.Values[0].(*ast.BasicLit); ok {
+					return strconv.Unquote(lit.Value)
+				}
+			}
+		}
+	}
+	return "", errors.New("no Version constant in version.go")
+}
+
+func readLock() (*lock, error) {
+
+	data, err := ioutil.ReadFile(lockFile)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &lock{}
+	if err := json.Unmarshal(data, l); err != nil {
+		return nil, err
+	}
+	if l.SHA256 == nil {
+		l.SHA256 = map[string]string{}
+	}
+	return l, nil
+}
+
+func writeLock(l *lock) error {
+
+	data, err := json.MarshalIndent(l, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(lockFile, append(data, '\n'), 0644)
+}
+
+func archiveName(version, platform string) string {
+	return fmt.Sprintf("media-server-native-%s-%s.tar.gz", version, platform)
+}
+
+// fetch download, verify and extract the archive of the platform
+func fetch(version, platform string) error {
+
+	l, err := readLock()
+	if err != nil {
+		return err
+	}
+
+	checksum := l.SHA256[platform]
+	if version == "dev" || l.URL == "" || checksum == "" {
+		return fmt.Errorf("no prebuilt native library published for %s version %s, build it with make", platform, version)
+	}
+
+	if stamp, err := ioutil.ReadFile(stampFile); err == nil && strings.TrimSpace(string(stamp)) == checksum {
+		log.Printf("prebuilt native library %s already installed", version)
+		return nil
+	}
+
+	url := strings.NewReplacer("{version}", version, "{platform}", platform).Replace(l.URL)
+	log.Printf("downloading %s", url)
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download failed: %s", resp.Status)
+	}
+
+	// verify the whole archive before extracting anything
+	tmp, err := ioutil.TempFile("", "media-server-native")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), resp.Body); err != nil {
+		return err
+	}
+
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != checksum {
+		return fmt.Errorf("checksum mismatch for %s: got %s, expected %s", url, sum, checksum)
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := extract(tmp, ".."); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(stampFile, []byte(checksum+"\n"), 0644)
+}
+
+func extract(reader io.Reader, root string) error {
+
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+		path := filepath.Join(root, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode).Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(file, archive)
+			file.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// pack archive the local build of the platform and record its checksum
+func pack(version, platform string) error {
+
+	if version == "dev" {
+		return errors.New("set the Version of version.go before packing")
+	}
+
+	l, err := readLock()
+	if err != nil {
+		return err
+	}
+
+	name := archiveName(version, platform)
+	out, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	hash := sha256.New()
+	gz := gzip.NewWriter(io.MultiWriter(out, hash))
+	archive := tar.NewWriter(gz)
+
+	for _, path := range archived {
+		err := filepath.Walk(filepath.Join("..", path), func(file string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			// only the headers of the sources are needed
+			if !info.IsDir() && strings.HasPrefix(path, "media-server/src") && !strings.HasSuffix(file, ".h") {
+				return nil
+			}
+			rel, err := filepath.Rel("..", file)
+			if err != nil {
+				return err
+			}
+			header, err := tar.FileInfoHeader(info, "")
+			if err != nil {
+				return err
+			}
+			header.Name = filepath.ToSlash(rel)
+			if err := archive.WriteHeader(header); err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			_, err = archive.Write(data)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("%s not built, run make first: %v", path, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	l.SHA256[platform] = hex.EncodeToString(hash.Sum(nil))
+	if err := writeLock(l); err != nil {
+		return err
+	}
+
+	log.Printf("packed %s sha256 %s", name, l.SHA256[platform])
+	return nil
+}
+
+func main() {
+
+	packFlag := flag.Bool("pack", false, "archive the local build and record its checksum in native.lock")
+	platform := flag.String("platform", runtime.GOOS+"-"+runtime.GOARCH, "platform of the archive")
+	flag.Parse()
+
+	version, err := version()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *packFlag {
+		err = pack(version, *platform)
+	} else {
+		err = fetch(version, *platform)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,181 @@
+//go:build ignore
+// +build ignore
+
+// gen_stub generates native_stub.go from the swig generated native.go, run it with go generate after regenerating the wrapper.
+// The stub keeps the wrapper API without cgo: functions calling the native library do nothing and return zero values,
+// and non nil handles for the wrapped classes, so the mediaserver package can be built and its logic tested without the native library.
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+const header = `// Code generated by gen_stub.go from native.go. DO NOT EDIT.
+
+//go:build stub
+// +build stub
+
+`
+
+// uses check if the node references one of the packages
+func uses(node ast.Node, packages ...string) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && contains(packages, id.Name) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// stubResult the value returned by a stubbed function for a result type
+func stubResult(typ ast.Expr, classes map[string]bool) ast.Expr {
+	if id, ok := typ.(*ast.Ident); ok && classes[id.Name] {
+		return &ast.CallExpr{
+			Fun:  ast.NewIdent("Swigcptr" + id.Name),
+			Args: []ast.Expr{&ast.CallExpr{Fun: ast.NewIdent("stubHandle")}},
+		}
+	}
+	return &ast.StarExpr{X: &ast.CallExpr{Fun: ast.NewIdent("new"), Args: []ast.Expr{typ}}}
+}
+
+func stubBody(fn *ast.FuncDecl, classes map[string]bool) *ast.BlockStmt {
+
+	body := &ast.BlockStmt{}
+	if fn.Type.Results == nil {
+		return body
+	}
+
+	ret := &ast.ReturnStmt{}
+	for _, field := range fn.Type.Results.List {
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			ret.Results = append(ret.Results, stubResult(field.Type, classes))
+		}
+	}
+	body.List = append(body.List, ret)
+	return body
+}
+
+// usedImports the package names referenced by the declarations
+func usedImports(decls []ast.Decl) map[string]bool {
+	used := map[string]bool{}
+	for _, decl := range decls {
+		ast.Inspect(decl, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					used[id.Name] = true
+				}
+			}
+			return true
+		})
+	}
+	return used
+}
+
+func main() {
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "native.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// the wrapped classes are interfaces implemented by a Swigcptr uintptr
+	classes := map[string]bool{}
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+			for _, spec := range gen.Specs {
+				name := spec.(*ast.TypeSpec).Name.Name
+				if strings.HasPrefix(name, "Swigcptr") {
+					classes[strings.TrimPrefix(name, "Swigcptr")] = true
+				}
+			}
+		}
+	}
+
+	imports := []string{}
+	decls := []ast.Decl{}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok == token.IMPORT {
+				for _, spec := range decl.Specs {
+					path := spec.(*ast.ImportSpec).Path.Value
+					if path != `"C"` && path != `"runtime/cgo"` {
+						imports = append(imports, path)
+					}
+				}
+				continue
+			}
+			if uses(decl, "C") {
+				continue
+			}
+			decls = append(decls, decl)
+		case *ast.FuncDecl:
+			// exported callbacks are only called from the native library
+			if uses(decl.Type, "C") || strings.HasPrefix(decl.Name.Name, "Swiggo_") || strings.HasPrefix(decl.Name.Name, "Swig_Director") {
+				continue
+			}
+			// the string helpers read native memory too
+			if decl.Body != nil && uses(decl.Body, "C", "unsafe") {
+				decl.Body = stubBody(decl, classes)
+			}
+			decls = append(decls, decl)
+		}
+	}
+
+	used := usedImports(decls)
+
+	var buffer bytes.Buffer
+	buffer.WriteString(header)
+	buffer.WriteString("package native\n\nimport (\n\t\"sync/atomic\"\n")
+	for _, path := range imports {
+		name := strings.Trim(path, `"`)
+		if used[name[strings.LastIndex(name, "/")+1:]] {
+			buffer.WriteString("\t" + path + "\n")
+		}
+	}
+	buffer.WriteString(")\n\n")
+	buffer.WriteString("var stubHandles uintptr\n\n")
+	buffer.WriteString("// stubHandle a unique non zero handle for the stubbed classes\n")
+	buffer.WriteString("func stubHandle() uintptr {\n\treturn atomic.AddUintptr(&stubHandles, 1)\n}\n\n")
+
+	var body bytes.Buffer
+	if err := format.Node(&body, token.NewFileSet(), &ast.File{Name: ast.NewIdent("native"), Decls: decls}); err != nil {
+		log.Fatal(err)
+	}
+	buffer.Write(bytes.TrimPrefix(body.Bytes(), []byte("package native\n")))
+
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile("native_stub.go", source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -4,6 +4,12 @@
 #include <string>
 #include <list>
 #include <functional>
+#include <atomic>
+#include <cstdarg>
+#include <memory>
+#include <mutex>
+#include <deque>
+#include <vector>
 #include "../media-server/include/config.h"
 #include "../media-server/include/dtls.h"
 #include "../media-server/include/OpenSSL.h"
@@ -11,6 +17,7 @@
 #include "../media-server/include/video.h"
 #include "../media-server/include/audio.h"
 #include "../media-server/include/rtp.h"
+#include "../media-server/include/codecs.h"
 #include "../media-server/include/tools.h"
 #include "../media-server/include/rtpsession.h"
 #include "../media-server/include/DTLSICETransport.h"
@@ -87,6 +94,19 @@ public:
 	{
 		return DTLSConnection::GetCertificateFingerPrint(DTLSConnection::Hash::SHA256);
 	}
+	
+	static bool SetCertificate(const char* cert, const char* key)
+	{
+		//Reload the DTLS context, established connections keep their session
+		DTLSConnection::SetCertificate(cert,key);
+		return DTLSConnection::Initialize();
+	}
+
+	static bool SupportsCodec(const char* name)
+	{
+		//Codecs unknown to the native build are mapped to UNKNOWN
+		return VideoCodec::GetCodecForName(name)!=VideoCodec::UNKNOWN || AudioCodec::GetCodecForName(name)!=AudioCodec::UNKNOWN;
+	}
 };
 
 
@@ -158,6 +178,23 @@ public:
 };
 
 
+//Errors logged by the mp4 writer, it does not report write failures to the recorder nor say which file failed
+static std::atomic<uint32_t> mp4WriteErrors = {0};
+static std::once_flag mp4LogOnce;
+
+static void OnMP4Log(MP4LogLevel level, const char* fmt, va_list ap)
+{
+	char msg[1024];
+	vsnprintf(msg,sizeof(msg),fmt,ap);
+	if (level<=MP4_LOG_ERROR)
+	{
+		mp4WriteErrors++;
+		Error("-MP4Recorder: %s\n",msg);
+	} else {
+		Debug("-MP4Recorder: %s\n",msg);
+	}
+}
+
 class MP4RecorderFacade :
     public MP4Recorder,
     public MP4Recorder::Listener
@@ -166,7 +203,14 @@ public:
     MP4RecorderFacade() :
         MP4Recorder(this)
     {
+        std::call_once(mp4LogOnce,[](){ MP4SetLogCallback(OnMP4Log); });
+        errors = mp4WriteErrors.load();
+    }
 
+    //Errors logged by the mp4 writer since this recorder was created, by any recorder
+    uint32_t GetWriteErrors()
+    {
+        return mp4WriteErrors.load()-errors;
     }
 
     void onFirstFrame(QWORD time) override
@@ -178,6 +222,9 @@ public:
     {
         // todo
     }
+
+private:
+    uint32_t errors = 0;
 };
 
 
@@ -251,8 +298,13 @@ public:
 	{
 
 
+		// Copy the packet, the caller buffer is not valid after returning
+		std::vector<uint8_t> buffer(data, data + size);
+
 		// Run on thread
-		loop.Async([=](...)  {
+		loop.Async([=, buffer = std::move(buffer)](...)  {
+
+			uint8_t* data = (uint8_t*)buffer.data();
 
 			RTPHeader header;
 			RTPHeaderExtension extension;
@@ -388,6 +440,11 @@ public:
 	{
 		sender = session;
 	}
+
+	RTPSenderFacade(RTPSender* sender)
+	{
+		this->sender = sender;
+	}
 	
 	RTPSender* get() { return sender;}
 private:
@@ -449,6 +506,640 @@ RTPReceiverFacade* RTPSessionToReceiver(MediaFrameSessionFacade* session)
 	return new RTPReceiverFacade(session);
 }
 
+uint32_t MediaFrameGetLength(const MediaFrame* frame)
+{
+	return frame->GetLength();
+}
+
+uint64_t MediaFrameGetTimestamp(const MediaFrame* frame)
+{
+	return frame->GetTimeStamp();
+}
+
+uint32_t MediaFrameGetClockRate(const MediaFrame* frame)
+{
+	return frame->GetClockRate();
+}
+
+bool MediaFrameIsIntra(const MediaFrame* frame)
+{
+	return frame->GetType()!=MediaFrame::Video || static_cast<const VideoFrame*>(frame)->IsIntra();
+}
+
+void MediaFrameCopyData(const MediaFrame* frame, uint8_t* data, int size)
+{
+	memcpy(data, frame->GetData(), std::min<int>(size, frame->GetLength()));
+}
+
+bool TransportSendSenderReport(DTLSICETransport* transport, RTPOutgoingSourceGroup* group)
+{
+	if (!transport || !group)
+		return false;
+	//Build it on the transport thread where the source stats are updated
+	transport->GetTimeService().Async([=](...){
+		auto rtcp = RTCPCompoundPacket::Create();
+		rtcp->AddPacket(group->media.CreateSenderReport(getTime()));
+		transport->Send(rtcp);
+	});
+	return true;
+}
+
+bool TransportSendRTCPApp(DTLSICETransport* transport, DWORD ssrc, int subtype, const char* name, const uint8_t* data, int size)
+{
+	if (!transport || !name || strlen(name)!=4 || subtype<0 || subtype>31 || size<0 || size%4)
+		return false;
+	std::string app_name(name, 4);
+	std::vector<uint8_t> payload(data, data+size);
+	//Serialize it on the transport thread like any other rtcp
+	transport->GetTimeService().Async([=](...){
+		auto rtcp = RTCPCompoundPacket::Create();
+		auto app = rtcp->CreatePacket<RTCPApp>();
+		app->SetSSRC(ssrc);
+		app->SetSubType(subtype);
+		app->SetName(app_name.c_str());
+		app->SetData(payload.data(), payload.size());
+		transport->Send(rtcp);
+	});
+	return true;
+}
+
+bool TransportSendREMB(DTLSICETransport* transport, DWORD ssrc, DWORD bitrate, const uint8_t* ssrcs, int size)
+{
+	if (!transport || !ssrcs || size<=0 || size%4)
+		return false;
+	//Media ssrcs are packed in network order
+	std::list<DWORD> media;
+	for (int i=0; i<size; i+=4)
+		media.push_back(get4(ssrcs,i));
+	//Serialize it on the transport thread like any other rtcp
+	transport->GetTimeService().Async([=](...){
+		auto rtcp = RTCPCompoundPacket::Create();
+		auto remb = rtcp->CreatePacket<RTCPPayloadFeedback>(RTCPPayloadFeedback::ApplicationLayerFeeedbackMessage, ssrc, DWORD(0));
+		remb->AddField(RTCPPayloadFeedback::ApplicationLayerFeeedbackField::CreateReceiverEstimatedMaxBitrate(media, bitrate));
+		transport->Send(rtcp);
+	});
+	return true;
+}
+
+bool TransportSendBYE(DTLSICETransport* transport, const uint8_t* ssrcs, int size)
+{
+	if (!transport || !ssrcs || size<=0 || size%4)
+		return false;
+	//Ssrcs are packed in network order
+	std::vector<DWORD> byes;
+	for (int i=0; i<size; i+=4)
+		byes.push_back(get4(ssrcs,i));
+	//Queued before the removal of the source group, which syncs with the transport thread
+	transport->GetTimeService().Async([=](...){
+		auto rtcp = RTCPCompoundPacket::Create();
+		rtcp->CreatePacket<RTCPBye>(byes,"stopped");
+		transport->Send(rtcp);
+	});
+	return true;
+}
+
+class TimeServiceProbe
+{
+public:
+	TimeServiceProbe(TimeService& timeService) :
+		timeService(timeService),
+		state(std::make_shared<State>())
+	{
+	}
+
+	void Ping()
+	{
+		//Only one probe task in flight
+		if (state->pending.exchange(true))
+			return;
+		state->pinged = getTimeMS();
+		auto state = this->state;
+		timeService.Async([state](...){
+			state->pending = false;
+		});
+	}
+
+	uint64_t GetPendingTime()
+	{
+		if (!state->pending)
+			return 0;
+		return getTimeMS() - state->pinged;
+	}
+private:
+	struct State
+	{
+		std::atomic<bool> pending = {false};
+		std::atomic<uint64_t> pinged = {0};
+	};
+	TimeService& timeService;
+	std::shared_ptr<State> state;
+};
+
+class SimulcastFrameSelector :
+	public MediaFrame::Listener
+{
+public:
+	SimulcastFrameSelector(MediaFrame::Listener* output) :
+		output(output)
+	{
+	}
+
+	void Select(DWORD ssrc)
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		//First encoding is used straight away, next ones at their first intra frame
+		if (!selected)
+			selected = ssrc;
+		else
+			pending = ssrc!=selected ? ssrc : 0;
+	}
+
+	DWORD GetSelected()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		return selected;
+	}
+
+	virtual void onMediaFrame(const MediaFrame &frame) override
+	{
+	}
+
+	virtual void onMediaFrame(DWORD ssrc, const MediaFrame &frame) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+
+		//Switch when the pending encoding can be decoded
+		if (pending && ssrc==pending && IsIntra(frame))
+		{
+			selected = pending;
+			pending = 0;
+			rebase = true;
+		}
+
+		if (ssrc!=selected)
+			return;
+
+		//All encodings are written with the first ssrc, so they end in the same track
+		if (!output_ssrc)
+			output_ssrc = ssrc;
+
+		//Continue the timestamps of the previous encoding using the elapsed wall clock time
+		if (rebase)
+		{
+			QWORD elapsed = frame.GetTime()>last_time ? frame.GetTime()-last_time : 0;
+			offset = last_timestamp + elapsed*frame.GetClockRate()/1000 - frame.GetTimeStamp();
+			rebase = false;
+		}
+
+		std::unique_ptr<MediaFrame> cloned(frame.Clone());
+		cloned->SetTimestamp(frame.GetTimeStamp()+offset);
+
+		last_timestamp = cloned->GetTimeStamp();
+		last_time = frame.GetTime();
+
+		output->onMediaFrame(output_ssrc,*cloned);
+	}
+
+private:
+	static bool IsIntra(const MediaFrame &frame)
+	{
+		return frame.GetType()!=MediaFrame::Video || static_cast<const VideoFrame&>(frame).IsIntra();
+	}
+
+	MediaFrame::Listener* output;
+	std::mutex mutex;
+	DWORD selected = 0;
+	DWORD pending = 0;
+	DWORD output_ssrc = 0;
+	bool rebase = false;
+	QWORD offset = 0;
+	QWORD last_timestamp = 0;
+	QWORD last_time = 0;
+};
+
+class RTPSenderTee :
+	public RTPSender
+{
+public:
+	RTPSenderTee(RTPSenderFacade* sender, MediaFrame::Listener* listener) :
+		sender(sender->get()),
+		facade(this),
+		state(std::make_shared<State>())
+	{
+		state->listener = listener;
+	}
+
+	virtual ~RTPSenderTee()
+	{
+		Stop();
+	}
+
+	virtual int Enqueue(const RTPPacket::shared& packet) override
+	{
+		state->Tee(packet);
+		return sender->Enqueue(packet);
+	}
+
+	virtual int Enqueue(const RTPPacket::shared& packet,std::function<RTPPacket::shared(const RTPPacket::shared&)> modifier) override
+	{
+		//Tee the packet as modified by the transponder, the state outlives us if the packet is still queued
+		auto state = this->state;
+		return sender->Enqueue(packet,[state,modifier](const RTPPacket::shared& original) {
+			auto modified = modifier(original);
+			state->Tee(modified);
+			return modified;
+		});
+	}
+
+	RTPSenderFacade* GetSender() { return &facade; }
+
+	void Stop()
+	{
+		std::lock_guard<std::mutex> lock(state->mutex);
+		state->listener = nullptr;
+	}
+
+private:
+	struct State
+	{
+		std::mutex mutex;
+		MediaFrame::Listener* listener = nullptr;
+		std::unique_ptr<RTPDepacketizer> depacketizer;
+
+		void Tee(const RTPPacket::shared& packet)
+		{
+			std::lock_guard<std::mutex> lock(mutex);
+			if (!listener || !packet)
+				return;
+			if (depacketizer && depacketizer->GetCodec()!=packet->GetCodec())
+				depacketizer.reset();
+			if (!depacketizer)
+				depacketizer.reset(RTPDepacketizer::Create(packet->GetMedia(),packet->GetCodec()));
+			if (!depacketizer)
+				return;
+			MediaFrame* frame = depacketizer->AddPacket(packet);
+			if (frame)
+			{
+				listener->onMediaFrame(packet->GetSSRC(),*frame);
+				depacketizer->ResetFrame();
+			}
+		}
+	};
+
+	RTPSender* sender;
+	RTPSenderFacade facade;
+	std::shared_ptr<State> state;
+};
+
+class RTPPacer :
+	public RTPSender
+{
+public:
+	RTPPacer(RTPSenderFacade* sender, TimeService& timeService) :
+		facade(this),
+		state(std::make_shared<State>())
+	{
+		state->sender = sender->get();
+		auto state = this->state;
+		timer = timeService.CreateTimer(std::chrono::milliseconds(0), std::chrono::milliseconds(5), [state](std::chrono::milliseconds now){
+			state->Flush(now.count());
+		});
+	}
+
+	virtual ~RTPPacer()
+	{
+		Stop();
+	}
+
+	virtual int Enqueue(const RTPPacket::shared& packet) override
+	{
+		return state->Push(packet,nullptr);
+	}
+
+	virtual int Enqueue(const RTPPacket::shared& packet,std::function<RTPPacket::shared(const RTPPacket::shared&)> modifier) override
+	{
+		return state->Push(packet,modifier);
+	}
+
+	//Bitrate in bps, 0 sends as soon as possible, burst in bytes and queue limit in packets, 0 for no limit
+	void Configure(DWORD bitrate, DWORD burst, DWORD limit)
+	{
+		std::lock_guard<std::mutex> lock(state->mutex);
+		state->bitrate = bitrate;
+		//At least one full packet must fit or nothing would be sent
+		state->burst = std::max<DWORD>(burst,1500);
+		state->limit = limit;
+	}
+
+	RTPSenderFacade* GetSender() { return &facade; }
+
+	DWORD GetQueued()
+	{
+		std::lock_guard<std::mutex> lock(state->mutex);
+		return state->queue.size();
+	}
+
+	QWORD GetSent()
+	{
+		std::lock_guard<std::mutex> lock(state->mutex);
+		return state->sent;
+	}
+
+	QWORD GetDropped()
+	{
+		std::lock_guard<std::mutex> lock(state->mutex);
+		return state->dropped;
+	}
+
+	void Stop()
+	{
+		if (timer)
+			timer->Cancel();
+		timer.reset();
+		std::lock_guard<std::mutex> lock(state->mutex);
+		state->sender = nullptr;
+		state->queue.clear();
+	}
+
+private:
+	struct Item
+	{
+		RTPPacket::shared packet;
+		std::function<RTPPacket::shared(const RTPPacket::shared&)> modifier;
+	};
+
+	struct State
+	{
+		std::mutex mutex;
+		RTPSender* sender = nullptr;
+		std::deque<Item> queue;
+		DWORD bitrate = 0;
+		DWORD burst = 1500;
+		DWORD limit = 0;
+		double tokens = 0;
+		QWORD last = 0;
+		QWORD sent = 0;
+		QWORD dropped = 0;
+
+		int Push(const RTPPacket::shared& packet,std::function<RTPPacket::shared(const RTPPacket::shared&)> modifier)
+		{
+			std::lock_guard<std::mutex> lock(mutex);
+			if (!sender || !packet)
+				return 0;
+			//Drop the oldest, it is the most likely to be useless by now
+			if (limit && queue.size()>=limit)
+			{
+				queue.pop_front();
+				dropped++;
+			}
+			queue.push_back({packet,modifier});
+			return 1;
+		}
+
+		void Flush(QWORD now)
+		{
+			std::lock_guard<std::mutex> lock(mutex);
+			if (!sender)
+				return;
+			//Token bucket refilled at the pacing bitrate up to the burst size
+			if (last && bitrate)
+				tokens = std::min<double>(burst,tokens + (now-last)*bitrate/8000.0);
+			last = now;
+			while (!queue.empty())
+			{
+				auto& item = queue.front();
+				DWORD size = 12 + item.packet->GetMediaLength();
+				if (bitrate && tokens<size)
+					break;
+				if (bitrate)
+					tokens -= size;
+				if (item.modifier)
+					sender->Enqueue(item.packet,item.modifier);
+				else
+					sender->Enqueue(item.packet);
+				sent++;
+				queue.pop_front();
+			}
+		}
+	};
+
+	RTPSenderFacade facade;
+	std::shared_ptr<State> state;
+	Timer::shared timer;
+};
+
+class RTPDumpWriter :
+	public RTPIncomingMediaStream::Listener
+{
+public:
+	RTPDumpWriter() = default;
+
+	virtual ~RTPDumpWriter()
+	{
+		Close();
+	}
+
+	bool Open(const char* filename)
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (fd)
+			return false;
+		if (!(fd = fopen(filename,"wb")))
+			return false;
+		start = getTimeMS();
+		//rtpdump file header, no source address known
+		fprintf(fd,"#!rtpplay1.0 0.0.0.0/0\n");
+		BYTE header[16] = {};
+		set4(header,0,start/1000);
+		set4(header,4,(start%1000)*1000);
+		fwrite(header,sizeof(header),1,fd);
+		return true;
+	}
+
+	void AddIncoming(RTPIncomingMediaStream* incoming)
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (!fd || !incoming || !incomings.insert(incoming).second)
+			return;
+		incoming->AddListener(this);
+	}
+
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (!fd)
+			return;
+
+		//Header extensions are not kept by the parsed packet, the fixed header is rebuilt
+		DWORD len = 12 + packet->GetMediaLength();
+		BYTE header[20];
+		set2(header,0,len+8);
+		set2(header,2,len);
+		set4(header,4,packet->GetTime()>start ? packet->GetTime()-start : 0);
+		header[8] = 0x80;
+		header[9] = (packet->GetMark() ? 0x80 : 0x00) | (packet->GetPayloadType() & 0x7F);
+		set2(header,10,packet->GetSeqNum());
+		set4(header,12,packet->GetTimestamp());
+		set4(header,16,packet->GetSSRC());
+
+		fwrite(header,sizeof(header),1,fd);
+		fwrite(packet->GetMediaData(),packet->GetMediaLength(),1,fd);
+	}
+
+	virtual void onBye(RTPIncomingMediaStream* group) override
+	{
+	}
+
+	virtual void onEnded(RTPIncomingMediaStream* group) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		incomings.erase(group);
+	}
+
+	void Close()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		for (auto incoming : incomings)
+			incoming->RemoveListener(this);
+		incomings.clear();
+		if (fd)
+			fclose(fd);
+		fd = nullptr;
+	}
+
+private:
+	std::mutex mutex;
+	FILE* fd = nullptr;
+	QWORD start = 0;
+	std::set<RTPIncomingMediaStream*> incomings;
+};
+
+class AudioLevelMeter :
+	public RTPIncomingMediaStream::Listener
+{
+public:
+	AudioLevelMeter(RTPIncomingMediaStream* incoming)
+	{
+		if (!incoming)
+			return;
+		this->incoming = incoming;
+		incoming->AddListener(this);
+	}
+
+	virtual ~AudioLevelMeter()
+	{
+		Stop();
+	}
+
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet) override
+	{
+		if (!packet->HasAudioLevel())
+			return;
+		//Keep the loudest level, in -dBov so the lowest value, until read
+		int level = packet->GetLevel() & 0x7F;
+		int current = loudest.load();
+		while (level < current && !loudest.compare_exchange_weak(current,level))
+			;
+	}
+
+	virtual void onBye(RTPIncomingMediaStream* group) override
+	{
+	}
+
+	virtual void onEnded(RTPIncomingMediaStream* group) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming==group)
+			incoming = nullptr;
+	}
+
+	//Loudest level received since the previous read in -dBov, 127 (silence) if none
+	BYTE ReadLevel()
+	{
+		return (BYTE)loudest.exchange(127);
+	}
+
+	void Stop()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming)
+			incoming->RemoveListener(this);
+		incoming = nullptr;
+	}
+
+private:
+	std::mutex mutex;
+	std::atomic<int> loudest = {127};
+	RTPIncomingMediaStream* incoming = nullptr;
+};
+
+class PayloadCodecMonitor :
+	public RTPIncomingMediaStream::Listener
+{
+public:
+	PayloadCodecMonitor(RTPIncomingMediaStream* incoming)
+	{
+		if (!incoming)
+			return;
+		this->incoming = incoming;
+		incoming->AddListener(this);
+	}
+
+	virtual ~PayloadCodecMonitor()
+	{
+		Stop();
+	}
+
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet) override
+	{
+		media = packet->GetMedia();
+		codec = packet->GetCodec();
+	}
+
+	virtual void onBye(RTPIncomingMediaStream* group) override
+	{
+	}
+
+	virtual void onEnded(RTPIncomingMediaStream* group) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming==group)
+			incoming = nullptr;
+	}
+
+	//Name of the codec of the last RTP packet received, empty if none
+	const char* GetCodec()
+	{
+		int last = codec.load();
+		if (last<0)
+			return "";
+		switch (media.load())
+		{
+			case MediaFrame::Audio:
+				return AudioCodec::GetNameFor((AudioCodec::Type)last);
+			case MediaFrame::Video:
+				return VideoCodec::GetNameFor((VideoCodec::Type)last);
+			default:
+				return "";
+		}
+	}
+
+	void Stop()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming)
+			incoming->RemoveListener(this);
+		incoming = nullptr;
+	}
+
+private:
+	std::mutex mutex;
+	std::atomic<int> media = {-1};
+	std::atomic<int> codec = {-1};
+	RTPIncomingMediaStream* incoming = nullptr;
+};
+
+
 
 
 
@@ -470,20 +1161,131 @@ public:
 		return RTPStreamTransponder::SetIncoming(incoming, receiver);
 	}
 	
+	virtual void onPLIRequest(RTPOutgoingSourceGroup* group,DWORD ssrc) override
+	{
+		//FIRs are reported as PLIs too
+		totalPLIRequests++;
+		RTPStreamTransponder::onPLIRequest(group,ssrc);
+	}
+
 	virtual void onREMB(RTPOutgoingSourceGroup* group,DWORD ssrc, DWORD bitrate) override
 	{
-		// todo  make callback
-		// Log("onREMB\n");
+		totalREMBs++;
+		lastREMB = bitrate;
 	}
 	void SetMinPeriod(DWORD period) { this->period = period; }
 
+	DWORD GetTotalPLIRequests()	{ return totalPLIRequests;	}
+	DWORD GetTotalREMBs()		{ return totalREMBs;		}
+	DWORD GetLastREMB()		{ return lastREMB;		}
+
 private:
 	DWORD period = 1000;
 	QWORD last = 0;
+	std::atomic<DWORD> totalPLIRequests = {0};
+	std::atomic<DWORD> totalREMBs = {0};
+	std::atomic<DWORD> lastREMB = {0};
 };
 
 
 
+class KeyframeCache :
+	public RTPIncomingMediaStream::Listener
+{
+public:
+	static constexpr DWORD MaxPackets = 512;
+
+	KeyframeCache(RTPIncomingMediaStream* incoming)
+	{
+		if (!incoming)
+			return;
+		this->incoming = incoming;
+		incoming->AddListener(this);
+	}
+
+	virtual ~KeyframeCache()
+	{
+		Stop();
+	}
+
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		//New frame, the SPS/PPS are sent with the timestamp of the IDR
+		if (frame.empty() || packet->GetTimestamp()!=timestamp)
+		{
+			frame.clear();
+			timestamp = packet->GetTimestamp();
+			intra = false;
+		}
+		//Do not grow if the marker is lost
+		if (frame.size()>=MaxPackets)
+			return;
+		frame.push_back(packet->Clone());
+		intra |= packet->IsKeyFrame();
+		//Keep only complete keyframes
+		if (packet->GetMark() && intra)
+		{
+			keyframe = std::make_shared<std::vector<RTPPacket::shared>>(std::move(frame));
+			frame.clear();
+			cached = getTimeMS();
+		}
+	}
+
+	virtual void onBye(RTPIncomingMediaStream* group) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		frame.clear();
+	}
+
+	virtual void onEnded(RTPIncomingMediaStream* group) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming==group)
+			incoming = nullptr;
+	}
+
+	//Send the last keyframe through the transponder unless it is older than maxAge ms, 0 for no limit
+	bool Replay(RTPStreamTransponderFacade* transponder, DWORD maxAge)
+	{
+		std::shared_ptr<std::vector<RTPPacket::shared>> packets;
+		RTPIncomingMediaStream* stream;
+		{
+			std::lock_guard<std::mutex> lock(mutex);
+			if (!transponder || !incoming || !keyframe || (maxAge && getTimeMS()-cached>maxAge))
+				return false;
+			packets = keyframe;
+			stream = incoming;
+		}
+		//Run on the media thread, before the transponder can be closed as closing syncs with it
+		stream->GetTimeService().Async([=](...){
+			for (const auto& packet : *packets)
+				transponder->onRTP(stream,packet->Clone());
+		});
+		return true;
+	}
+
+	void Stop()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming)
+			incoming->RemoveListener(this);
+		incoming = nullptr;
+		keyframe.reset();
+		frame.clear();
+	}
+
+private:
+	std::mutex mutex;
+	RTPIncomingMediaStream* incoming = nullptr;
+	std::vector<RTPPacket::shared> frame;
+	std::shared_ptr<std::vector<RTPPacket::shared>> keyframe;
+	DWORD timestamp = 0;
+	bool intra = false;
+	QWORD cached = 0;
+};
+
+
 class StreamTrackDepacketizer :
 	public RTPIncomingMediaStream::Listener
 {
@@ -1145,6 +1947,8 @@ public:
 	static void EnableUltraDebug(bool flag);
 	static std::string GetFingerprint();
 	static bool SetPortRange(int minPort, int maxPort);
+	static bool SetCertificate(const char* cert, const char* key);
+	static bool SupportsCodec(const char* name);
 };
 
 
@@ -1218,10 +2022,13 @@ public:
 	int Dump(const char* filename, bool inbound = true, bool outbound = true, bool rtcp = true, bool rtpHeadersOnly = false);
 	int Dump(UDPDumper* dumper, bool inbound = true, bool outbound = true, bool rtcp = true, bool rtpHeadersOnly = false);
 	int DumpBWEStats(const char* filename);
+	int StopDump();
 	void Reset();
 	
 	void ActivateRemoteCandidate(ICERemoteCandidate* candidate,bool useCandidate, DWORD priority);
 	int SetRemoteCryptoDTLS(const char *setup,const char *hash,const char *fingerprint);
+	int SetLocalCryptoSDES(const char* suite, const char* key64);
+	int SetRemoteCryptoSDES(const char* suite, const char* key64);
 	int SetLocalSTUNCredentials(const char* username, const char* pwd);
 	int SetRemoteSTUNCredentials(const char* username, const char* pwd);
 	bool AddOutgoingSourceGroup(RTPOutgoingSourceGroup *group);
@@ -1290,6 +2097,83 @@ RTPReceiverFacade*	TransportToReceiver(DTLSICETransport* transport);
 RTPSenderFacade*	SessionToSender(RTPSessionFacade* session);
 RTPReceiverFacade*	SessionToReceiver(RTPSessionFacade* session);
 RTPReceiverFacade*  RTPSessionToReceiver(MediaFrameSessionFacade* session);
+uint32_t	MediaFrameGetLength(const MediaFrame* frame);
+uint64_t	MediaFrameGetTimestamp(const MediaFrame* frame);
+uint32_t	MediaFrameGetClockRate(const MediaFrame* frame);
+bool		MediaFrameIsIntra(const MediaFrame* frame);
+void		MediaFrameCopyData(const MediaFrame* frame, uint8_t* data, int size);
+bool		TransportSendSenderReport(DTLSICETransport* transport, RTPOutgoingSourceGroup* group);
+bool		TransportSendRTCPApp(DTLSICETransport* transport, DWORD ssrc, int subtype, const char* name, const uint8_t* data, int size);
+bool		TransportSendREMB(DTLSICETransport* transport, DWORD ssrc, DWORD bitrate, const uint8_t* ssrcs, int size);
+bool		TransportSendBYE(DTLSICETransport* transport, const uint8_t* ssrcs, int size);
+
+
+class TimeServiceProbe
+{
+public:
+	TimeServiceProbe(TimeService& timeService);
+	void Ping();
+	uint64_t GetPendingTime();
+};
+
+
+class SimulcastFrameSelector :
+	public MediaFrameListener
+{
+public:
+	SimulcastFrameSelector(MediaFrameListener* output);
+	void Select(DWORD ssrc);
+	DWORD GetSelected();
+};
+
+
+class RTPSenderTee
+{
+public:
+	RTPSenderTee(RTPSenderFacade* sender, MediaFrameListener* listener);
+	RTPSenderFacade* GetSender();
+	void Stop();
+};
+
+
+class RTPPacer
+{
+public:
+	RTPPacer(RTPSenderFacade* sender, TimeService& timeService);
+	void Configure(DWORD bitrate, DWORD burst, DWORD limit);
+	RTPSenderFacade* GetSender();
+	DWORD GetQueued();
+	QWORD GetSent();
+	QWORD GetDropped();
+	void Stop();
+};
+
+
+class RTPDumpWriter
+{
+public:
+	RTPDumpWriter();
+	bool Open(const char* filename);
+	void AddIncoming(RTPIncomingMediaStream* incoming);
+	void Close();
+};
+
+
+class AudioLevelMeter
+{
+public:
+	AudioLevelMeter(RTPIncomingMediaStream* incoming);
+	BYTE ReadLevel();
+	void Stop();
+};
+
+class PayloadCodecMonitor
+{
+public:
+	PayloadCodecMonitor(RTPIncomingMediaStream* incoming);
+	const char* GetCodec();
+	void Stop();
+};
 
 
 class RTPStreamTransponderFacade 
@@ -1301,6 +2185,9 @@ public:
 	void SelectLayer(int spatialLayerId,int temporalLayerId);
 	void Mute(bool muting);
 	void Close();
+	DWORD GetTotalPLIRequests();
+	DWORD GetTotalREMBs();
+	DWORD GetLastREMB();
 };
 
 
@@ -1311,6 +2198,15 @@ struct MediaFrameListener
 };
 
 
+class KeyframeCache
+{
+public:
+	KeyframeCache(RTPIncomingMediaStream* incoming);
+	bool Replay(RTPStreamTransponderFacade* transponder, DWORD maxAge);
+	void Stop();
+};
+
+
 class StreamTrackDepacketizer 
 {
 public:
@@ -1337,6 +2233,7 @@ public:
 	virtual bool Close();
 	void SetTimeShiftDuration(DWORD duration);
 	bool Close(bool async);
+	uint32_t GetWriteErrors();
 };
 
 
@@ -1360,7 +2257,7 @@ class SenderSideEstimatorListener :
 public:
 	SenderSideEstimatorListener();
 	virtual ~SenderSideEstimatorListener() {}
-	void onTargetBitrateRequested(DWORD bitrate);
+	virtual void onTargetBitrateRequested(DWORD bitrate);
 };
 
 
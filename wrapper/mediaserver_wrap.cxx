@@ -1,3 +1,5 @@
+// +build !stub
+
 /* ----------------------------------------------------------------------------
  * This file was automatically generated by SWIG (http://www.swig.org).
  * Version 4.0.0
@@ -349,6 +351,12 @@ static void* Swig_malloc(int c) {
 #include <string>
 #include <list>
 #include <functional>
+#include <atomic>
+#include <cstdarg>
+#include <memory>
+#include <mutex>
+#include <deque>
+#include <vector>
 #include "../media-server/include/config.h"
 #include "../media-server/include/dtls.h"
 #include "../media-server/include/OpenSSL.h"
@@ -356,6 +364,7 @@ static void* Swig_malloc(int c) {
 #include "../media-server/include/video.h"
 #include "../media-server/include/audio.h"
 #include "../media-server/include/rtp.h"
+#include "../media-server/include/codecs.h"
 #include "../media-server/include/tools.h"
 #include "../media-server/include/rtpsession.h"
 #include "../media-server/include/DTLSICETransport.h"
@@ -432,6 +441,19 @@ public:
 	{
 		return DTLSConnection::GetCertificateFingerPrint(DTLSConnection::Hash::SHA256);
 	}
+	
+	static bool SetCertificate(const char* cert, const char* key)
+	{
+		//Reload the DTLS context, established connections keep their session
+		DTLSConnection::SetCertificate(cert,key);
+		return DTLSConnection::Initialize();
+	}
+
+	static bool SupportsCodec(const char* name)
+	{
+		//Codecs unknown to the native build are mapped to UNKNOWN
+		return VideoCodec::GetCodecForName(name)!=VideoCodec::UNKNOWN || AudioCodec::GetCodecForName(name)!=AudioCodec::UNKNOWN;
+	}
 };
 
 
@@ -503,6 +525,23 @@ public:
 };
 
 
+//Errors logged by the mp4 writer, it does not report write failures to the recorder nor say which file failed
+static std::atomic<uint32_t> mp4WriteErrors = {0};
+static std::once_flag mp4LogOnce;
+
+static void OnMP4Log(MP4LogLevel level, const char* fmt, va_list ap)
+{
+	char msg[1024];
+	vsnprintf(msg,sizeof(msg),fmt,ap);
+	if (level<=MP4_LOG_ERROR)
+	{
+		mp4WriteErrors++;
+		Error("-MP4Recorder: %s\n",msg);
+	} else {
+		Debug("-MP4Recorder: %s\n",msg);
+	}
+}
+
 class MP4RecorderFacade :
     public MP4Recorder,
     public MP4Recorder::Listener
@@ -511,7 +550,14 @@ public:
     MP4RecorderFacade() :
         MP4Recorder(this)
     {
+        std::call_once(mp4LogOnce,[](){ MP4SetLogCallback(OnMP4Log); });
+        errors = mp4WriteErrors.load();
+    }
 
+    //Errors logged by the mp4 writer since this recorder was created, by any recorder
+    uint32_t GetWriteErrors()
+    {
+        return mp4WriteErrors.load()-errors;
     }
 
     void onFirstFrame(QWORD time) override
@@ -523,6 +569,9 @@ public:
     {
         // todo
     }
+
+private:
+    uint32_t errors = 0;
 };
 
 
@@ -596,8 +645,13 @@ public:
 	{
 
 
+		// Copy the packet, the caller buffer is not valid after returning
+		std::vector<uint8_t> buffer(data, data + size);
+
 		// Run on thread
-		loop.Async([=](...)  {
+		loop.Async([=, buffer = std::move(buffer)](...)  {
+
+			uint8_t* data = (uint8_t*)buffer.data();
 
 			RTPHeader header;
 			RTPHeaderExtension extension;
@@ -733,6 +787,11 @@ public:
 	{
 		sender = session;
 	}
+
+	RTPSenderFacade(RTPSender* sender)
+	{
+		this->sender = sender;
+	}
 	
 	RTPSender* get() { return sender;}
 private:
@@ -794,392 +853,791 @@ RTPReceiverFacade* RTPSessionToReceiver(MediaFrameSessionFacade* session)
 	return new RTPReceiverFacade(session);
 }
 
+uint32_t MediaFrameGetLength(const MediaFrame* frame)
+{
+	return frame->GetLength();
+}
 
+uint64_t MediaFrameGetTimestamp(const MediaFrame* frame)
+{
+	return frame->GetTimeStamp();
+}
 
+uint32_t MediaFrameGetClockRate(const MediaFrame* frame)
+{
+	return frame->GetClockRate();
+}
 
-class RTPStreamTransponderFacade : 
-	public RTPStreamTransponder
+bool MediaFrameIsIntra(const MediaFrame* frame)
 {
-public:
-	RTPStreamTransponderFacade(RTPOutgoingSourceGroup* outgoing,RTPSenderFacade* sender) :
-		RTPStreamTransponder(outgoing, sender ? sender->get() : NULL)
-	{}
+	return frame->GetType()!=MediaFrame::Video || static_cast<const VideoFrame*>(frame)->IsIntra();
+}
 
-	bool SetIncoming(RTPIncomingMediaStream* incoming, RTPReceiverFacade* receiver)
+void MediaFrameCopyData(const MediaFrame* frame, uint8_t* data, int size)
+{
+	memcpy(data, frame->GetData(), std::min<int>(size, frame->GetLength()));
+}
+
+bool TransportSendSenderReport(DTLSICETransport* transport, RTPOutgoingSourceGroup* group)
+{
+	if (!transport || !group)
+		return false;
+	//Build it on the transport thread where the source stats are updated
+	transport->GetTimeService().Async([=](...){
+		auto rtcp = RTCPCompoundPacket::Create();
+		rtcp->AddPacket(group->media.CreateSenderReport(getTime()));
+		transport->Send(rtcp);
+	});
+	return true;
+}
+
+bool TransportSendRTCPApp(DTLSICETransport* transport, DWORD ssrc, int subtype, const char* name, const uint8_t* data, int size)
+{
+	if (!transport || !name || strlen(name)!=4 || subtype<0 || subtype>31 || size<0 || size%4)
+		return false;
+	std::string app_name(name, 4);
+	std::vector<uint8_t> payload(data, data+size);
+	//Serialize it on the transport thread like any other rtcp
+	transport->GetTimeService().Async([=](...){
+		auto rtcp = RTCPCompoundPacket::Create();
+		auto app = rtcp->CreatePacket<RTCPApp>();
+		app->SetSSRC(ssrc);
+		app->SetSubType(subtype);
+		app->SetName(app_name.c_str());
+		app->SetData(payload.data(), payload.size());
+		transport->Send(rtcp);
+	});
+	return true;
+}
+
+bool TransportSendREMB(DTLSICETransport* transport, DWORD ssrc, DWORD bitrate, const uint8_t* ssrcs, int size)
+{
+	if (!transport || !ssrcs || size<=0 || size%4)
+		return false;
+	//Media ssrcs are packed in network order
+	std::list<DWORD> media;
+	for (int i=0; i<size; i+=4)
+		media.push_back(get4(ssrcs,i));
+	//Serialize it on the transport thread like any other rtcp
+	transport->GetTimeService().Async([=](...){
+		auto rtcp = RTCPCompoundPacket::Create();
+		auto remb = rtcp->CreatePacket<RTCPPayloadFeedback>(RTCPPayloadFeedback::ApplicationLayerFeeedbackMessage, ssrc, DWORD(0));
+		remb->AddField(RTCPPayloadFeedback::ApplicationLayerFeeedbackField::CreateReceiverEstimatedMaxBitrate(media, bitrate));
+		transport->Send(rtcp);
+	});
+	return true;
+}
+
+bool TransportSendBYE(DTLSICETransport* transport, const uint8_t* ssrcs, int size)
+{
+	if (!transport || !ssrcs || size<=0 || size%4)
+		return false;
+	//Ssrcs are packed in network order
+	std::vector<DWORD> byes;
+	for (int i=0; i<size; i+=4)
+		byes.push_back(get4(ssrcs,i));
+	//Queued before the removal of the source group, which syncs with the transport thread
+	transport->GetTimeService().Async([=](...){
+		auto rtcp = RTCPCompoundPacket::Create();
+		rtcp->CreatePacket<RTCPBye>(byes,"stopped");
+		transport->Send(rtcp);
+	});
+	return true;
+}
+
+class TimeServiceProbe
+{
+public:
+	TimeServiceProbe(TimeService& timeService) :
+		timeService(timeService),
+		state(std::make_shared<State>())
 	{
-		return RTPStreamTransponder::SetIncoming(incoming, receiver ? receiver->get() : NULL);
 	}
 
-	bool SetIncoming(RTPIncomingMediaStream* incoming, RTPReceiver* receiver)
+	void Ping()
 	{
-		return RTPStreamTransponder::SetIncoming(incoming, receiver);
+		//Only one probe task in flight
+		if (state->pending.exchange(true))
+			return;
+		state->pinged = getTimeMS();
+		auto state = this->state;
+		timeService.Async([state](...){
+			state->pending = false;
+		});
 	}
-	
-	virtual void onREMB(RTPOutgoingSourceGroup* group,DWORD ssrc, DWORD bitrate) override
+
+	uint64_t GetPendingTime()
 	{
-		// todo  make callback
-		// Log("onREMB\n");
+		if (!state->pending)
+			return 0;
+		return getTimeMS() - state->pinged;
 	}
-	void SetMinPeriod(DWORD period) { this->period = period; }
-
 private:
-	DWORD period = 1000;
-	QWORD last = 0;
+	struct State
+	{
+		std::atomic<bool> pending = {false};
+		std::atomic<uint64_t> pinged = {0};
+	};
+	TimeService& timeService;
+	std::shared_ptr<State> state;
 };
 
-
-
-class StreamTrackDepacketizer :
-	public RTPIncomingMediaStream::Listener
+class SimulcastFrameSelector :
+	public MediaFrame::Listener
 {
 public:
-	StreamTrackDepacketizer(RTPIncomingMediaStream* incomingSource)
+	SimulcastFrameSelector(MediaFrame::Listener* output) :
+		output(output)
 	{
-		//Store
-		this->incomingSource = incomingSource;
-		//Add us as RTP listeners
-		this->incomingSource->AddListener(this);
-		//No depkacketixer yet
-		depacketizer = NULL;
 	}
 
-	virtual ~StreamTrackDepacketizer()
+	void Select(DWORD ssrc)
 	{
-		//JIC
-		Stop();
-		//Check 
-		if (depacketizer)
-			//Delete depacketier
-			delete(depacketizer);
+		std::lock_guard<std::mutex> lock(mutex);
+		//First encoding is used straight away, next ones at their first intra frame
+		if (!selected)
+			selected = ssrc;
+		else
+			pending = ssrc!=selected ? ssrc : 0;
 	}
 
-	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet)
+	DWORD GetSelected()
 	{
+		std::lock_guard<std::mutex> lock(mutex);
+		return selected;
+	}
 
-	    if (listeners.empty())
-	           return;
+	virtual void onMediaFrame(const MediaFrame &frame) override
+	{
+	}
 
+	virtual void onMediaFrame(DWORD ssrc, const MediaFrame &frame) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
 
-		//If depacketizer is not the same codec 
-		if (depacketizer && depacketizer->GetCodec()!=packet->GetCodec())
+		//Switch when the pending encoding can be decoded
+		if (pending && ssrc==pending && IsIntra(frame))
 		{
-			//Delete it
-			delete(depacketizer);
-			//Create it next
-			depacketizer = NULL;
+			selected = pending;
+			pending = 0;
+			rebase = true;
 		}
-		//If we don't have a depacketized
-		if (!depacketizer)
-			//Create one
-			depacketizer = RTPDepacketizer::Create(packet->GetMedia(),packet->GetCodec());
-		//Ensure we have it
-		if (!depacketizer)
-			//Do nothing
+
+		if (ssrc!=selected)
 			return;
-		//Pass the pakcet to the depacketizer
-		 MediaFrame* frame = depacketizer->AddPacket(packet);
-		 
-		 //If we have a new frame
-		 if (frame)
-		 {
-			 //Call all listeners
-			 for (const auto& listener : listeners)
-				 //Call listener
-				 listener->onMediaFrame(packet->GetSSRC(),*frame);
-			 //Next
-			 depacketizer->ResetFrame();
-		 }	
+
+		//All encodings are written with the first ssrc, so they end in the same track
+		if (!output_ssrc)
+			output_ssrc = ssrc;
+
+		//Continue the timestamps of the previous encoding using the elapsed wall clock time
+		if (rebase)
+		{
+			QWORD elapsed = frame.GetTime()>last_time ? frame.GetTime()-last_time : 0;
+			offset = last_timestamp + elapsed*frame.GetClockRate()/1000 - frame.GetTimeStamp();
+			rebase = false;
+		}
+
+		std::unique_ptr<MediaFrame> cloned(frame.Clone());
+		cloned->SetTimestamp(frame.GetTimeStamp()+offset);
+
+		last_timestamp = cloned->GetTimeStamp();
+		last_time = frame.GetTime();
+
+		output->onMediaFrame(output_ssrc,*cloned);
 	}
 
-	virtual void onBye(RTPIncomingMediaStream* group) 
+private:
+	static bool IsIntra(const MediaFrame &frame)
 	{
-		if(depacketizer)
-			//Skip current
-			depacketizer->ResetFrame();
+		return frame.GetType()!=MediaFrame::Video || static_cast<const VideoFrame&>(frame).IsIntra();
 	}
-	
-	virtual void onEnded(RTPIncomingMediaStream* group) 
+
+	MediaFrame::Listener* output;
+	std::mutex mutex;
+	DWORD selected = 0;
+	DWORD pending = 0;
+	DWORD output_ssrc = 0;
+	bool rebase = false;
+	QWORD offset = 0;
+	QWORD last_timestamp = 0;
+	QWORD last_time = 0;
+};
+
+class RTPSenderTee :
+	public RTPSender
+{
+public:
+	RTPSenderTee(RTPSenderFacade* sender, MediaFrame::Listener* listener) :
+		sender(sender->get()),
+		facade(this),
+		state(std::make_shared<State>())
 	{
-		if (incomingSource==group)
-			incomingSource = nullptr;
+		state->listener = listener;
 	}
-	
-	void AddMediaListener(MediaFrame::Listener *listener)
+
+	virtual ~RTPSenderTee()
 	{
-		//Add to set
-		if (!incomingSource || !listener)
-			//Done
-			return;
-		//Add listener async
-		incomingSource->GetTimeService().Async([=](...){
-			//Add to set
-			listeners.insert(listener);
-		});
+		Stop();
 	}
-	
-	void RemoveMediaListener(MediaFrame::Listener *listener)
+
+	virtual int Enqueue(const RTPPacket::shared& packet) override
 	{
-		//Remove from set
-		if (!incomingSource)
-			//Done
-			return;
+		state->Tee(packet);
+		return sender->Enqueue(packet);
+	}
 
-		//Add listener sync so it can be deleted after this call
-		incomingSource->GetTimeService().Sync([=](...){
-			//Remove from set
-			listeners.erase(listener);
+	virtual int Enqueue(const RTPPacket::shared& packet,std::function<RTPPacket::shared(const RTPPacket::shared&)> modifier) override
+	{
+		//Tee the packet as modified by the transponder, the state outlives us if the packet is still queued
+		auto state = this->state;
+		return sender->Enqueue(packet,[state,modifier](const RTPPacket::shared& original) {
+			auto modified = modifier(original);
+			state->Tee(modified);
+			return modified;
 		});
 	}
-	
+
+	RTPSenderFacade* GetSender() { return &facade; }
+
 	void Stop()
 	{
-		//If already stopped
-		if (!incomingSource)
-			//Done
-			return;
-		
-		//Stop listeneing
-		incomingSource->RemoveListener(this);
-		//Clean it
-		incomingSource = NULL;
+		std::lock_guard<std::mutex> lock(state->mutex);
+		state->listener = nullptr;
 	}
-	
+
 private:
-    std::set<MediaFrame::Listener*> listeners;
-	RTPDepacketizer* depacketizer;
-	RTPIncomingMediaStream* incomingSource;
-};
+	struct State
+	{
+		std::mutex mutex;
+		MediaFrame::Listener* listener = nullptr;
+		std::unique_ptr<RTPDepacketizer> depacketizer;
 
+		void Tee(const RTPPacket::shared& packet)
+		{
+			std::lock_guard<std::mutex> lock(mutex);
+			if (!listener || !packet)
+				return;
+			if (depacketizer && depacketizer->GetCodec()!=packet->GetCodec())
+				depacketizer.reset();
+			if (!depacketizer)
+				depacketizer.reset(RTPDepacketizer::Create(packet->GetMedia(),packet->GetCodec()));
+			if (!depacketizer)
+				return;
+			MediaFrame* frame = depacketizer->AddPacket(packet);
+			if (frame)
+			{
+				listener->onMediaFrame(packet->GetSSRC(),*frame);
+				depacketizer->ResetFrame();
+			}
+		}
+	};
 
+	RTPSender* sender;
+	RTPSenderFacade facade;
+	std::shared_ptr<State> state;
+};
 
-class DTLSICETransportListener :
-	public DTLSICETransport::Listener
+class RTPPacer :
+	public RTPSender
 {
 public:
-	DTLSICETransportListener()
+	RTPPacer(RTPSenderFacade* sender, TimeService& timeService) :
+		facade(this),
+		state(std::make_shared<State>())
 	{
+		state->sender = sender->get();
+		auto state = this->state;
+		timer = timeService.CreateTimer(std::chrono::milliseconds(0), std::chrono::milliseconds(5), [state](std::chrono::milliseconds now){
+			state->Flush(now.count());
+		});
+	}
 
- 	}
-
- 	virtual ~DTLSICETransportListener() = default;
-
- 	virtual void onRemoteICECandidateActivated(const std::string& ip, uint16_t port, uint32_t priority) override
- 	{
-
- 	    // todo
- 	}
-
- 	virtual void onDTLSStateChanged(const DTLSICETransport::DTLSState state) override 
+	virtual ~RTPPacer()
 	{
+		Stop();
+	}
 
-		switch(state)
-		{
-			case DTLSICETransport::DTLSState::New:
-				onDTLSStateChange(0);
-				break;
-			case DTLSICETransport::DTLSState::Connecting:
-				onDTLSStateChange(1);
-				break;
-			case DTLSICETransport::DTLSState::Connected:
-				onDTLSStateChange(2);
-				break;
-			case DTLSICETransport::DTLSState::Closed:
-				onDTLSStateChange(3);
-				break;
-			case DTLSICETransport::DTLSState::Failed:
-				onDTLSStateChange(4);
-				break;
-		}
+	virtual int Enqueue(const RTPPacket::shared& packet) override
+	{
+		return state->Push(packet,nullptr);
 	}
 
-	virtual void onDTLSStateChange(uint32_t state)
+	virtual int Enqueue(const RTPPacket::shared& packet,std::function<RTPPacket::shared(const RTPPacket::shared&)> modifier) override
 	{
+		return state->Push(packet,modifier);
+	}
 
+	//Bitrate in bps, 0 sends as soon as possible, burst in bytes and queue limit in packets, 0 for no limit
+	void Configure(DWORD bitrate, DWORD burst, DWORD limit)
+	{
+		std::lock_guard<std::mutex> lock(state->mutex);
+		state->bitrate = bitrate;
+		//At least one full packet must fit or nothing would be sent
+		state->burst = std::max<DWORD>(burst,1500);
+		state->limit = limit;
 	}
-};
 
+	RTPSenderFacade* GetSender() { return &facade; }
 
+	DWORD GetQueued()
+	{
+		std::lock_guard<std::mutex> lock(state->mutex);
+		return state->queue.size();
+	}
 
+	QWORD GetSent()
+	{
+		std::lock_guard<std::mutex> lock(state->mutex);
+		return state->sent;
+	}
 
-class SenderSideEstimatorListener : 
-	public RemoteRateEstimator::Listener
-{
-public:
-	SenderSideEstimatorListener()
+	QWORD GetDropped()
 	{
-		
+		std::lock_guard<std::mutex> lock(state->mutex);
+		return state->dropped;
 	}
-	
-	virtual void onTargetBitrateRequested(DWORD bitrate) override 
+
+	void Stop()
 	{
-        // todo make callback
+		if (timer)
+			timer->Cancel();
+		timer.reset();
+		std::lock_guard<std::mutex> lock(state->mutex);
+		state->sender = nullptr;
+		state->queue.clear();
 	}
 
 private:
-	DWORD period  = 500;
-	QWORD last = 0;
-};
+	struct Item
+	{
+		RTPPacket::shared packet;
+		std::function<RTPPacket::shared(const RTPPacket::shared&)> modifier;
+	};
 
+	struct State
+	{
+		std::mutex mutex;
+		RTPSender* sender = nullptr;
+		std::deque<Item> queue;
+		DWORD bitrate = 0;
+		DWORD burst = 1500;
+		DWORD limit = 0;
+		double tokens = 0;
+		QWORD last = 0;
+		QWORD sent = 0;
+		QWORD dropped = 0;
+
+		int Push(const RTPPacket::shared& packet,std::function<RTPPacket::shared(const RTPPacket::shared&)> modifier)
+		{
+			std::lock_guard<std::mutex> lock(mutex);
+			if (!sender || !packet)
+				return 0;
+			//Drop the oldest, it is the most likely to be useless by now
+			if (limit && queue.size()>=limit)
+			{
+				queue.pop_front();
+				dropped++;
+			}
+			queue.push_back({packet,modifier});
+			return 1;
+		}
 
+		void Flush(QWORD now)
+		{
+			std::lock_guard<std::mutex> lock(mutex);
+			if (!sender)
+				return;
+			//Token bucket refilled at the pacing bitrate up to the burst size
+			if (last && bitrate)
+				tokens = std::min<double>(burst,tokens + (now-last)*bitrate/8000.0);
+			last = now;
+			while (!queue.empty())
+			{
+				auto& item = queue.front();
+				DWORD size = 12 + item.packet->GetMediaLength();
+				if (bitrate && tokens<size)
+					break;
+				if (bitrate)
+					tokens -= size;
+				if (item.modifier)
+					sender->Enqueue(item.packet,item.modifier);
+				else
+					sender->Enqueue(item.packet);
+				sent++;
+				queue.pop_front();
+			}
+		}
+	};
 
-EvenSource::EvenSource()
-{
-}
+	RTPSenderFacade facade;
+	std::shared_ptr<State> state;
+	Timer::shared timer;
+};
 
-EvenSource::EvenSource(const char* str)
+class RTPDumpWriter :
+	public RTPIncomingMediaStream::Listener
 {
-}
+public:
+	RTPDumpWriter() = default;
 
-EvenSource::EvenSource(const std::wstring &str)
-{
-}
+	virtual ~RTPDumpWriter()
+	{
+		Close();
+	}
 
-EvenSource::~EvenSource()
-{
-}
+	bool Open(const char* filename)
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (fd)
+			return false;
+		if (!(fd = fopen(filename,"wb")))
+			return false;
+		start = getTimeMS();
+		//rtpdump file header, no source address known
+		fprintf(fd,"#!rtpplay1.0 0.0.0.0/0\n");
+		BYTE header[16] = {};
+		set4(header,0,start/1000);
+		set4(header,4,(start%1000)*1000);
+		fwrite(header,sizeof(header),1,fd);
+		return true;
+	}
 
-void EvenSource::SendEvent(const char* type,const char* msg,...)
-{
-}
+	void AddIncoming(RTPIncomingMediaStream* incoming)
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (!fd || !incoming || !incomings.insert(incoming).second)
+			return;
+		incoming->AddListener(this);
+	}
 
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (!fd)
+			return;
 
-class LayerSources : public std::vector<LayerSource*>
-{
-public:
-	size_t size() const  { return std::vector<LayerSource*>::size(); }
-	LayerSource* get(size_t i)	{ return  std::vector<LayerSource*>::at(i); }
-};
+		//Header extensions are not kept by the parsed packet, the fixed header is rebuilt
+		DWORD len = 12 + packet->GetMediaLength();
+		BYTE header[20];
+		set2(header,0,len+8);
+		set2(header,2,len);
+		set4(header,4,packet->GetTime()>start ? packet->GetTime()-start : 0);
+		header[8] = 0x80;
+		header[9] = (packet->GetMark() ? 0x80 : 0x00) | (packet->GetPayloadType() & 0x7F);
+		set2(header,10,packet->GetSeqNum());
+		set4(header,12,packet->GetTimestamp());
+		set4(header,16,packet->GetSSRC());
+
+		fwrite(header,sizeof(header),1,fd);
+		fwrite(packet->GetMediaData(),packet->GetMediaLength(),1,fd);
+	}
 
+	virtual void onBye(RTPIncomingMediaStream* group) override
+	{
+	}
 
+	virtual void onEnded(RTPIncomingMediaStream* group) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		incomings.erase(group);
+	}
 
-class ActiveSpeakerDetectorFacade :
-	public ActiveSpeakerDetector,
-	public ActiveSpeakerDetector::Listener,
+	void Close()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		for (auto incoming : incomings)
+			incoming->RemoveListener(this);
+		incomings.clear();
+		if (fd)
+			fclose(fd);
+		fd = nullptr;
+	}
+
+private:
+	std::mutex mutex;
+	FILE* fd = nullptr;
+	QWORD start = 0;
+	std::set<RTPIncomingMediaStream*> incomings;
+};
+
+class AudioLevelMeter :
 	public RTPIncomingMediaStream::Listener
 {
-public:	
-	ActiveSpeakerDetectorFacade(ActiveTrackListener* listener) :
-		ActiveSpeakerDetector(this),
-		listener(listener)
-	{};
-		
-	virtual void onActiveSpeakerChanded(uint32_t id) override
+public:
+	AudioLevelMeter(RTPIncomingMediaStream* incoming)
 	{
-        // todo make callback
+		if (!incoming)
+			return;
+		this->incoming = incoming;
+		incoming->AddListener(this);
+	}
 
-		if (listener) 
-		{
-			listener->onActiveTrackchanged(id);
-		}
+	virtual ~AudioLevelMeter()
+	{
+		Stop();
 	}
-	
-	void AddIncomingSourceGroup(RTPIncomingMediaStream* incoming, uint32_t id)
+
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet) override
 	{
-			if (incoming)
-    		{
-    			ScopedLock lock(mutex);
-    			//Insert new
-    			auto [it,inserted] = sources.try_emplace(incoming,id);
-    			//If already present
-    			if (!inserted)
-    				//do nothing
-    				return;
-    			//Add us as rtp listeners
-    			incoming->AddListener(this);
-    			//initialize to silence
-    			ActiveSpeakerDetector::Accumulate(id, false, 127, getTimeMS());
-    		}
+		if (!packet->HasAudioLevel())
+			return;
+		//Keep the loudest level, in -dBov so the lowest value, until read
+		int level = packet->GetLevel() & 0x7F;
+		int current = loudest.load();
+		while (level < current && !loudest.compare_exchange_weak(current,level))
+			;
 	}
-	
-	void RemoveIncomingSourceGroup(RTPIncomingMediaStream* incoming)
+
+	virtual void onBye(RTPIncomingMediaStream* group) override
+	{
+	}
+
+	virtual void onEnded(RTPIncomingMediaStream* group) override
 	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming==group)
+			incoming = nullptr;
+	}
+
+	//Loudest level received since the previous read in -dBov, 127 (silence) if none
+	BYTE ReadLevel()
+	{
+		return (BYTE)loudest.exchange(127);
+	}
+
+	void Stop()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
 		if (incoming)
-		{	
-			ScopedLock lock(mutex);
-			//Get map
-			auto it = sources.find(incoming);
-			//check it was present
-			if (it==sources.end())
-				//Do nothing
-				return;
-			//Remove listener
 			incoming->RemoveListener(this);
-			//RElease id
-			ActiveSpeakerDetector::Release(it->second);
-			//Erase
-			sources.erase(it);
-		}
+		incoming = nullptr;
 	}
-	
-	virtual void onRTP(RTPIncomingMediaStream* incoming,const RTPPacket::shared& packet) override
+
+private:
+	std::mutex mutex;
+	std::atomic<int> loudest = {127};
+	RTPIncomingMediaStream* incoming = nullptr;
+};
+
+class PayloadCodecMonitor :
+	public RTPIncomingMediaStream::Listener
+{
+public:
+	PayloadCodecMonitor(RTPIncomingMediaStream* incoming)
 	{
-        if (packet->HasAudioLevel())
-        {
-            ScopedLock lock(mutex);
-            //Get map
-            auto it = sources.find(incoming);
-            //check it was present
-            if (it==sources.end())
-                //Do nothing
-                return;
-            //Accumulate on id
-            ActiveSpeakerDetector::Accumulate(it->second, packet->GetVAD(),packet->GetLevel(), getTimeMS());
-        }
-	}		
+		if (!incoming)
+			return;
+		this->incoming = incoming;
+		incoming->AddListener(this);
+	}
 
-	virtual void onBye(RTPIncomingMediaStream* incoming) override
+	virtual ~PayloadCodecMonitor()
+	{
+		Stop();
+	}
+
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet) override
 	{
+		media = packet->GetMedia();
+		codec = packet->GetCodec();
+	}
 
+	virtual void onBye(RTPIncomingMediaStream* group) override
+	{
 	}
-	
-	virtual void onEnded(RTPIncomingMediaStream* incoming) override
+
+	virtual void onEnded(RTPIncomingMediaStream* group) override
 	{
-		if (incoming)
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming==group)
+			incoming = nullptr;
+	}
+
+	//Name of the codec of the last RTP packet received, empty if none
+	const char* GetCodec()
+	{
+		int last = codec.load();
+		if (last<0)
+			return "";
+		switch (media.load())
 		{
-			ScopedLock lock(mutex);
-			//Get map
-			auto it = sources.find(incoming);
-			//check it was present
-			if (it==sources.end())
-				//Do nothing
-			//Release id
-			ActiveSpeakerDetector::Release(it->second);
-			//Erase
-			sources.erase(it);
+			case MediaFrame::Audio:
+				return AudioCodec::GetNameFor((AudioCodec::Type)last);
+			case MediaFrame::Video:
+				return VideoCodec::GetNameFor((VideoCodec::Type)last);
+			default:
+				return "";
 		}
 	}
+
+	void Stop()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming)
+			incoming->RemoveListener(this);
+		incoming = nullptr;
+	}
+
 private:
-	Mutex mutex;
-	std::map<RTPIncomingMediaStream*,uint32_t> sources;
-	ActiveTrackListener* listener;
+	std::mutex mutex;
+	std::atomic<int> media = {-1};
+	std::atomic<int> codec = {-1};
+	RTPIncomingMediaStream* incoming = nullptr;
 };
 
 
-class  MediaFrameListenerFacade:
-	public MediaFrameListener
+
+
+
+class RTPStreamTransponderFacade : 
+	public RTPStreamTransponder
 {
 public:
-	MediaFrameListenerFacade()
+	RTPStreamTransponderFacade(RTPOutgoingSourceGroup* outgoing,RTPSenderFacade* sender) :
+		RTPStreamTransponder(outgoing, sender ? sender->get() : NULL)
+	{}
+
+	bool SetIncoming(RTPIncomingMediaStream* incoming, RTPReceiverFacade* receiver)
 	{
+		return RTPStreamTransponder::SetIncoming(incoming, receiver ? receiver->get() : NULL);
+	}
 
+	bool SetIncoming(RTPIncomingMediaStream* incoming, RTPReceiver* receiver)
+	{
+		return RTPStreamTransponder::SetIncoming(incoming, receiver);
+	}
+	
+	virtual void onPLIRequest(RTPOutgoingSourceGroup* group,DWORD ssrc) override
+	{
+		//FIRs are reported as PLIs too
+		totalPLIRequests++;
+		RTPStreamTransponder::onPLIRequest(group,ssrc);
 	}
 
-	virtual void onMediaFrame(const MediaFrame &frame)  {
+	virtual void onREMB(RTPOutgoingSourceGroup* group,DWORD ssrc, DWORD bitrate) override
+	{
+		totalREMBs++;
+		lastREMB = bitrate;
+	}
+	void SetMinPeriod(DWORD period) { this->period = period; }
+
+	DWORD GetTotalPLIRequests()	{ return totalPLIRequests;	}
+	DWORD GetTotalREMBs()		{ return totalREMBs;		}
+	DWORD GetLastREMB()		{ return lastREMB;		}
+
+private:
+	DWORD period = 1000;
+	QWORD last = 0;
+	std::atomic<DWORD> totalPLIRequests = {0};
+	std::atomic<DWORD> totalREMBs = {0};
+	std::atomic<DWORD> lastREMB = {0};
+};
+
 
+
+class KeyframeCache :
+	public RTPIncomingMediaStream::Listener
+{
+public:
+	static constexpr DWORD MaxPackets = 512;
+
+	KeyframeCache(RTPIncomingMediaStream* incoming)
+	{
+		if (!incoming)
+			return;
+		this->incoming = incoming;
+		incoming->AddListener(this);
 	}
-	virtual void onMediaFrame(DWORD ssrc, const MediaFrame &frame) {
 
-		onMediaFrame(frame);
+	virtual ~KeyframeCache()
+	{
+		Stop();
 	}
-	
+
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		//New frame, the SPS/PPS are sent with the timestamp of the IDR
+		if (frame.empty() || packet->GetTimestamp()!=timestamp)
+		{
+			frame.clear();
+			timestamp = packet->GetTimestamp();
+			intra = false;
+		}
+		//Do not grow if the marker is lost
+		if (frame.size()>=MaxPackets)
+			return;
+		frame.push_back(packet->Clone());
+		intra |= packet->IsKeyFrame();
+		//Keep only complete keyframes
+		if (packet->GetMark() && intra)
+		{
+			keyframe = std::make_shared<std::vector<RTPPacket::shared>>(std::move(frame));
+			frame.clear();
+			cached = getTimeMS();
+		}
+	}
+
+	virtual void onBye(RTPIncomingMediaStream* group) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		frame.clear();
+	}
+
+	virtual void onEnded(RTPIncomingMediaStream* group) override
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming==group)
+			incoming = nullptr;
+	}
+
+	//Send the last keyframe through the transponder unless it is older than maxAge ms, 0 for no limit
+	bool Replay(RTPStreamTransponderFacade* transponder, DWORD maxAge)
+	{
+		std::shared_ptr<std::vector<RTPPacket::shared>> packets;
+		RTPIncomingMediaStream* stream;
+		{
+			std::lock_guard<std::mutex> lock(mutex);
+			if (!transponder || !incoming || !keyframe || (maxAge && getTimeMS()-cached>maxAge))
+				return false;
+			packets = keyframe;
+			stream = incoming;
+		}
+		//Run on the media thread, before the transponder can be closed as closing syncs with it
+		stream->GetTimeService().Async([=](...){
+			for (const auto& packet : *packets)
+				transponder->onRTP(stream,packet->Clone());
+		});
+		return true;
+	}
+
+	void Stop()
+	{
+		std::lock_guard<std::mutex> lock(mutex);
+		if (incoming)
+			incoming->RemoveListener(this);
+		incoming = nullptr;
+		keyframe.reset();
+		frame.clear();
+	}
+
+private:
+	std::mutex mutex;
+	RTPIncomingMediaStream* incoming = nullptr;
+	std::vector<RTPPacket::shared> frame;
+	std::shared_ptr<std::vector<RTPPacket::shared>> keyframe;
+	DWORD timestamp = 0;
+	bool intra = false;
+	QWORD cached = 0;
 };
 
 
-class MediaFrameMultiplexer :
+class StreamTrackDepacketizer :
 	public RTPIncomingMediaStream::Listener
 {
 public:
-	MediaFrameMultiplexer(RTPIncomingMediaStream* incomingSource)
+	StreamTrackDepacketizer(RTPIncomingMediaStream* incomingSource)
 	{
 		//Store
 		this->incomingSource = incomingSource;
@@ -1189,7 +1647,7 @@ public:
 		depacketizer = NULL;
 	}
 
-	virtual ~MediaFrameMultiplexer()
+	virtual ~StreamTrackDepacketizer()
 	{
 		//JIC
 		Stop();
@@ -1202,10 +1660,11 @@ public:
 	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet)
 	{
 
-		if (listeners.empty()) 
-			return;
+	    if (listeners.empty())
+	           return;
 
 
+		//If depacketizer is not the same codec 
 		if (depacketizer && depacketizer->GetCodec()!=packet->GetCodec())
 		{
 			//Delete it
@@ -1228,13 +1687,12 @@ public:
 		 if (frame)
 		 {
 			 //Call all listeners
-			 for (Listeners::const_iterator it = listeners.begin();it!=listeners.end();++it)
+			 for (const auto& listener : listeners)
 				 //Call listener
-				 (*it)->onMediaFrame(*frame);
+				 listener->onMediaFrame(packet->GetSSRC(),*frame);
 			 //Next
 			 depacketizer->ResetFrame();
-		 }
-
+		 }	
 	}
 
 	virtual void onBye(RTPIncomingMediaStream* group) 
@@ -1250,16 +1708,31 @@ public:
 			incomingSource = nullptr;
 	}
 	
-	void AddMediaListener(MediaFrameListener *listener)
+	void AddMediaListener(MediaFrame::Listener *listener)
 	{
 		//Add to set
-		listeners.insert(listener);
+		if (!incomingSource || !listener)
+			//Done
+			return;
+		//Add listener async
+		incomingSource->GetTimeService().Async([=](...){
+			//Add to set
+			listeners.insert(listener);
+		});
 	}
 	
-	void RemoveMediaListener(MediaFrameListener *listener)
+	void RemoveMediaListener(MediaFrame::Listener *listener)
 	{
 		//Remove from set
-		listeners.erase(listener);
+		if (!incomingSource)
+			//Done
+			return;
+
+		//Add listener sync so it can be deleted after this call
+		incomingSource->GetTimeService().Sync([=](...){
+			//Remove from set
+			listeners.erase(listener);
+		});
 	}
 	
 	void Stop()
@@ -1276,1343 +1749,2146 @@ public:
 	}
 	
 private:
-	typedef std::set<MediaFrameListener*> Listeners;
-private:
-	Listeners listeners;
+    std::set<MediaFrame::Listener*> listeners;
 	RTPDepacketizer* depacketizer;
 	RTPIncomingMediaStream* incomingSource;
 };
 
 
 
-#include <stdint.h>		// Use the C99 official header
-
+class DTLSICETransportListener :
+	public DTLSICETransport::Listener
+{
+public:
+	DTLSICETransportListener()
+	{
 
-#include <string>
+ 	}
 
+ 	virtual ~DTLSICETransportListener() = default;
 
-#include <vector>
-#include <stdexcept>
+ 	virtual void onRemoteICECandidateActivated(const std::string& ip, uint16_t port, uint32_t priority) override
+ 	{
 
+ 	    // todo
+ 	}
 
-using MediaFrameType = MediaFrame::Type;
+ 	virtual void onDTLSStateChanged(const DTLSICETransport::DTLSState state) override 
+	{
 
-SWIGINTERN LayerSources RTPIncomingSource_layers(RTPIncomingSource *self){
-			LayerSources layers;
-			for(auto it = self->layers.begin(); it != self->layers.end(); ++it )
-				layers.push_back(&(it->second));
-			return layers;
+		switch(state)
+		{
+			case DTLSICETransport::DTLSState::New:
+				onDTLSStateChange(0);
+				break;
+			case DTLSICETransport::DTLSState::Connecting:
+				onDTLSStateChange(1);
+				break;
+			case DTLSICETransport::DTLSState::Connected:
+				onDTLSStateChange(2);
+				break;
+			case DTLSICETransport::DTLSState::Closed:
+				onDTLSStateChange(3);
+				break;
+			case DTLSICETransport::DTLSState::Failed:
+				onDTLSStateChange(4);
+				break;
 		}
+	}
 
-using RTPIncomingMediaStreamListener = RTPIncomingMediaStream::Listener;
-
+	virtual void onDTLSStateChange(uint32_t state)
+	{
 
-using RemoteRateEstimatorListener = RemoteRateEstimator::Listener;
+	}
+};
 
 
-// C++ director class methods.
-#include "mediaserver_wrap.h"
 
-SwigDirector_DTLSICETransportListener::SwigDirector_DTLSICETransportListener(int swig_p)
-    : DTLSICETransportListener(),
-      go_val(swig_p), swig_mem(0)
-{ }
 
-extern "C" void Swiggo_DeleteDirector_DTLSICETransportListener_native_3e8e6202ec41eede(intgo);
-SwigDirector_DTLSICETransportListener::~SwigDirector_DTLSICETransportListener()
+class SenderSideEstimatorListener : 
+	public RemoteRateEstimator::Listener
 {
-  Swiggo_DeleteDirector_DTLSICETransportListener_native_3e8e6202ec41eede(go_val);
-  delete swig_mem;
-}
-
-extern "C" void Swig_DirectorDTLSICETransportListener_callback_onDTLSStateChange_native_3e8e6202ec41eede(int, intgo arg2);
-void SwigDirector_DTLSICETransportListener::onDTLSStateChange(uint32_t state) {
-  intgo swig_arg2;
-  
-  swig_arg2 = (uint32_t)state; 
-  Swig_DirectorDTLSICETransportListener_callback_onDTLSStateChange_native_3e8e6202ec41eede(go_val, swig_arg2);
-}
+public:
+	SenderSideEstimatorListener()
+	{
+		
+	}
+	
+	virtual void onTargetBitrateRequested(DWORD bitrate) override 
+	{
+        // todo make callback
+	}
 
-SwigDirector_SenderSideEstimatorListener::SwigDirector_SenderSideEstimatorListener(int swig_p)
-    : SenderSideEstimatorListener(),
-      go_val(swig_p), swig_mem(0)
-{ }
+private:
+	DWORD period  = 500;
+	QWORD last = 0;
+};
 
-extern "C" void Swiggo_DeleteDirector_SenderSideEstimatorListener_native_3e8e6202ec41eede(intgo);
-SwigDirector_SenderSideEstimatorListener::~SwigDirector_SenderSideEstimatorListener()
-{
-  Swiggo_DeleteDirector_SenderSideEstimatorListener_native_3e8e6202ec41eede(go_val);
-  delete swig_mem;
-}
 
-SwigDirector_MediaFrameListenerFacade::SwigDirector_MediaFrameListenerFacade(int swig_p)
-    : MediaFrameListenerFacade(),
-      go_val(swig_p), swig_mem(0)
-{ }
 
-extern "C" void Swiggo_DeleteDirector_MediaFrameListenerFacade_native_3e8e6202ec41eede(intgo);
-SwigDirector_MediaFrameListenerFacade::~SwigDirector_MediaFrameListenerFacade()
+EvenSource::EvenSource()
 {
-  Swiggo_DeleteDirector_MediaFrameListenerFacade_native_3e8e6202ec41eede(go_val);
-  delete swig_mem;
-}
-
-extern "C" void Swig_DirectorMediaFrameListenerFacade_callback_onMediaFrame_native_3e8e6202ec41eede(int, MediaFrame *arg2);
-void SwigDirector_MediaFrameListenerFacade::onMediaFrame(MediaFrame const &frame) {
-  MediaFrame *swig_arg2;
-  
-  swig_arg2 = (MediaFrame *)&frame; 
-  Swig_DirectorMediaFrameListenerFacade_callback_onMediaFrame_native_3e8e6202ec41eede(go_val, swig_arg2);
 }
 
-SwigDirector_ActiveTrackListener::SwigDirector_ActiveTrackListener(int swig_p)
-    : ActiveTrackListener(),
-      go_val(swig_p), swig_mem(0)
-{ }
-
-extern "C" void Swiggo_DeleteDirector_ActiveTrackListener_native_3e8e6202ec41eede(intgo);
-SwigDirector_ActiveTrackListener::~SwigDirector_ActiveTrackListener()
+EvenSource::EvenSource(const char* str)
 {
-  Swiggo_DeleteDirector_ActiveTrackListener_native_3e8e6202ec41eede(go_val);
-  delete swig_mem;
-}
-
-extern "C" void Swig_DirectorActiveTrackListener_callback_onActiveTrackchanged_native_3e8e6202ec41eede(int, intgo arg2);
-void SwigDirector_ActiveTrackListener::onActiveTrackchanged(uint32_t id) {
-  intgo swig_arg2;
-  
-  swig_arg2 = (uint32_t)id; 
-  Swig_DirectorActiveTrackListener_callback_onActiveTrackchanged_native_3e8e6202ec41eede(go_val, swig_arg2);
-}
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-void _wrap_Swig_free_native_3e8e6202ec41eede(void *_swig_go_0) {
-  void *arg1 = (void *) 0 ;
-  
-  arg1 = *(void **)&_swig_go_0; 
-  
-  Swig_free(arg1);
-  
 }
 
-
-void *_wrap_Swig_malloc_native_3e8e6202ec41eede(intgo _swig_go_0) {
-  int arg1 ;
-  void *result = 0 ;
-  void *_swig_go_result;
-  
-  arg1 = (int)_swig_go_0; 
-  
-  result = (void *)Swig_malloc(arg1);
-  *(void **)&_swig_go_result = (void *)result; 
-  return _swig_go_result;
+EvenSource::EvenSource(const std::wstring &str)
+{
 }
 
-
-Acumulator *_wrap_new_Acumulator__SWIG_0_native_3e8e6202ec41eede(intgo _swig_go_0, intgo _swig_go_1) {
-  uint32_t arg1 ;
-  uint32_t arg2 ;
-  Acumulator *result = 0 ;
-  Acumulator *_swig_go_result;
-  
-  arg1 = (uint32_t)_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
-  
-  result = (Acumulator *)new Acumulator(arg1,arg2);
-  *(Acumulator **)&_swig_go_result = (Acumulator *)result; 
-  return _swig_go_result;
+EvenSource::~EvenSource()
+{
 }
 
-
-Acumulator *_wrap_new_Acumulator__SWIG_1_native_3e8e6202ec41eede(intgo _swig_go_0) {
-  uint32_t arg1 ;
-  Acumulator *result = 0 ;
-  Acumulator *_swig_go_result;
-  
-  arg1 = (uint32_t)_swig_go_0; 
-  
-  result = (Acumulator *)new Acumulator(arg1);
-  *(Acumulator **)&_swig_go_result = (Acumulator *)result; 
-  return _swig_go_result;
+void EvenSource::SendEvent(const char* type,const char* msg,...)
+{
 }
 
 
-long long _wrap_Acumulator_GetAcumulated_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
-  
-  arg1 = *(Acumulator **)&_swig_go_0; 
-  
-  result = (uint64_t)((Acumulator const *)arg1)->GetAcumulated();
-  _swig_go_result = result; 
-  return _swig_go_result;
-}
+class LayerSources : public std::vector<LayerSource*>
+{
+public:
+	size_t size() const  { return std::vector<LayerSource*>::size(); }
+	LayerSource* get(size_t i)	{ return  std::vector<LayerSource*>::at(i); }
+};
 
 
-long long _wrap_Acumulator_GetDiff_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
-  
-  arg1 = *(Acumulator **)&_swig_go_0; 
-  
-  result = (uint64_t)((Acumulator const *)arg1)->GetDiff();
-  _swig_go_result = result; 
-  return _swig_go_result;
-}
 
+class ActiveSpeakerDetectorFacade :
+	public ActiveSpeakerDetector,
+	public ActiveSpeakerDetector::Listener,
+	public RTPIncomingMediaStream::Listener
+{
+public:	
+	ActiveSpeakerDetectorFacade(ActiveTrackListener* listener) :
+		ActiveSpeakerDetector(this),
+		listener(listener)
+	{};
+		
+	virtual void onActiveSpeakerChanded(uint32_t id) override
+	{
+        // todo make callback
 
-long long _wrap_Acumulator_GetInstant_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+		if (listener) 
+		{
+			listener->onActiveTrackchanged(id);
+		}
+	}
+	
+	void AddIncomingSourceGroup(RTPIncomingMediaStream* incoming, uint32_t id)
+	{
+			if (incoming)
+    		{
+    			ScopedLock lock(mutex);
+    			//Insert new
+    			auto [it,inserted] = sources.try_emplace(incoming,id);
+    			//If already present
+    			if (!inserted)
+    				//do nothing
+    				return;
+    			//Add us as rtp listeners
+    			incoming->AddListener(this);
+    			//initialize to silence
+    			ActiveSpeakerDetector::Accumulate(id, false, 127, getTimeMS());
+    		}
+	}
+	
+	void RemoveIncomingSourceGroup(RTPIncomingMediaStream* incoming)
+	{
+		if (incoming)
+		{	
+			ScopedLock lock(mutex);
+			//Get map
+			auto it = sources.find(incoming);
+			//check it was present
+			if (it==sources.end())
+				//Do nothing
+				return;
+			//Remove listener
+			incoming->RemoveListener(this);
+			//RElease id
+			ActiveSpeakerDetector::Release(it->second);
+			//Erase
+			sources.erase(it);
+		}
+	}
+	
+	virtual void onRTP(RTPIncomingMediaStream* incoming,const RTPPacket::shared& packet) override
+	{
+        if (packet->HasAudioLevel())
+        {
+            ScopedLock lock(mutex);
+            //Get map
+            auto it = sources.find(incoming);
+            //check it was present
+            if (it==sources.end())
+                //Do nothing
+                return;
+            //Accumulate on id
+            ActiveSpeakerDetector::Accumulate(it->second, packet->GetVAD(),packet->GetLevel(), getTimeMS());
+        }
+	}		
+
+	virtual void onBye(RTPIncomingMediaStream* incoming) override
+	{
+
+	}
+	
+	virtual void onEnded(RTPIncomingMediaStream* incoming) override
+	{
+		if (incoming)
+		{
+			ScopedLock lock(mutex);
+			//Get map
+			auto it = sources.find(incoming);
+			//check it was present
+			if (it==sources.end())
+				//Do nothing
+			//Release id
+			ActiveSpeakerDetector::Release(it->second);
+			//Erase
+			sources.erase(it);
+		}
+	}
+private:
+	Mutex mutex;
+	std::map<RTPIncomingMediaStream*,uint32_t> sources;
+	ActiveTrackListener* listener;
+};
+
+
+class  MediaFrameListenerFacade:
+	public MediaFrameListener
+{
+public:
+	MediaFrameListenerFacade()
+	{
+
+	}
+
+	virtual void onMediaFrame(const MediaFrame &frame)  {
+
+	}
+	virtual void onMediaFrame(DWORD ssrc, const MediaFrame &frame) {
+
+		onMediaFrame(frame);
+	}
+	
+};
+
+
+class MediaFrameMultiplexer :
+	public RTPIncomingMediaStream::Listener
+{
+public:
+	MediaFrameMultiplexer(RTPIncomingMediaStream* incomingSource)
+	{
+		//Store
+		this->incomingSource = incomingSource;
+		//Add us as RTP listeners
+		this->incomingSource->AddListener(this);
+		//No depkacketixer yet
+		depacketizer = NULL;
+	}
+
+	virtual ~MediaFrameMultiplexer()
+	{
+		//JIC
+		Stop();
+		//Check 
+		if (depacketizer)
+			//Delete depacketier
+			delete(depacketizer);
+	}
+
+	virtual void onRTP(RTPIncomingMediaStream* group,const RTPPacket::shared& packet)
+	{
+
+		if (listeners.empty()) 
+			return;
+
+
+		if (depacketizer && depacketizer->GetCodec()!=packet->GetCodec())
+		{
+			//Delete it
+			delete(depacketizer);
+			//Create it next
+			depacketizer = NULL;
+		}
+		//If we don't have a depacketized
+		if (!depacketizer)
+			//Create one
+			depacketizer = RTPDepacketizer::Create(packet->GetMedia(),packet->GetCodec());
+		//Ensure we have it
+		if (!depacketizer)
+			//Do nothing
+			return;
+		//Pass the pakcet to the depacketizer
+		 MediaFrame* frame = depacketizer->AddPacket(packet);
+		 
+		 //If we have a new frame
+		 if (frame)
+		 {
+			 //Call all listeners
+			 for (Listeners::const_iterator it = listeners.begin();it!=listeners.end();++it)
+				 //Call listener
+				 (*it)->onMediaFrame(*frame);
+			 //Next
+			 depacketizer->ResetFrame();
+		 }
+
+	}
+
+	virtual void onBye(RTPIncomingMediaStream* group) 
+	{
+		if(depacketizer)
+			//Skip current
+			depacketizer->ResetFrame();
+	}
+	
+	virtual void onEnded(RTPIncomingMediaStream* group) 
+	{
+		if (incomingSource==group)
+			incomingSource = nullptr;
+	}
+	
+	void AddMediaListener(MediaFrameListener *listener)
+	{
+		//Add to set
+		listeners.insert(listener);
+	}
+	
+	void RemoveMediaListener(MediaFrameListener *listener)
+	{
+		//Remove from set
+		listeners.erase(listener);
+	}
+	
+	void Stop()
+	{
+		//If already stopped
+		if (!incomingSource)
+			//Done
+			return;
+		
+		//Stop listeneing
+		incomingSource->RemoveListener(this);
+		//Clean it
+		incomingSource = NULL;
+	}
+	
+private:
+	typedef std::set<MediaFrameListener*> Listeners;
+private:
+	Listeners listeners;
+	RTPDepacketizer* depacketizer;
+	RTPIncomingMediaStream* incomingSource;
+};
+
+
+
+#include <stdint.h>		// Use the C99 official header
+
+
+#include <string>
+
+
+#include <vector>
+#include <stdexcept>
+
+
+using MediaFrameType = MediaFrame::Type;
+
+SWIGINTERN LayerSources RTPIncomingSource_layers(RTPIncomingSource *self){
+			LayerSources layers;
+			for(auto it = self->layers.begin(); it != self->layers.end(); ++it )
+				layers.push_back(&(it->second));
+			return layers;
+		}
+
+using RTPIncomingMediaStreamListener = RTPIncomingMediaStream::Listener;
+
+
+using RemoteRateEstimatorListener = RemoteRateEstimator::Listener;
+
+
+// C++ director class methods.
+#include "mediaserver_wrap.h"
+
+SwigDirector_DTLSICETransportListener::SwigDirector_DTLSICETransportListener(int swig_p)
+    : DTLSICETransportListener(),
+      go_val(swig_p), swig_mem(0)
+{ }
+
+extern "C" void Swiggo_DeleteDirector_DTLSICETransportListener_native_3e8e6202ec41eede(intgo);
+SwigDirector_DTLSICETransportListener::~SwigDirector_DTLSICETransportListener()
+{
+  Swiggo_DeleteDirector_DTLSICETransportListener_native_3e8e6202ec41eede(go_val);
+  delete swig_mem;
+}
+
+extern "C" void Swig_DirectorDTLSICETransportListener_callback_onDTLSStateChange_native_3e8e6202ec41eede(int, intgo arg2);
+void SwigDirector_DTLSICETransportListener::onDTLSStateChange(uint32_t state) {
+  intgo swig_arg2;
+  
+  swig_arg2 = (uint32_t)state; 
+  Swig_DirectorDTLSICETransportListener_callback_onDTLSStateChange_native_3e8e6202ec41eede(go_val, swig_arg2);
+}
+
+SwigDirector_SenderSideEstimatorListener::SwigDirector_SenderSideEstimatorListener(int swig_p)
+    : SenderSideEstimatorListener(),
+      go_val(swig_p), swig_mem(0)
+{ }
+
+extern "C" void Swiggo_DeleteDirector_SenderSideEstimatorListener_native_3e8e6202ec41eede(intgo);
+SwigDirector_SenderSideEstimatorListener::~SwigDirector_SenderSideEstimatorListener()
+{
+  Swiggo_DeleteDirector_SenderSideEstimatorListener_native_3e8e6202ec41eede(go_val);
+  delete swig_mem;
+}
+
+extern "C" void Swig_DirectorSenderSideEstimatorListener_callback_onTargetBitrateRequested_native_3e8e6202ec41eede(int, intgo arg2);
+void SwigDirector_SenderSideEstimatorListener::onTargetBitrateRequested(DWORD bitrate) {
+  intgo swig_arg2;
+  
+  swig_arg2 = (DWORD)bitrate; 
+  Swig_DirectorSenderSideEstimatorListener_callback_onTargetBitrateRequested_native_3e8e6202ec41eede(go_val, swig_arg2);
+}
+
+SwigDirector_MediaFrameListenerFacade::SwigDirector_MediaFrameListenerFacade(int swig_p)
+    : MediaFrameListenerFacade(),
+      go_val(swig_p), swig_mem(0)
+{ }
+
+extern "C" void Swiggo_DeleteDirector_MediaFrameListenerFacade_native_3e8e6202ec41eede(intgo);
+SwigDirector_MediaFrameListenerFacade::~SwigDirector_MediaFrameListenerFacade()
+{
+  Swiggo_DeleteDirector_MediaFrameListenerFacade_native_3e8e6202ec41eede(go_val);
+  delete swig_mem;
+}
+
+extern "C" void Swig_DirectorMediaFrameListenerFacade_callback_onMediaFrame_native_3e8e6202ec41eede(int, MediaFrame *arg2);
+void SwigDirector_MediaFrameListenerFacade::onMediaFrame(MediaFrame const &frame) {
+  MediaFrame *swig_arg2;
+  
+  swig_arg2 = (MediaFrame *)&frame; 
+  Swig_DirectorMediaFrameListenerFacade_callback_onMediaFrame_native_3e8e6202ec41eede(go_val, swig_arg2);
+}
+
+SwigDirector_ActiveTrackListener::SwigDirector_ActiveTrackListener(int swig_p)
+    : ActiveTrackListener(),
+      go_val(swig_p), swig_mem(0)
+{ }
+
+extern "C" void Swiggo_DeleteDirector_ActiveTrackListener_native_3e8e6202ec41eede(intgo);
+SwigDirector_ActiveTrackListener::~SwigDirector_ActiveTrackListener()
+{
+  Swiggo_DeleteDirector_ActiveTrackListener_native_3e8e6202ec41eede(go_val);
+  delete swig_mem;
+}
+
+extern "C" void Swig_DirectorActiveTrackListener_callback_onActiveTrackchanged_native_3e8e6202ec41eede(int, intgo arg2);
+void SwigDirector_ActiveTrackListener::onActiveTrackchanged(uint32_t id) {
+  intgo swig_arg2;
+  
+  swig_arg2 = (uint32_t)id; 
+  Swig_DirectorActiveTrackListener_callback_onActiveTrackchanged_native_3e8e6202ec41eede(go_val, swig_arg2);
+}
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+void _wrap_Swig_free_native_3e8e6202ec41eede(void *_swig_go_0) {
+  void *arg1 = (void *) 0 ;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  Swig_free(arg1);
+  
+}
+
+
+void *_wrap_Swig_malloc_native_3e8e6202ec41eede(intgo _swig_go_0) {
+  int arg1 ;
+  void *result = 0 ;
+  void *_swig_go_result;
+  
+  arg1 = (int)_swig_go_0; 
+  
+  result = (void *)Swig_malloc(arg1);
+  *(void **)&_swig_go_result = (void *)result; 
+  return _swig_go_result;
+}
+
+
+Acumulator *_wrap_new_Acumulator__SWIG_0_native_3e8e6202ec41eede(intgo _swig_go_0, intgo _swig_go_1) {
+  uint32_t arg1 ;
+  uint32_t arg2 ;
+  Acumulator *result = 0 ;
+  Acumulator *_swig_go_result;
+  
+  arg1 = (uint32_t)_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  result = (Acumulator *)new Acumulator(arg1,arg2);
+  *(Acumulator **)&_swig_go_result = (Acumulator *)result; 
+  return _swig_go_result;
+}
+
+
+Acumulator *_wrap_new_Acumulator__SWIG_1_native_3e8e6202ec41eede(intgo _swig_go_0) {
+  uint32_t arg1 ;
+  Acumulator *result = 0 ;
+  Acumulator *_swig_go_result;
+  
+  arg1 = (uint32_t)_swig_go_0; 
+  
+  result = (Acumulator *)new Acumulator(arg1);
+  *(Acumulator **)&_swig_go_result = (Acumulator *)result; 
+  return _swig_go_result;
+}
+
+
+long long _wrap_Acumulator_GetAcumulated_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (uint64_t)((Acumulator const *)arg1)->GetAcumulated();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+long long _wrap_Acumulator_GetDiff_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (uint64_t)((Acumulator const *)arg1)->GetDiff();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+long long _wrap_Acumulator_GetInstant_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (uint64_t)((Acumulator const *)arg1)->GetInstant();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+long long _wrap_Acumulator_GetMin_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (uint64_t)((Acumulator const *)arg1)->GetMin();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+long long _wrap_Acumulator_GetMax_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (uint64_t)((Acumulator const *)arg1)->GetMax();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_Acumulator_GetWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (uint32_t)((Acumulator const *)arg1)->GetWindow();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+bool _wrap_Acumulator_IsInWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (bool)((Acumulator const *)arg1)->IsInWindow();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+bool _wrap_Acumulator_IsInMinMaxWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (bool)((Acumulator const *)arg1)->IsInMinMaxWindow();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+long double *_wrap_Acumulator_GetInstantMedia_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  long double result;
+  long double *_swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (long double)((Acumulator const *)arg1)->GetInstantMedia();
+  *(long double **)&_swig_go_result = new long double(result); 
+  return _swig_go_result;
+}
+
+
+long double *_wrap_Acumulator_GetInstantAvg_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  long double result;
+  long double *_swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (long double)((Acumulator const *)arg1)->GetInstantAvg();
+  *(long double **)&_swig_go_result = new long double(result); 
+  return _swig_go_result;
+}
+
+
+long double *_wrap_Acumulator_GetAverage_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  long double result;
+  long double *_swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (long double)((Acumulator const *)arg1)->GetAverage();
+  *(long double **)&_swig_go_result = new long double(result); 
+  return _swig_go_result;
+}
+
+
+long double *_wrap_Acumulator_GetMinAvg_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  long double result;
+  long double *_swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (long double)((Acumulator const *)arg1)->GetMinAvg();
+  *(long double **)&_swig_go_result = new long double(result); 
+  return _swig_go_result;
+}
+
+
+long double *_wrap_Acumulator_GetMaxAvg_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  long double result;
+  long double *_swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (long double)((Acumulator const *)arg1)->GetMaxAvg();
+  *(long double **)&_swig_go_result = new long double(result); 
+  return _swig_go_result;
+}
+
+
+void _wrap_Acumulator_ResetMinMax_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  (arg1)->ResetMinMax();
+  
+}
+
+
+void _wrap_Acumulator_Reset_native_3e8e6202ec41eede(Acumulator *_swig_go_0, long long _swig_go_1) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint64_t arg2 ;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
+  
+  (arg1)->Reset(arg2);
+  
+}
+
+
+long long _wrap_Acumulator_Update__SWIG_0_native_3e8e6202ec41eede(Acumulator *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint64_t arg2 ;
+  uint32_t arg3 ;
+  uint64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
+  arg3 = (uint32_t)_swig_go_2; 
+  
+  result = (uint64_t)(arg1)->Update(arg2,arg3);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+long long _wrap_Acumulator_Update__SWIG_1_native_3e8e6202ec41eede(Acumulator *_swig_go_0, long long _swig_go_1) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint64_t arg2 ;
+  uint64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
+  
+  result = (uint64_t)(arg1)->Update(arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_Acumulator_GetMinValueInWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
   Acumulator *arg1 = (Acumulator *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+  uint32_t result;
+  intgo _swig_go_result;
   
   arg1 = *(Acumulator **)&_swig_go_0; 
   
-  result = (uint64_t)((Acumulator const *)arg1)->GetInstant();
+  result = (uint32_t)((Acumulator const *)arg1)->GetMinValueInWindow();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-long long _wrap_Acumulator_GetMin_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+intgo _wrap_Acumulator_GetMaxValueInWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
   Acumulator *arg1 = (Acumulator *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+  uint32_t result;
+  intgo _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (uint32_t)((Acumulator const *)arg1)->GetMaxValueInWindow();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_Acumulator_GetCount_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  result = (uint32_t)((Acumulator const *)arg1)->GetCount();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_delete_Acumulator_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
+  Acumulator *arg1 = (Acumulator *) 0 ;
+  
+  arg1 = *(Acumulator **)&_swig_go_0; 
+  
+  delete arg1;
+  
+}
+
+
+void _wrap_LayerInfo_MaxLayerId_set_native_3e8e6202ec41eede(char _swig_go_0) {
+  uint8_t arg1 ;
+  
+  arg1 = (uint8_t)_swig_go_0; 
+  
+  LayerInfo::MaxLayerId = arg1;
+  
+}
+
+
+char _wrap_LayerInfo_MaxLayerId_get_native_3e8e6202ec41eede() {
+  uint8_t result;
+  char _swig_go_result;
+  
+  
+  result = (uint8_t)LayerInfo::MaxLayerId;
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_LayerInfo_temporalLayerId_set_native_3e8e6202ec41eede(LayerInfo *_swig_go_0, char _swig_go_1) {
+  LayerInfo *arg1 = (LayerInfo *) 0 ;
+  uint8_t arg2 ;
+  
+  arg1 = *(LayerInfo **)&_swig_go_0; 
+  arg2 = (uint8_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->temporalLayerId = arg2;
+  
+}
+
+
+char _wrap_LayerInfo_temporalLayerId_get_native_3e8e6202ec41eede(LayerInfo *_swig_go_0) {
+  LayerInfo *arg1 = (LayerInfo *) 0 ;
+  uint8_t result;
+  char _swig_go_result;
+  
+  arg1 = *(LayerInfo **)&_swig_go_0; 
+  
+  result = (uint8_t) ((arg1)->temporalLayerId);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_LayerInfo_spatialLayerId_set_native_3e8e6202ec41eede(LayerInfo *_swig_go_0, char _swig_go_1) {
+  LayerInfo *arg1 = (LayerInfo *) 0 ;
+  uint8_t arg2 ;
+  
+  arg1 = *(LayerInfo **)&_swig_go_0; 
+  arg2 = (uint8_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->spatialLayerId = arg2;
+  
+}
+
+
+char _wrap_LayerInfo_spatialLayerId_get_native_3e8e6202ec41eede(LayerInfo *_swig_go_0) {
+  LayerInfo *arg1 = (LayerInfo *) 0 ;
+  uint8_t result;
+  char _swig_go_result;
+  
+  arg1 = *(LayerInfo **)&_swig_go_0; 
+  
+  result = (uint8_t) ((arg1)->spatialLayerId);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+LayerInfo *_wrap_new_LayerInfo_native_3e8e6202ec41eede() {
+  LayerInfo *result = 0 ;
+  LayerInfo *_swig_go_result;
+  
+  
+  result = (LayerInfo *)new LayerInfo();
+  *(LayerInfo **)&_swig_go_result = (LayerInfo *)result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_delete_LayerInfo_native_3e8e6202ec41eede(LayerInfo *_swig_go_0) {
+  LayerInfo *arg1 = (LayerInfo *) 0 ;
+  
+  arg1 = *(LayerInfo **)&_swig_go_0; 
+  
+  delete arg1;
+  
+}
+
+
+void _wrap_LayerSource_numPackets_set_native_3e8e6202ec41eede(LayerSource *_swig_go_0, intgo _swig_go_1) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint32_t arg2 ;
+  
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->numPackets = arg2;
+  
+}
+
+
+intgo _wrap_LayerSource_numPackets_get_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
+  
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  
+  result = (uint32_t) ((arg1)->numPackets);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_LayerSource_totalBytes_set_native_3e8e6202ec41eede(LayerSource *_swig_go_0, intgo _swig_go_1) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint32_t arg2 ;
+  
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->totalBytes = arg2;
+  
+}
+
+
+intgo _wrap_LayerSource_totalBytes_get_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
+  
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  
+  result = (uint32_t) ((arg1)->totalBytes);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_LayerSource_bitrate_set_native_3e8e6202ec41eede(LayerSource *_swig_go_0, intgo _swig_go_1) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint32_t arg2 ;
+  
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->bitrate = arg2;
+  
+}
+
+
+intgo _wrap_LayerSource_bitrate_get_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
+  
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  
+  result = (uint32_t) ((arg1)->bitrate);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+LayerSource *_wrap_new_LayerSource_native_3e8e6202ec41eede() {
+  LayerSource *result = 0 ;
+  LayerSource *_swig_go_result;
+  
+  
+  result = (LayerSource *)new LayerSource();
+  *(LayerSource **)&_swig_go_result = (LayerSource *)result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_delete_LayerSource_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  
+  delete arg1;
+  
+}
+
+
+void _wrap_SetLayerSource_TemporalLayerId_native_3e8e6202ec41eede(LayerSource *_swig_go_0, char _swig_go_1) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint8_t arg2 ;
+  
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg2 = (uint8_t)_swig_go_1; 
+  
+  LayerInfo *swig_b0 = (LayerInfo *)arg1;
+  if (swig_b0) (swig_b0)->temporalLayerId = arg2;
+  
+}
+
+
+char _wrap_GetLayerSource_TemporalLayerId_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint8_t result;
+  char _swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(LayerSource **)&_swig_go_0; 
   
-  result = (uint64_t)((Acumulator const *)arg1)->GetMin();
+  LayerInfo *swig_b0 = (LayerInfo *)arg1;
+  result = (uint8_t) ((swig_b0)->temporalLayerId);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-long long _wrap_Acumulator_GetMax_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+void _wrap_SetLayerSource_SpatialLayerId_native_3e8e6202ec41eede(LayerSource *_swig_go_0, char _swig_go_1) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint8_t arg2 ;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg2 = (uint8_t)_swig_go_1; 
+  
+  LayerInfo *swig_b0 = (LayerInfo *)arg1;
+  if (swig_b0) (swig_b0)->spatialLayerId = arg2;
   
-  result = (uint64_t)((Acumulator const *)arg1)->GetMax();
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_Acumulator_GetWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+char _wrap_GetLayerSource_SpatialLayerId_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
+  LayerSource *arg1 = (LayerSource *) 0 ;
+  uint8_t result;
+  char _swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(LayerSource **)&_swig_go_0; 
   
-  result = (uint32_t)((Acumulator const *)arg1)->GetWindow();
+  LayerInfo *swig_b0 = (LayerInfo *)arg1;
+  result = (uint8_t) ((swig_b0)->spatialLayerId);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_Acumulator_IsInWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  bool result;
-  bool _swig_go_result;
+long long _wrap_LayerSources_size_native_3e8e6202ec41eede(LayerSources *_swig_go_0) {
+  LayerSources *arg1 = (LayerSources *) 0 ;
+  size_t result;
+  long long _swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(LayerSources **)&_swig_go_0; 
   
-  result = (bool)((Acumulator const *)arg1)->IsInWindow();
+  result = ((LayerSources const *)arg1)->size();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_Acumulator_IsInMinMaxWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  bool result;
-  bool _swig_go_result;
+LayerSource *_wrap_LayerSources_get_native_3e8e6202ec41eede(LayerSources *_swig_go_0, long long _swig_go_1) {
+  LayerSources *arg1 = (LayerSources *) 0 ;
+  size_t arg2 ;
+  LayerSource *result = 0 ;
+  LayerSource *_swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(LayerSources **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  result = (bool)((Acumulator const *)arg1)->IsInMinMaxWindow();
-  _swig_go_result = result; 
+  result = (LayerSource *)(arg1)->get(arg2);
+  *(LayerSource **)&_swig_go_result = (LayerSource *)result; 
   return _swig_go_result;
 }
 
 
-long double *_wrap_Acumulator_GetInstantMedia_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  long double result;
-  long double *_swig_go_result;
+LayerSources *_wrap_new_LayerSources_native_3e8e6202ec41eede() {
+  LayerSources *result = 0 ;
+  LayerSources *_swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
   
-  result = (long double)((Acumulator const *)arg1)->GetInstantMedia();
-  *(long double **)&_swig_go_result = new long double(result); 
+  result = (LayerSources *)new LayerSources();
+  *(LayerSources **)&_swig_go_result = (LayerSources *)result; 
   return _swig_go_result;
 }
 
 
-long double *_wrap_Acumulator_GetInstantAvg_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  long double result;
-  long double *_swig_go_result;
+void _wrap_delete_LayerSources_native_3e8e6202ec41eede(LayerSources *_swig_go_0) {
+  LayerSources *arg1 = (LayerSources *) 0 ;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(LayerSources **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (long double)((Acumulator const *)arg1)->GetInstantAvg();
-  *(long double **)&_swig_go_result = new long double(result); 
-  return _swig_go_result;
 }
 
 
-long double *_wrap_Acumulator_GetAverage_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  long double result;
-  long double *_swig_go_result;
+void _wrap_RTPSource_ssrc_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->ssrc = arg2;
   
-  result = (long double)((Acumulator const *)arg1)->GetAverage();
-  *(long double **)&_swig_go_result = new long double(result); 
-  return _swig_go_result;
 }
 
 
-long double *_wrap_Acumulator_GetMinAvg_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  long double result;
-  long double *_swig_go_result;
+intgo _wrap_RTPSource_ssrc_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  result = (long double)((Acumulator const *)arg1)->GetMinAvg();
-  *(long double **)&_swig_go_result = new long double(result); 
+  result = (uint32_t) ((arg1)->ssrc);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-long double *_wrap_Acumulator_GetMaxAvg_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  long double result;
-  long double *_swig_go_result;
+void _wrap_RTPSource_extSeqNum_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->extSeqNum = arg2;
   
-  result = (long double)((Acumulator const *)arg1)->GetMaxAvg();
-  *(long double **)&_swig_go_result = new long double(result); 
-  return _swig_go_result;
 }
 
 
-void _wrap_Acumulator_ResetMinMax_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  
-  arg1 = *(Acumulator **)&_swig_go_0; 
+intgo _wrap_RTPSource_extSeqNum_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  (arg1)->ResetMinMax();
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
+  result = (uint32_t) ((arg1)->extSeqNum);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_Acumulator_Reset_native_3e8e6202ec41eede(Acumulator *_swig_go_0, long long _swig_go_1) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  uint64_t arg2 ;
+void _wrap_RTPSource_cycles_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  (arg1)->Reset(arg2);
+  if (arg1) (arg1)->cycles = arg2;
   
 }
 
 
-long long _wrap_Acumulator_Update__SWIG_0_native_3e8e6202ec41eede(Acumulator *_swig_go_0, long long _swig_go_1, intgo _swig_go_2) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  uint64_t arg2 ;
-  uint32_t arg3 ;
-  uint64_t result;
-  long long _swig_go_result;
+intgo _wrap_RTPSource_cycles_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
-  arg3 = (uint32_t)_swig_go_2; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  result = (uint64_t)(arg1)->Update(arg2,arg3);
+  result = (uint32_t) ((arg1)->cycles);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-long long _wrap_Acumulator_Update__SWIG_1_native_3e8e6202ec41eede(Acumulator *_swig_go_0, long long _swig_go_1) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  uint64_t arg2 ;
-  uint64_t result;
-  long long _swig_go_result;
+void _wrap_RTPSource_jitter_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->jitter = arg2;
   
-  result = (uint64_t)(arg1)->Update(arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_Acumulator_GetMinValueInWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
+intgo _wrap_RTPSource_jitter_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  result = (uint32_t)((Acumulator const *)arg1)->GetMinValueInWindow();
+  result = (uint32_t) ((arg1)->jitter);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_Acumulator_GetMaxValueInWindow_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+void _wrap_RTPSource_numPackets_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->numPackets = arg2;
   
-  result = (uint32_t)((Acumulator const *)arg1)->GetMaxValueInWindow();
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_Acumulator_GetCount_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
+intgo _wrap_RTPSource_numPackets_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  result = (uint32_t)((Acumulator const *)arg1)->GetCount();
+  result = (uint32_t) ((arg1)->numPackets);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_Acumulator_native_3e8e6202ec41eede(Acumulator *_swig_go_0) {
-  Acumulator *arg1 = (Acumulator *) 0 ;
+void _wrap_RTPSource_numRTCPPackets_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(Acumulator **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  delete arg1;
+  if (arg1) (arg1)->numRTCPPackets = arg2;
   
 }
 
 
-void _wrap_LayerInfo_MaxLayerId_set_native_3e8e6202ec41eede(char _swig_go_0) {
-  uint8_t arg1 ;
+intgo _wrap_RTPSource_numRTCPPackets_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = (uint8_t)_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  LayerInfo::MaxLayerId = arg1;
+  result = (uint32_t) ((arg1)->numRTCPPackets);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_RTPSource_totalBytes_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
+  
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->totalBytes = arg2;
   
 }
 
 
-char _wrap_LayerInfo_MaxLayerId_get_native_3e8e6202ec41eede() {
-  uint8_t result;
-  char _swig_go_result;
+intgo _wrap_RTPSource_totalBytes_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  result = (uint8_t)LayerInfo::MaxLayerId;
+  result = (uint32_t) ((arg1)->totalBytes);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_LayerInfo_temporalLayerId_set_native_3e8e6202ec41eede(LayerInfo *_swig_go_0, char _swig_go_1) {
-  LayerInfo *arg1 = (LayerInfo *) 0 ;
-  uint8_t arg2 ;
+void _wrap_RTPSource_totalRTCPBytes_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(LayerInfo **)&_swig_go_0; 
-  arg2 = (uint8_t)_swig_go_1; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->temporalLayerId = arg2;
+  if (arg1) (arg1)->totalRTCPBytes = arg2;
   
 }
 
 
-char _wrap_LayerInfo_temporalLayerId_get_native_3e8e6202ec41eede(LayerInfo *_swig_go_0) {
-  LayerInfo *arg1 = (LayerInfo *) 0 ;
-  uint8_t result;
-  char _swig_go_result;
+intgo _wrap_RTPSource_totalRTCPBytes_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(LayerInfo **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  result = (uint8_t) ((arg1)->temporalLayerId);
+  result = (uint32_t) ((arg1)->totalRTCPBytes);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_LayerInfo_spatialLayerId_set_native_3e8e6202ec41eede(LayerInfo *_swig_go_0, char _swig_go_1) {
-  LayerInfo *arg1 = (LayerInfo *) 0 ;
-  uint8_t arg2 ;
+void _wrap_RTPSource_bitrate_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(LayerInfo **)&_swig_go_0; 
-  arg2 = (uint8_t)_swig_go_1; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->spatialLayerId = arg2;
+  if (arg1) (arg1)->bitrate = arg2;
   
 }
 
 
-char _wrap_LayerInfo_spatialLayerId_get_native_3e8e6202ec41eede(LayerInfo *_swig_go_0) {
-  LayerInfo *arg1 = (LayerInfo *) 0 ;
-  uint8_t result;
-  char _swig_go_result;
+intgo _wrap_RTPSource_bitrate_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(LayerInfo **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  result = (uint8_t) ((arg1)->spatialLayerId);
+  result = (uint32_t) ((arg1)->bitrate);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-LayerInfo *_wrap_new_LayerInfo_native_3e8e6202ec41eede() {
-  LayerInfo *result = 0 ;
-  LayerInfo *_swig_go_result;
+RTPSource *_wrap_new_RTPSource_native_3e8e6202ec41eede() {
+  RTPSource *result = 0 ;
+  RTPSource *_swig_go_result;
   
   
-  result = (LayerInfo *)new LayerInfo();
-  *(LayerInfo **)&_swig_go_result = (LayerInfo *)result; 
+  result = (RTPSource *)new RTPSource();
+  *(RTPSource **)&_swig_go_result = (RTPSource *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_LayerInfo_native_3e8e6202ec41eede(LayerInfo *_swig_go_0) {
-  LayerInfo *arg1 = (LayerInfo *) 0 ;
+void _wrap_delete_RTPSource_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
+  RTPSource *arg1 = (RTPSource *) 0 ;
   
-  arg1 = *(LayerInfo **)&_swig_go_0; 
+  arg1 = *(RTPSource **)&_swig_go_0; 
   
   delete arg1;
   
 }
 
 
-void _wrap_LayerSource_numPackets_set_native_3e8e6202ec41eede(LayerSource *_swig_go_0, intgo _swig_go_1) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
+void _wrap_RTPIncomingSource_lostPackets_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->numPackets = arg2;
+  if (arg1) (arg1)->lostPackets = arg2;
   
 }
 
 
-intgo _wrap_LayerSource_numPackets_get_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
+intgo _wrap_RTPIncomingSource_lostPackets_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->numPackets);
+  result = (uint32_t) ((arg1)->lostPackets);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_LayerSource_totalBytes_set_native_3e8e6202ec41eede(LayerSource *_swig_go_0, intgo _swig_go_1) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
+void _wrap_RTPIncomingSource_dropPackets_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->totalBytes = arg2;
+  if (arg1) (arg1)->dropPackets = arg2;
   
 }
 
 
-intgo _wrap_LayerSource_totalBytes_get_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
+intgo _wrap_RTPIncomingSource_dropPackets_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalBytes);
+  result = (uint32_t) ((arg1)->dropPackets);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_LayerSource_bitrate_set_native_3e8e6202ec41eede(LayerSource *_swig_go_0, intgo _swig_go_1) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
+void _wrap_RTPIncomingSource_totalPacketsSinceLastSR_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->bitrate = arg2;
+  if (arg1) (arg1)->totalPacketsSinceLastSR = arg2;
   
 }
 
 
-intgo _wrap_LayerSource_bitrate_get_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
+intgo _wrap_RTPIncomingSource_totalPacketsSinceLastSR_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->bitrate);
+  result = (uint32_t) ((arg1)->totalPacketsSinceLastSR);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-LayerSource *_wrap_new_LayerSource_native_3e8e6202ec41eede() {
-  LayerSource *result = 0 ;
-  LayerSource *_swig_go_result;
+void _wrap_RTPIncomingSource_totalBytesSinceLastSR_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint32_t arg2 ;
   
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->totalBytesSinceLastSR = arg2;
   
-  result = (LayerSource *)new LayerSource();
-  *(LayerSource **)&_swig_go_result = (LayerSource *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_delete_LayerSource_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
-  
-  arg1 = *(LayerSource **)&_swig_go_0; 
+intgo _wrap_RTPIncomingSource_totalBytesSinceLastSR_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  delete arg1;
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
+  result = (uint32_t) ((arg1)->totalBytesSinceLastSR);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_SetLayerSource_TemporalLayerId_native_3e8e6202ec41eede(LayerSource *_swig_go_0, char _swig_go_1) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
-  uint8_t arg2 ;
+void _wrap_RTPIncomingSource_minExtSeqNumSinceLastSR_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
-  arg2 = (uint8_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  LayerInfo *swig_b0 = (LayerInfo *)arg1;
-  if (swig_b0) (swig_b0)->temporalLayerId = arg2;
+  if (arg1) (arg1)->minExtSeqNumSinceLastSR = arg2;
   
 }
 
 
-char _wrap_GetLayerSource_TemporalLayerId_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
-  uint8_t result;
-  char _swig_go_result;
+intgo _wrap_RTPIncomingSource_minExtSeqNumSinceLastSR_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  LayerInfo *swig_b0 = (LayerInfo *)arg1;
-  result = (uint8_t) ((swig_b0)->temporalLayerId);
+  result = (uint32_t) ((arg1)->minExtSeqNumSinceLastSR);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetLayerSource_SpatialLayerId_native_3e8e6202ec41eede(LayerSource *_swig_go_0, char _swig_go_1) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
-  uint8_t arg2 ;
+void _wrap_RTPIncomingSource_lostPacketsSinceLastSR_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
-  arg2 = (uint8_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  LayerInfo *swig_b0 = (LayerInfo *)arg1;
-  if (swig_b0) (swig_b0)->spatialLayerId = arg2;
+  if (arg1) (arg1)->lostPacketsSinceLastSR = arg2;
   
 }
 
 
-char _wrap_GetLayerSource_SpatialLayerId_native_3e8e6202ec41eede(LayerSource *_swig_go_0) {
-  LayerSource *arg1 = (LayerSource *) 0 ;
-  uint8_t result;
-  char _swig_go_result;
+intgo _wrap_RTPIncomingSource_lostPacketsSinceLastSR_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(LayerSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  LayerInfo *swig_b0 = (LayerInfo *)arg1;
-  result = (uint8_t) ((swig_b0)->spatialLayerId);
+  result = (uint32_t) ((arg1)->lostPacketsSinceLastSR);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-long long _wrap_LayerSources_size_native_3e8e6202ec41eede(LayerSources *_swig_go_0) {
-  LayerSources *arg1 = (LayerSources *) 0 ;
-  size_t result;
-  long long _swig_go_result;
-  
-  arg1 = *(LayerSources **)&_swig_go_0; 
+void _wrap_RTPIncomingSource_lastReceivedSenderNTPTimestamp_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t arg2 ;
   
-  result = ((LayerSources const *)arg1)->size();
-  _swig_go_result = result; 
-  return _swig_go_result;
-}
-
-
-LayerSource *_wrap_LayerSources_get_native_3e8e6202ec41eede(LayerSources *_swig_go_0, long long _swig_go_1) {
-  LayerSources *arg1 = (LayerSources *) 0 ;
-  size_t arg2 ;
-  LayerSource *result = 0 ;
-  LayerSource *_swig_go_result;
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
   
-  arg1 = *(LayerSources **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  if (arg1) (arg1)->lastReceivedSenderNTPTimestamp = arg2;
   
-  result = (LayerSource *)(arg1)->get(arg2);
-  *(LayerSource **)&_swig_go_result = (LayerSource *)result; 
-  return _swig_go_result;
 }
 
 
-LayerSources *_wrap_new_LayerSources_native_3e8e6202ec41eede() {
-  LayerSources *result = 0 ;
-  LayerSources *_swig_go_result;
+long long _wrap_RTPIncomingSource_lastReceivedSenderNTPTimestamp_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
   
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (LayerSources *)new LayerSources();
-  *(LayerSources **)&_swig_go_result = (LayerSources *)result; 
+  result = (uint64_t) ((arg1)->lastReceivedSenderNTPTimestamp);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_LayerSources_native_3e8e6202ec41eede(LayerSources *_swig_go_0) {
-  LayerSources *arg1 = (LayerSources *) 0 ;
-  
-  arg1 = *(LayerSources **)&_swig_go_0; 
-  
-  delete arg1;
-  
-}
-
-
-void _wrap_RTPSource_ssrc_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t arg2 ;
-  
-  arg1 = *(RTPSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+void _wrap_RTPIncomingSource_lastReceivedSenderReport_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t arg2 ;
   
-  if (arg1) (arg1)->ssrc = arg2;
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
+  
+  if (arg1) (arg1)->lastReceivedSenderReport = arg2;
   
 }
 
 
-intgo _wrap_RTPSource_ssrc_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+long long _wrap_RTPIncomingSource_lastReceivedSenderReport_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->ssrc);
+  result = (uint64_t) ((arg1)->lastReceivedSenderReport);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPSource_extSeqNum_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPIncomingSource_lastReport_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t arg2 ;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
   
-  if (arg1) (arg1)->extSeqNum = arg2;
+  if (arg1) (arg1)->lastReport = arg2;
   
 }
 
 
-intgo _wrap_RTPSource_extSeqNum_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+long long _wrap_RTPIncomingSource_lastReport_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->extSeqNum);
+  result = (uint64_t) ((arg1)->lastReport);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPSource_cycles_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPIncomingSource_lastPLI_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t arg2 ;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
   
-  if (arg1) (arg1)->cycles = arg2;
+  if (arg1) (arg1)->lastPLI = arg2;
   
 }
 
 
-intgo _wrap_RTPSource_cycles_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+long long _wrap_RTPIncomingSource_lastPLI_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->cycles);
+  result = (uint64_t) ((arg1)->lastPLI);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPSource_jitter_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
+void _wrap_RTPIncomingSource_totalPLIs_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->jitter = arg2;
+  if (arg1) (arg1)->totalPLIs = arg2;
   
 }
 
 
-intgo _wrap_RTPSource_jitter_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
+intgo _wrap_RTPIncomingSource_totalPLIs_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->jitter);
+  result = (uint32_t) ((arg1)->totalPLIs);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPSource_numPackets_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
+void _wrap_RTPIncomingSource_totalNACKs_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->numPackets = arg2;
+  if (arg1) (arg1)->totalNACKs = arg2;
   
 }
 
 
-intgo _wrap_RTPSource_numPackets_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
+intgo _wrap_RTPIncomingSource_totalNACKs_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->numPackets);
+  result = (uint32_t) ((arg1)->totalNACKs);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPSource_numRTCPPackets_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPIncomingSource_lastNACKed_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t arg2 ;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
   
-  if (arg1) (arg1)->numRTCPPackets = arg2;
+  if (arg1) (arg1)->lastNACKed = arg2;
   
 }
 
 
-intgo _wrap_RTPSource_numRTCPPackets_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+long long _wrap_RTPIncomingSource_lastNACKed_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->numRTCPPackets);
+  result = (uint64_t) ((arg1)->lastNACKed);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPSource_totalBytes_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t arg2 ;
-  
-  arg1 = *(RTPSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+LayerSources *_wrap_RTPIncomingSource_layers_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  LayerSources result;
+  LayerSources *_swig_go_result;
   
-  if (arg1) (arg1)->totalBytes = arg2;
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
+  result = RTPIncomingSource_layers(arg1);
+  *(LayerSources **)&_swig_go_result = new LayerSources(result); 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_RTPSource_totalBytes_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+RTPIncomingSource *_wrap_new_RTPIncomingSource_native_3e8e6202ec41eede() {
+  RTPIncomingSource *result = 0 ;
+  RTPIncomingSource *_swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalBytes);
-  _swig_go_result = result; 
+  result = (RTPIncomingSource *)new RTPIncomingSource();
+  *(RTPIncomingSource **)&_swig_go_result = (RTPIncomingSource *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPSource_totalRTCPBytes_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
+void _wrap_delete_RTPIncomingSource_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  
+  delete arg1;
+  
+}
+
+
+void _wrap_SetRTPIncomingSource_Ssrc_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->totalRTCPBytes = arg2;
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->ssrc = arg2;
   
 }
 
 
-intgo _wrap_RTPSource_totalRTCPBytes_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
+intgo _wrap_GetRTPIncomingSource_Ssrc_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalRTCPBytes);
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->ssrc);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPSource_bitrate_set_native_3e8e6202ec41eede(RTPSource *_swig_go_0, intgo _swig_go_1) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
+void _wrap_SetRTPIncomingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->bitrate = arg2;
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->extSeqNum = arg2;
   
 }
 
 
-intgo _wrap_RTPSource_bitrate_get_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
+intgo _wrap_GetRTPIncomingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->bitrate);
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->extSeqNum);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-RTPSource *_wrap_new_RTPSource_native_3e8e6202ec41eede() {
-  RTPSource *result = 0 ;
-  RTPSource *_swig_go_result;
+void _wrap_SetRTPIncomingSource_Cycles_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint32_t arg2 ;
   
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->cycles = arg2;
   
-  result = (RTPSource *)new RTPSource();
-  *(RTPSource **)&_swig_go_result = (RTPSource *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPSource_native_3e8e6202ec41eede(RTPSource *_swig_go_0) {
-  RTPSource *arg1 = (RTPSource *) 0 ;
-  
-  arg1 = *(RTPSource **)&_swig_go_0; 
+intgo _wrap_GetRTPIncomingSource_Cycles_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  delete arg1;
+  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->cycles);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_lostPackets_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+void _wrap_SetRTPIncomingSource_Jitter_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->lostPackets = arg2;
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->jitter = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSource_lostPackets_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+intgo _wrap_GetRTPIncomingSource_Jitter_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->lostPackets);
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->jitter);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_dropPackets_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+void _wrap_SetRTPIncomingSource_NumPackets_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->dropPackets = arg2;
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->numPackets = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSource_dropPackets_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+intgo _wrap_GetRTPIncomingSource_NumPackets_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->dropPackets);
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->numPackets);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_totalPacketsSinceLastSR_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+void _wrap_SetRTPIncomingSource_NumRTCPPackets_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->totalPacketsSinceLastSR = arg2;
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->numRTCPPackets = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSource_totalPacketsSinceLastSR_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+intgo _wrap_GetRTPIncomingSource_NumRTCPPackets_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalPacketsSinceLastSR);
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->numRTCPPackets);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_totalBytesSinceLastSR_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+void _wrap_SetRTPIncomingSource_TotalBytes_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->totalBytesSinceLastSR = arg2;
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->totalBytes = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSource_totalBytesSinceLastSR_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+intgo _wrap_GetRTPIncomingSource_TotalBytes_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalBytesSinceLastSR);
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->totalBytes);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_minExtSeqNumSinceLastSR_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+void _wrap_SetRTPIncomingSource_TotalRTCPBytes_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->minExtSeqNumSinceLastSR = arg2;
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->totalRTCPBytes = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSource_minExtSeqNumSinceLastSR_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+intgo _wrap_GetRTPIncomingSource_TotalRTCPBytes_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->minExtSeqNumSinceLastSR);
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->totalRTCPBytes);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_lostPacketsSinceLastSR_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
+void _wrap_SetRTPIncomingSource_Bitrate_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t arg2 ;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->lostPacketsSinceLastSR = arg2;
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  if (swig_b0) (swig_b0)->bitrate = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSource_lostPacketsSinceLastSR_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
+intgo _wrap_GetRTPIncomingSource_Bitrate_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
   RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
   arg1 = *(RTPIncomingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->lostPacketsSinceLastSR);
+  RTPSource *swig_b0 = (RTPSource *)arg1;
+  result = (uint32_t) ((swig_b0)->bitrate);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_lastReceivedSenderNTPTimestamp_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint64_t arg2 ;
+void _wrap_RTPOutgoingSource_time_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->lastReceivedSenderNTPTimestamp = arg2;
+  if (arg1) (arg1)->time = arg2;
   
 }
 
 
-long long _wrap_RTPIncomingSource_lastReceivedSenderNTPTimestamp_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+intgo _wrap_RTPOutgoingSource_time_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
-  result = (uint64_t) ((arg1)->lastReceivedSenderNTPTimestamp);
+  result = (uint32_t) ((arg1)->time);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_lastReceivedSenderReport_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint64_t arg2 ;
+void _wrap_RTPOutgoingSource_lastTime_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->lastReceivedSenderReport = arg2;
+  if (arg1) (arg1)->lastTime = arg2;
   
 }
 
 
-long long _wrap_RTPIncomingSource_lastReceivedSenderReport_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+intgo _wrap_RTPOutgoingSource_lastTime_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
-  result = (uint64_t) ((arg1)->lastReceivedSenderReport);
+  result = (uint32_t) ((arg1)->lastTime);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_lastReport_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint64_t arg2 ;
+void _wrap_RTPOutgoingSource_numPackets_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->lastReport = arg2;
+  if (arg1) (arg1)->numPackets = arg2;
   
 }
 
 
-long long _wrap_RTPIncomingSource_lastReport_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+intgo _wrap_RTPOutgoingSource_numPackets_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
-  result = (uint64_t) ((arg1)->lastReport);
+  result = (uint32_t) ((arg1)->numPackets);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_lastPLI_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint64_t arg2 ;
+void _wrap_RTPOutgoingSource_numRTCPPackets_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->lastPLI = arg2;
+  if (arg1) (arg1)->numRTCPPackets = arg2;
   
 }
 
 
-long long _wrap_RTPIncomingSource_lastPLI_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+intgo _wrap_RTPOutgoingSource_numRTCPPackets_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
-  result = (uint64_t) ((arg1)->lastPLI);
+  result = (uint32_t) ((arg1)->numRTCPPackets);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_totalPLIs_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_RTPOutgoingSource_totalBytes_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->totalPLIs = arg2;
+  if (arg1) (arg1)->totalBytes = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSource_totalPLIs_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+intgo _wrap_RTPOutgoingSource_totalBytes_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalPLIs);
+  result = (uint32_t) ((arg1)->totalBytes);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_totalNACKs_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_RTPOutgoingSource_totalRTCPBytes_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->totalNACKs = arg2;
+  if (arg1) (arg1)->totalRTCPBytes = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSource_totalNACKs_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+intgo _wrap_RTPOutgoingSource_totalRTCPBytes_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalNACKs);
+  result = (uint32_t) ((arg1)->totalRTCPBytes);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSource_lastNACKed_set_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, long long _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_RTPOutgoingSource_lastSenderReport_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, long long _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint64_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   arg2 = (uint64_t)_swig_go_1; 
   
-  if (arg1) (arg1)->lastNACKed = arg2;
+  if (arg1) (arg1)->lastSenderReport = arg2;
   
 }
 
 
-long long _wrap_RTPIncomingSource_lastNACKed_get_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+long long _wrap_RTPOutgoingSource_lastSenderReport_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint64_t result;
   long long _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
-  result = (uint64_t) ((arg1)->lastNACKed);
+  result = (uint64_t) ((arg1)->lastSenderReport);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-LayerSources *_wrap_RTPIncomingSource_layers_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  LayerSources result;
-  LayerSources *_swig_go_result;
+void _wrap_RTPOutgoingSource_lastSenderReportNTP_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, long long _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint64_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg2 = (uint64_t)_swig_go_1; 
   
-  result = RTPIncomingSource_layers(arg1);
-  *(LayerSources **)&_swig_go_result = new LayerSources(result); 
+  if (arg1) (arg1)->lastSenderReportNTP = arg2;
+  
+}
+
+
+long long _wrap_RTPOutgoingSource_lastSenderReportNTP_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
+  
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  
+  result = (uint64_t) ((arg1)->lastSenderReportNTP);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-RTPIncomingSource *_wrap_new_RTPIncomingSource_native_3e8e6202ec41eede() {
-  RTPIncomingSource *result = 0 ;
-  RTPIncomingSource *_swig_go_result;
+RTPOutgoingSource *_wrap_new_RTPOutgoingSource_native_3e8e6202ec41eede() {
+  RTPOutgoingSource *result = 0 ;
+  RTPOutgoingSource *_swig_go_result;
   
   
-  result = (RTPIncomingSource *)new RTPIncomingSource();
-  *(RTPIncomingSource **)&_swig_go_result = (RTPIncomingSource *)result; 
+  result = (RTPOutgoingSource *)new RTPOutgoingSource();
+  *(RTPOutgoingSource **)&_swig_go_result = (RTPOutgoingSource *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPIncomingSource_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_delete_RTPOutgoingSource_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
   delete arg1;
   
 }
 
 
-void _wrap_SetRTPIncomingSource_Ssrc_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_SetRTPOutgoingSource_Ssrc_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
@@ -2621,12 +3897,12 @@ void _wrap_SetRTPIncomingSource_Ssrc_native_3e8e6202ec41eede(RTPIncomingSource *
 }
 
 
-intgo _wrap_GetRTPIncomingSource_Ssrc_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+intgo _wrap_GetRTPOutgoingSource_Ssrc_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
   result = (uint32_t) ((swig_b0)->ssrc);
@@ -2635,11 +3911,11 @@ intgo _wrap_GetRTPIncomingSource_Ssrc_native_3e8e6202ec41eede(RTPIncomingSource
 }
 
 
-void _wrap_SetRTPIncomingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_SetRTPOutgoingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
@@ -2648,12 +3924,12 @@ void _wrap_SetRTPIncomingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPIncomingSou
 }
 
 
-intgo _wrap_GetRTPIncomingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+intgo _wrap_GetRTPOutgoingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
   result = (uint32_t) ((swig_b0)->extSeqNum);
@@ -2662,11 +3938,11 @@ intgo _wrap_GetRTPIncomingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPIncomingSo
 }
 
 
-void _wrap_SetRTPIncomingSource_Cycles_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_SetRTPOutgoingSource_Cycles_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
@@ -2675,12 +3951,12 @@ void _wrap_SetRTPIncomingSource_Cycles_native_3e8e6202ec41eede(RTPIncomingSource
 }
 
 
-intgo _wrap_GetRTPIncomingSource_Cycles_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+intgo _wrap_GetRTPOutgoingSource_Cycles_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
   result = (uint32_t) ((swig_b0)->cycles);
@@ -2689,11 +3965,11 @@ intgo _wrap_GetRTPIncomingSource_Cycles_native_3e8e6202ec41eede(RTPIncomingSourc
 }
 
 
-void _wrap_SetRTPIncomingSource_Jitter_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_SetRTPOutgoingSource_Jitter_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
@@ -2702,12 +3978,12 @@ void _wrap_SetRTPIncomingSource_Jitter_native_3e8e6202ec41eede(RTPIncomingSource
 }
 
 
-intgo _wrap_GetRTPIncomingSource_Jitter_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+intgo _wrap_GetRTPOutgoingSource_Jitter_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
   result = (uint32_t) ((swig_b0)->jitter);
@@ -2716,2631 +3992,2822 @@ intgo _wrap_GetRTPIncomingSource_Jitter_native_3e8e6202ec41eede(RTPIncomingSourc
 }
 
 
-void _wrap_SetRTPIncomingSource_NumPackets_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+void _wrap_SetRTPOutgoingSource_Bitrate_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->numPackets = arg2;
+  if (swig_b0) (swig_b0)->bitrate = arg2;
   
 }
 
 
-intgo _wrap_GetRTPIncomingSource_NumPackets_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
+intgo _wrap_GetRTPOutgoingSource_Bitrate_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
+  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
   
   RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->numPackets);
+  result = (uint32_t) ((swig_b0)->bitrate);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetRTPIncomingSource_NumRTCPPackets_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_delete_TimeService_native_3e8e6202ec41eede(TimeService *_swig_go_0) {
+  TimeService *arg1 = (TimeService *) 0 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(TimeService **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->numRTCPPackets = arg2;
+  delete arg1;
   
 }
 
 
-intgo _wrap_GetRTPIncomingSource_NumRTCPPackets_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+RTPOutgoingSourceGroup *_wrap_new_RTPOutgoingSourceGroup__SWIG_0_native_3e8e6202ec41eede(intgo _swig_go_0) {
+  MediaFrameType arg1 ;
+  RTPOutgoingSourceGroup *result = 0 ;
+  RTPOutgoingSourceGroup *_swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = (MediaFrameType)_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->numRTCPPackets);
-  _swig_go_result = result; 
+  result = (RTPOutgoingSourceGroup *)new RTPOutgoingSourceGroup(arg1);
+  *(RTPOutgoingSourceGroup **)&_swig_go_result = (RTPOutgoingSourceGroup *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetRTPIncomingSource_TotalBytes_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint32_t arg2 ;
+RTPOutgoingSourceGroup *_wrap_new_RTPOutgoingSourceGroup__SWIG_1_native_3e8e6202ec41eede(std::string *_swig_go_0, intgo _swig_go_1) {
+  std::string *arg1 = 0 ;
+  MediaFrameType arg2 ;
+  RTPOutgoingSourceGroup *result = 0 ;
+  RTPOutgoingSourceGroup *_swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(std::string **)&_swig_go_0; 
+  arg2 = (MediaFrameType)_swig_go_1; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->totalBytes = arg2;
+  result = (RTPOutgoingSourceGroup *)new RTPOutgoingSourceGroup(*arg1,arg2);
+  *(RTPOutgoingSourceGroup **)&_swig_go_result = (RTPOutgoingSourceGroup *)result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_RTPOutgoingSourceGroup_Xtype_set_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0, intgo _swig_go_1) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+  MediaFrameType arg2 ;
+  
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  arg2 = (MediaFrameType)_swig_go_1; 
+  
+  if (arg1) (arg1)->type = arg2;
   
 }
 
 
-intgo _wrap_GetRTPIncomingSource_TotalBytes_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint32_t result;
+intgo _wrap_RTPOutgoingSourceGroup_Xtype_get_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+  MediaFrameType result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->totalBytes);
-  _swig_go_result = result; 
+  result = (MediaFrameType) ((arg1)->type);
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetRTPIncomingSource_TotalRTCPBytes_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPOutgoingSourceGroup_media_set_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0, RTPOutgoingSource *_swig_go_1) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+  RTPOutgoingSource *arg2 = (RTPOutgoingSource *) 0 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  arg2 = *(RTPOutgoingSource **)&_swig_go_1; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->totalRTCPBytes = arg2;
+  if (arg1) (arg1)->media = *arg2;
   
 }
 
 
-intgo _wrap_GetRTPIncomingSource_TotalRTCPBytes_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+RTPOutgoingSource *_wrap_RTPOutgoingSourceGroup_media_get_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+  RTPOutgoingSource *result = 0 ;
+  RTPOutgoingSource *_swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->totalRTCPBytes);
-  _swig_go_result = result; 
+  result = (RTPOutgoingSource *)& ((arg1)->media);
+  *(RTPOutgoingSource **)&_swig_go_result = (RTPOutgoingSource *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetRTPIncomingSource_Bitrate_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPOutgoingSourceGroup_fec_set_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0, RTPOutgoingSource *_swig_go_1) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+  RTPOutgoingSource *arg2 = (RTPOutgoingSource *) 0 ;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  arg2 = *(RTPOutgoingSource **)&_swig_go_1; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->bitrate = arg2;
+  if (arg1) (arg1)->fec = *arg2;
   
 }
 
 
-intgo _wrap_GetRTPIncomingSource_Bitrate_native_3e8e6202ec41eede(RTPIncomingSource *_swig_go_0) {
-  RTPIncomingSource *arg1 = (RTPIncomingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+RTPOutgoingSource *_wrap_RTPOutgoingSourceGroup_fec_get_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+  RTPOutgoingSource *result = 0 ;
+  RTPOutgoingSource *_swig_go_result;
   
-  arg1 = *(RTPIncomingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->bitrate);
-  _swig_go_result = result; 
+  result = (RTPOutgoingSource *)& ((arg1)->fec);
+  *(RTPOutgoingSource **)&_swig_go_result = (RTPOutgoingSource *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSource_time_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPOutgoingSourceGroup_rtx_set_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0, RTPOutgoingSource *_swig_go_1) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+  RTPOutgoingSource *arg2 = (RTPOutgoingSource *) 0 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  arg2 = *(RTPOutgoingSource **)&_swig_go_1; 
   
-  if (arg1) (arg1)->time = arg2;
+  if (arg1) (arg1)->rtx = *arg2;
   
 }
 
 
-intgo _wrap_RTPOutgoingSource_time_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+RTPOutgoingSource *_wrap_RTPOutgoingSourceGroup_rtx_get_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+  RTPOutgoingSource *result = 0 ;
+  RTPOutgoingSource *_swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->time);
-  _swig_go_result = result; 
+  result = (RTPOutgoingSource *)& ((arg1)->rtx);
+  *(RTPOutgoingSource **)&_swig_go_result = (RTPOutgoingSource *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSource_lastTime_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPOutgoingSourceGroup_Update_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
   
-  if (arg1) (arg1)->lastTime = arg2;
+  (arg1)->Update();
   
 }
 
 
-intgo _wrap_RTPOutgoingSource_lastTime_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+void _wrap_delete_RTPOutgoingSourceGroup_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
+  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (uint32_t) ((arg1)->lastTime);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSource_numPackets_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_delete_RTPIncomingMediaStreamListener_native_3e8e6202ec41eede(RTPIncomingMediaStreamListener *_swig_go_0) {
+  RTPIncomingMediaStreamListener *arg1 = (RTPIncomingMediaStreamListener *) 0 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPIncomingMediaStreamListener **)&_swig_go_0; 
   
-  if (arg1) (arg1)->numPackets = arg2;
+  delete arg1;
   
 }
 
 
-intgo _wrap_RTPOutgoingSource_numPackets_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+RTPIncomingSourceGroup *_wrap_new_RTPIncomingSourceGroup_native_3e8e6202ec41eede(intgo _swig_go_0, TimeService *_swig_go_1) {
+  MediaFrameType arg1 ;
+  TimeService *arg2 = 0 ;
+  RTPIncomingSourceGroup *result = 0 ;
+  RTPIncomingSourceGroup *_swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = (MediaFrameType)_swig_go_0; 
+  arg2 = *(TimeService **)&_swig_go_1; 
   
-  result = (uint32_t) ((arg1)->numPackets);
-  _swig_go_result = result; 
+  result = (RTPIncomingSourceGroup *)new RTPIncomingSourceGroup(arg1,*arg2);
+  *(RTPIncomingSourceGroup **)&_swig_go_result = (RTPIncomingSourceGroup *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSource_numRTCPPackets_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPIncomingSourceGroup_rid_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, _gostring_ _swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  std::string *arg2 = 0 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  if (arg1) (arg1)->numRTCPPackets = arg2;
+  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
+  arg2 = &arg2_str;
+  
+  
+  if (arg1) (arg1)->rid = *arg2;
   
 }
 
 
-intgo _wrap_RTPOutgoingSource_numRTCPPackets_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+_gostring_ _wrap_RTPIncomingSourceGroup_rid_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  std::string *result = 0 ;
+  _gostring_ _swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->numRTCPPackets);
-  _swig_go_result = result; 
+  result = (std::string *) & ((arg1)->rid);
+  _swig_go_result = Swig_AllocateString((*result).data(), (*result).length()); 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSource_totalBytes_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPIncomingSourceGroup_mid_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, _gostring_ _swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  std::string *arg2 = 0 ;
+  
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  
+  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
+  arg2 = &arg2_str;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->totalBytes = arg2;
+  if (arg1) (arg1)->mid = *arg2;
   
 }
 
 
-intgo _wrap_RTPOutgoingSource_totalBytes_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+_gostring_ _wrap_RTPIncomingSourceGroup_mid_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  std::string *result = 0 ;
+  _gostring_ _swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalBytes);
-  _swig_go_result = result; 
+  result = (std::string *) & ((arg1)->mid);
+  _swig_go_result = Swig_AllocateString((*result).data(), (*result).length()); 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSource_totalRTCPBytes_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+void _wrap_RTPIncomingSourceGroup_rtt_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  if (arg1) (arg1)->totalRTCPBytes = arg2;
+  if (arg1) (arg1)->rtt = arg2;
   
 }
 
 
-intgo _wrap_RTPOutgoingSource_totalRTCPBytes_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+intgo _wrap_RTPIncomingSourceGroup_rtt_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->totalRTCPBytes);
+  result = (uint32_t) ((arg1)->rtt);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSource_lastSenderReport_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, long long _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint64_t arg2 ;
+void _wrap_RTPIncomingSourceGroup_Xtype_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  MediaFrameType arg2 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg2 = (MediaFrameType)_swig_go_1; 
   
-  if (arg1) (arg1)->lastSenderReport = arg2;
+  if (arg1) (arg1)->type = arg2;
   
 }
 
 
-long long _wrap_RTPOutgoingSource_lastSenderReport_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+intgo _wrap_RTPIncomingSourceGroup_Xtype_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  MediaFrameType result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  result = (uint64_t) ((arg1)->lastSenderReport);
-  _swig_go_result = result; 
+  result = (MediaFrameType) ((arg1)->type);
+  _swig_go_result = (intgo)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSource_lastSenderReportNTP_set_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, long long _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint64_t arg2 ;
+void _wrap_RTPIncomingSourceGroup_media_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingSource *_swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  RTPIncomingSource *arg2 = (RTPIncomingSource *) 0 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint64_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg2 = *(RTPIncomingSource **)&_swig_go_1; 
   
-  if (arg1) (arg1)->lastSenderReportNTP = arg2;
+  if (arg1) (arg1)->media = *arg2;
   
 }
 
 
-long long _wrap_RTPOutgoingSource_lastSenderReportNTP_get_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+RTPIncomingSource *_wrap_RTPIncomingSourceGroup_media_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  RTPIncomingSource *result = 0 ;
+  RTPIncomingSource *_swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  result = (uint64_t) ((arg1)->lastSenderReportNTP);
-  _swig_go_result = result; 
+  result = (RTPIncomingSource *)& ((arg1)->media);
+  *(RTPIncomingSource **)&_swig_go_result = (RTPIncomingSource *)result; 
   return _swig_go_result;
 }
 
 
-RTPOutgoingSource *_wrap_new_RTPOutgoingSource_native_3e8e6202ec41eede() {
-  RTPOutgoingSource *result = 0 ;
-  RTPOutgoingSource *_swig_go_result;
+void _wrap_RTPIncomingSourceGroup_fec_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingSource *_swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  RTPIncomingSource *arg2 = (RTPIncomingSource *) 0 ;
+  
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg2 = *(RTPIncomingSource **)&_swig_go_1; 
   
+  if (arg1) (arg1)->fec = *arg2;
   
-  result = (RTPOutgoingSource *)new RTPOutgoingSource();
-  *(RTPOutgoingSource **)&_swig_go_result = (RTPOutgoingSource *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPOutgoingSource_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+RTPIncomingSource *_wrap_RTPIncomingSourceGroup_fec_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  RTPIncomingSource *result = 0 ;
+  RTPIncomingSource *_swig_go_result;
   
-  delete arg1;
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
+  result = (RTPIncomingSource *)& ((arg1)->fec);
+  *(RTPIncomingSource **)&_swig_go_result = (RTPIncomingSource *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_SetRTPOutgoingSource_Ssrc_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPIncomingSourceGroup_rtx_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingSource *_swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  RTPIncomingSource *arg2 = (RTPIncomingSource *) 0 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg2 = *(RTPIncomingSource **)&_swig_go_1; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->ssrc = arg2;
+  if (arg1) (arg1)->rtx = *arg2;
   
 }
 
 
-intgo _wrap_GetRTPOutgoingSource_Ssrc_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+RTPIncomingSource *_wrap_RTPIncomingSourceGroup_rtx_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  RTPIncomingSource *result = 0 ;
+  RTPIncomingSource *_swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->ssrc);
-  _swig_go_result = result; 
+  result = (RTPIncomingSource *)& ((arg1)->rtx);
+  *(RTPIncomingSource **)&_swig_go_result = (RTPIncomingSource *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetRTPOutgoingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+void _wrap_RTPIncomingSourceGroup_lost_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->extSeqNum = arg2;
+  if (arg1) (arg1)->lost = arg2;
   
 }
 
 
-intgo _wrap_GetRTPOutgoingSource_ExtSeqNum_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+intgo _wrap_RTPIncomingSourceGroup_lost_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->extSeqNum);
+  result = (uint32_t) ((arg1)->lost);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetRTPOutgoingSource_Cycles_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+void _wrap_RTPIncomingSourceGroup_minWaitedTime_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->cycles = arg2;
+  if (arg1) (arg1)->minWaitedTime = arg2;
   
 }
 
 
-intgo _wrap_GetRTPOutgoingSource_Cycles_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+intgo _wrap_RTPIncomingSourceGroup_minWaitedTime_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->cycles);
+  result = (uint32_t) ((arg1)->minWaitedTime);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetRTPOutgoingSource_Jitter_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+void _wrap_RTPIncomingSourceGroup_maxWaitedTime_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->jitter = arg2;
+  if (arg1) (arg1)->maxWaitedTime = arg2;
   
 }
 
 
-intgo _wrap_GetRTPOutgoingSource_Jitter_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
+intgo _wrap_RTPIncomingSourceGroup_maxWaitedTime_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   uint32_t result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->jitter);
+  result = (uint32_t) ((arg1)->maxWaitedTime);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_SetRTPOutgoingSource_Bitrate_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPIncomingSourceGroup_avgWaitedTime_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, double _swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  double arg2 ;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg2 = (double)_swig_go_1; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  if (swig_b0) (swig_b0)->bitrate = arg2;
+  if (arg1) (arg1)->avgWaitedTime = arg2;
   
 }
 
 
-intgo _wrap_GetRTPOutgoingSource_Bitrate_native_3e8e6202ec41eede(RTPOutgoingSource *_swig_go_0) {
-  RTPOutgoingSource *arg1 = (RTPOutgoingSource *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+double _wrap_RTPIncomingSourceGroup_avgWaitedTime_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  double result;
+  double _swig_go_result;
   
-  arg1 = *(RTPOutgoingSource **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  RTPSource *swig_b0 = (RTPSource *)arg1;
-  result = (uint32_t) ((swig_b0)->bitrate);
+  result = (double) ((arg1)->avgWaitedTime);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_TimeService_native_3e8e6202ec41eede(TimeService *_swig_go_0) {
-  TimeService *arg1 = (TimeService *) 0 ;
+void _wrap_RTPIncomingSourceGroup_AddListener_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingMediaStreamListener *_swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  RTPIncomingMediaStreamListener *arg2 = (RTPIncomingMediaStreamListener *) 0 ;
   
-  arg1 = *(TimeService **)&_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg2 = *(RTPIncomingMediaStreamListener **)&_swig_go_1; 
   
-  delete arg1;
+  (arg1)->AddListener(arg2);
   
 }
 
 
-RTPOutgoingSourceGroup *_wrap_new_RTPOutgoingSourceGroup__SWIG_0_native_3e8e6202ec41eede(intgo _swig_go_0) {
-  MediaFrameType arg1 ;
-  RTPOutgoingSourceGroup *result = 0 ;
-  RTPOutgoingSourceGroup *_swig_go_result;
+void _wrap_RTPIncomingSourceGroup_RemoveListener_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingMediaStreamListener *_swig_go_1) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  RTPIncomingMediaStreamListener *arg2 = (RTPIncomingMediaStreamListener *) 0 ;
   
-  arg1 = (MediaFrameType)_swig_go_0; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg2 = *(RTPIncomingMediaStreamListener **)&_swig_go_1; 
+  
+  (arg1)->RemoveListener(arg2);
   
-  result = (RTPOutgoingSourceGroup *)new RTPOutgoingSourceGroup(arg1);
-  *(RTPOutgoingSourceGroup **)&_swig_go_result = (RTPOutgoingSourceGroup *)result; 
-  return _swig_go_result;
 }
 
 
-RTPOutgoingSourceGroup *_wrap_new_RTPOutgoingSourceGroup__SWIG_1_native_3e8e6202ec41eede(std::string *_swig_go_0, intgo _swig_go_1) {
-  std::string *arg1 = 0 ;
-  MediaFrameType arg2 ;
-  RTPOutgoingSourceGroup *result = 0 ;
-  RTPOutgoingSourceGroup *_swig_go_result;
+void _wrap_RTPIncomingSourceGroup_Update_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+  
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  arg1 = *(std::string **)&_swig_go_0; 
-  arg2 = (MediaFrameType)_swig_go_1; 
+  (arg1)->Update();
   
-  result = (RTPOutgoingSourceGroup *)new RTPOutgoingSourceGroup(*arg1,arg2);
-  *(RTPOutgoingSourceGroup **)&_swig_go_result = (RTPOutgoingSourceGroup *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSourceGroup_Xtype_set_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0, intgo _swig_go_1) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  MediaFrameType arg2 ;
+void _wrap_delete_RTPIncomingSourceGroup_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
+  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
-  arg2 = (MediaFrameType)_swig_go_1; 
+  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  if (arg1) (arg1)->type = arg2;
+  delete arg1;
   
 }
 
 
-intgo _wrap_RTPOutgoingSourceGroup_Xtype_get_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  MediaFrameType result;
-  intgo _swig_go_result;
+RTPIncomingMediaStreamMultiplexer *_wrap_new_RTPIncomingMediaStreamMultiplexer_native_3e8e6202ec41eede(intgo _swig_go_0, TimeService *_swig_go_1) {
+  uint32_t arg1 ;
+  TimeService *arg2 = 0 ;
+  RTPIncomingMediaStreamMultiplexer *result = 0 ;
+  RTPIncomingMediaStreamMultiplexer *_swig_go_result;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  arg1 = (uint32_t)_swig_go_0; 
+  arg2 = *(TimeService **)&_swig_go_1; 
   
-  result = (MediaFrameType) ((arg1)->type);
-  _swig_go_result = (intgo)result; 
+  result = (RTPIncomingMediaStreamMultiplexer *)new RTPIncomingMediaStreamMultiplexer(arg1,*arg2);
+  *(RTPIncomingMediaStreamMultiplexer **)&_swig_go_result = (RTPIncomingMediaStreamMultiplexer *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSourceGroup_media_set_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0, RTPOutgoingSource *_swig_go_1) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  RTPOutgoingSource *arg2 = (RTPOutgoingSource *) 0 ;
+void _wrap_RTPIncomingMediaStreamMultiplexer_Stop_native_3e8e6202ec41eede(RTPIncomingMediaStreamMultiplexer *_swig_go_0) {
+  RTPIncomingMediaStreamMultiplexer *arg1 = (RTPIncomingMediaStreamMultiplexer *) 0 ;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
-  arg2 = *(RTPOutgoingSource **)&_swig_go_1; 
+  arg1 = *(RTPIncomingMediaStreamMultiplexer **)&_swig_go_0; 
   
-  if (arg1) (arg1)->media = *arg2;
+  (arg1)->Stop();
   
 }
 
 
-RTPOutgoingSource *_wrap_RTPOutgoingSourceGroup_media_get_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  RTPOutgoingSource *result = 0 ;
-  RTPOutgoingSource *_swig_go_result;
+void _wrap_delete_RTPIncomingMediaStreamMultiplexer_native_3e8e6202ec41eede(RTPIncomingMediaStreamMultiplexer *_swig_go_0) {
+  RTPIncomingMediaStreamMultiplexer *arg1 = (RTPIncomingMediaStreamMultiplexer *) 0 ;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPIncomingMediaStreamMultiplexer **)&_swig_go_0; 
   
-  result = (RTPOutgoingSource *)& ((arg1)->media);
-  *(RTPOutgoingSource **)&_swig_go_result = (RTPOutgoingSource *)result; 
+  delete arg1;
+  
+}
+
+
+RTPIncomingMediaStream *_wrap_RTPIncomingMediaStreamMultiplexer_SwigGetRTPIncomingMediaStream_native_3e8e6202ec41eede(RTPIncomingMediaStreamMultiplexer *_swig_go_0) {
+  RTPIncomingMediaStreamMultiplexer *arg1 = (RTPIncomingMediaStreamMultiplexer *) 0 ;
+  RTPIncomingMediaStream *result = 0 ;
+  RTPIncomingMediaStream *_swig_go_result;
+  
+  arg1 = *(RTPIncomingMediaStreamMultiplexer **)&_swig_go_0; 
+  
+  result = (RTPIncomingMediaStream*)arg1;
+  *(RTPIncomingMediaStream **)&_swig_go_result = (RTPIncomingMediaStream *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSourceGroup_fec_set_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0, RTPOutgoingSource *_swig_go_1) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  RTPOutgoingSource *arg2 = (RTPOutgoingSource *) 0 ;
+void _wrap_PropertiesFacade_SetPropertyInt_native_3e8e6202ec41eede(PropertiesFacade *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2) {
+  PropertiesFacade *arg1 = (PropertiesFacade *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int arg3 ;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
-  arg2 = *(RTPOutgoingSource **)&_swig_go_1; 
+  arg1 = *(PropertiesFacade **)&_swig_go_0; 
   
-  if (arg1) (arg1)->fec = *arg2;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
   
+  arg3 = (int)_swig_go_2; 
+  
+  (arg1)->SetPropertyInt((char const *)arg2,arg3);
+  
+  free(arg2); 
 }
 
 
-RTPOutgoingSource *_wrap_RTPOutgoingSourceGroup_fec_get_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  RTPOutgoingSource *result = 0 ;
-  RTPOutgoingSource *_swig_go_result;
+void _wrap_PropertiesFacade_SetPropertyStr_native_3e8e6202ec41eede(PropertiesFacade *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2) {
+  PropertiesFacade *arg1 = (PropertiesFacade *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  arg1 = *(PropertiesFacade **)&_swig_go_0; 
   
-  result = (RTPOutgoingSource *)& ((arg1)->fec);
-  *(RTPOutgoingSource **)&_swig_go_result = (RTPOutgoingSource *)result; 
-  return _swig_go_result;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  (arg1)->SetPropertyStr((char const *)arg2,(char const *)arg3);
+  
+  free(arg2); 
+  free(arg3); 
 }
 
 
-void _wrap_RTPOutgoingSourceGroup_rtx_set_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0, RTPOutgoingSource *_swig_go_1) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  RTPOutgoingSource *arg2 = (RTPOutgoingSource *) 0 ;
+void _wrap_PropertiesFacade_SetPropertyBool_native_3e8e6202ec41eede(PropertiesFacade *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2) {
+  PropertiesFacade *arg1 = (PropertiesFacade *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool arg3 ;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
-  arg2 = *(RTPOutgoingSource **)&_swig_go_1; 
+  arg1 = *(PropertiesFacade **)&_swig_go_0; 
   
-  if (arg1) (arg1)->rtx = *arg2;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (bool)_swig_go_2; 
   
+  (arg1)->SetPropertyBool((char const *)arg2,arg3);
+  
+  free(arg2); 
 }
 
 
-RTPOutgoingSource *_wrap_RTPOutgoingSourceGroup_rtx_get_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  RTPOutgoingSource *result = 0 ;
-  RTPOutgoingSource *_swig_go_result;
+PropertiesFacade *_wrap_new_PropertiesFacade_native_3e8e6202ec41eede() {
+  PropertiesFacade *result = 0 ;
+  PropertiesFacade *_swig_go_result;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
   
-  result = (RTPOutgoingSource *)& ((arg1)->rtx);
-  *(RTPOutgoingSource **)&_swig_go_result = (RTPOutgoingSource *)result; 
+  result = (PropertiesFacade *)new PropertiesFacade();
+  *(PropertiesFacade **)&_swig_go_result = (PropertiesFacade *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPOutgoingSourceGroup_Update_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
+void _wrap_delete_PropertiesFacade_native_3e8e6202ec41eede(PropertiesFacade *_swig_go_0) {
+  PropertiesFacade *arg1 = (PropertiesFacade *) 0 ;
   
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
+  arg1 = *(PropertiesFacade **)&_swig_go_0; 
   
-  (arg1)->Update();
+  delete arg1;
   
 }
 
 
-void _wrap_delete_RTPOutgoingSourceGroup_native_3e8e6202ec41eede(RTPOutgoingSourceGroup *_swig_go_0) {
-  RTPOutgoingSourceGroup *arg1 = (RTPOutgoingSourceGroup *) 0 ;
-  
-  arg1 = *(RTPOutgoingSourceGroup **)&_swig_go_0; 
-  
-  delete arg1;
+void _wrap_MediaServer_Initialize_native_3e8e6202ec41eede() {
+  MediaServer::Initialize();
   
 }
 
 
-void _wrap_delete_RTPIncomingMediaStreamListener_native_3e8e6202ec41eede(RTPIncomingMediaStreamListener *_swig_go_0) {
-  RTPIncomingMediaStreamListener *arg1 = (RTPIncomingMediaStreamListener *) 0 ;
+void _wrap_MediaServer_EnableLog_native_3e8e6202ec41eede(bool _swig_go_0) {
+  bool arg1 ;
   
-  arg1 = *(RTPIncomingMediaStreamListener **)&_swig_go_0; 
+  arg1 = (bool)_swig_go_0; 
   
-  delete arg1;
+  MediaServer::EnableLog(arg1);
   
 }
 
 
-RTPIncomingSourceGroup *_wrap_new_RTPIncomingSourceGroup_native_3e8e6202ec41eede(intgo _swig_go_0, TimeService *_swig_go_1) {
-  MediaFrameType arg1 ;
-  TimeService *arg2 = 0 ;
-  RTPIncomingSourceGroup *result = 0 ;
-  RTPIncomingSourceGroup *_swig_go_result;
+void _wrap_MediaServer_EnableDebug_native_3e8e6202ec41eede(bool _swig_go_0) {
+  bool arg1 ;
   
-  arg1 = (MediaFrameType)_swig_go_0; 
-  arg2 = *(TimeService **)&_swig_go_1; 
+  arg1 = (bool)_swig_go_0; 
+  
+  MediaServer::EnableDebug(arg1);
   
-  result = (RTPIncomingSourceGroup *)new RTPIncomingSourceGroup(arg1,*arg2);
-  *(RTPIncomingSourceGroup **)&_swig_go_result = (RTPIncomingSourceGroup *)result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_rid_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, _gostring_ _swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  std::string *arg2 = 0 ;
+void _wrap_MediaServer_EnableUltraDebug_native_3e8e6202ec41eede(bool _swig_go_0) {
+  bool arg1 ;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = (bool)_swig_go_0; 
   
-  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
-  arg2 = &arg2_str;
+  MediaServer::EnableUltraDebug(arg1);
   
+}
+
+
+_gostring_ _wrap_MediaServer_GetFingerprint_native_3e8e6202ec41eede() {
+  std::string result;
+  _gostring_ _swig_go_result;
   
-  if (arg1) (arg1)->rid = *arg2;
   
+  result = MediaServer::GetFingerprint();
+  _swig_go_result = Swig_AllocateString((&result)->data(), (&result)->length()); 
+  return _swig_go_result;
 }
 
 
-_gostring_ _wrap_RTPIncomingSourceGroup_rid_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  std::string *result = 0 ;
-  _gostring_ _swig_go_result;
+bool _wrap_MediaServer_SetPortRange_native_3e8e6202ec41eede(intgo _swig_go_0, intgo _swig_go_1) {
+  int arg1 ;
+  int arg2 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = (int)_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  result = (std::string *) & ((arg1)->rid);
-  _swig_go_result = Swig_AllocateString((*result).data(), (*result).length()); 
+  result = (bool)MediaServer::SetPortRange(arg1,arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_mid_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, _gostring_ _swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  std::string *arg2 = 0 ;
+bool _wrap_MediaServer_SetCertificate_native_3e8e6202ec41eede(_gostring_ _swig_go_0, _gostring_ _swig_go_1) {
+  char *arg1 = (char *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
-  arg2 = &arg2_str;
+  arg1 = (char *)malloc(_swig_go_0.n + 1);
+  memcpy(arg1, _swig_go_0.p, _swig_go_0.n);
+  arg1[_swig_go_0.n] = '\0';
   
   
-  if (arg1) (arg1)->mid = *arg2;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
   
+  result = (bool)MediaServer::SetCertificate((char const *)arg1,(char const *)arg2);
+  _swig_go_result = result; 
+  free(arg1); 
+  free(arg2); 
+  return _swig_go_result;
 }
 
 
-_gostring_ _wrap_RTPIncomingSourceGroup_mid_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  std::string *result = 0 ;
-  _gostring_ _swig_go_result;
+bool _wrap_MediaServer_SupportsCodec_native_3e8e6202ec41eede(_gostring_ _swig_go_0) {
+  char *arg1 = (char *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
   
-  result = (std::string *) & ((arg1)->mid);
-  _swig_go_result = Swig_AllocateString((*result).data(), (*result).length()); 
+  arg1 = (char *)malloc(_swig_go_0.n + 1);
+  memcpy(arg1, _swig_go_0.p, _swig_go_0.n);
+  arg1[_swig_go_0.n] = '\0';
+  
+  
+  result = (bool)MediaServer::SupportsCodec((char const *)arg1);
+  _swig_go_result = result; 
+  free(arg1); 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_rtt_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  uint32_t arg2 ;
-  
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+MediaServer *_wrap_new_MediaServer_native_3e8e6202ec41eede() {
+  MediaServer *result = 0 ;
+  MediaServer *_swig_go_result;
   
-  if (arg1) (arg1)->rtt = arg2;
   
+  result = (MediaServer *)new MediaServer();
+  *(MediaServer **)&_swig_go_result = (MediaServer *)result; 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_RTPIncomingSourceGroup_rtt_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+void _wrap_delete_MediaServer_native_3e8e6202ec41eede(MediaServer *_swig_go_0) {
+  MediaServer *arg1 = (MediaServer *) 0 ;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(MediaServer **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (uint32_t) ((arg1)->rtt);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_Xtype_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  MediaFrameType arg2 ;
+void _wrap_RTPBundleTransportConnection_transport_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, DTLSICETransport *_swig_go_1) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  DTLSICETransport *arg2 = (DTLSICETransport *) 0 ;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = (MediaFrameType)_swig_go_1; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg2 = *(DTLSICETransport **)&_swig_go_1; 
   
-  if (arg1) (arg1)->type = arg2;
+  if (arg1) (arg1)->transport = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSourceGroup_Xtype_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  MediaFrameType result;
-  intgo _swig_go_result;
+DTLSICETransport *_wrap_RTPBundleTransportConnection_transport_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  DTLSICETransport *result = 0 ;
+  DTLSICETransport *_swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
   
-  result = (MediaFrameType) ((arg1)->type);
-  _swig_go_result = (intgo)result; 
+  result = (DTLSICETransport *) ((arg1)->transport);
+  *(DTLSICETransport **)&_swig_go_result = (DTLSICETransport *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_media_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingSource *_swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  RTPIncomingSource *arg2 = (RTPIncomingSource *) 0 ;
+void _wrap_RTPBundleTransportConnection_disableSTUNKeepAlive_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, bool _swig_go_1) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  bool arg2 ;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = *(RTPIncomingSource **)&_swig_go_1; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg2 = (bool)_swig_go_1; 
   
-  if (arg1) (arg1)->media = *arg2;
+  if (arg1) (arg1)->disableSTUNKeepAlive = arg2;
   
 }
 
 
-RTPIncomingSource *_wrap_RTPIncomingSourceGroup_media_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  RTPIncomingSource *result = 0 ;
-  RTPIncomingSource *_swig_go_result;
+bool _wrap_RTPBundleTransportConnection_disableSTUNKeepAlive_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
   
-  result = (RTPIncomingSource *)& ((arg1)->media);
-  *(RTPIncomingSource **)&_swig_go_result = (RTPIncomingSource *)result; 
+  result = (bool) ((arg1)->disableSTUNKeepAlive);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_fec_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingSource *_swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  RTPIncomingSource *arg2 = (RTPIncomingSource *) 0 ;
+void _wrap_RTPBundleTransportConnection_iceRequestsSent_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, long long _swig_go_1) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  size_t arg2 ;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = *(RTPIncomingSource **)&_swig_go_1; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  if (arg1) (arg1)->fec = *arg2;
+  if (arg1) (arg1)->iceRequestsSent = arg2;
   
 }
 
 
-RTPIncomingSource *_wrap_RTPIncomingSourceGroup_fec_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  RTPIncomingSource *result = 0 ;
-  RTPIncomingSource *_swig_go_result;
+long long _wrap_RTPBundleTransportConnection_iceRequestsSent_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  size_t result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
   
-  result = (RTPIncomingSource *)& ((arg1)->fec);
-  *(RTPIncomingSource **)&_swig_go_result = (RTPIncomingSource *)result; 
+  result =  ((arg1)->iceRequestsSent);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_rtx_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingSource *_swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  RTPIncomingSource *arg2 = (RTPIncomingSource *) 0 ;
+void _wrap_RTPBundleTransportConnection_iceRequestsReceived_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, long long _swig_go_1) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  size_t arg2 ;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = *(RTPIncomingSource **)&_swig_go_1; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  if (arg1) (arg1)->rtx = *arg2;
+  if (arg1) (arg1)->iceRequestsReceived = arg2;
   
 }
 
 
-RTPIncomingSource *_wrap_RTPIncomingSourceGroup_rtx_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  RTPIncomingSource *result = 0 ;
-  RTPIncomingSource *_swig_go_result;
+long long _wrap_RTPBundleTransportConnection_iceRequestsReceived_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  size_t result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
   
-  result = (RTPIncomingSource *)& ((arg1)->rtx);
-  *(RTPIncomingSource **)&_swig_go_result = (RTPIncomingSource *)result; 
+  result =  ((arg1)->iceRequestsReceived);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_lost_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPBundleTransportConnection_iceResponsesSent_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, long long _swig_go_1) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  size_t arg2 ;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  if (arg1) (arg1)->lost = arg2;
+  if (arg1) (arg1)->iceResponsesSent = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSourceGroup_lost_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+long long _wrap_RTPBundleTransportConnection_iceResponsesSent_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  size_t result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->lost);
+  result =  ((arg1)->iceResponsesSent);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_minWaitedTime_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  uint32_t arg2 ;
+void _wrap_RTPBundleTransportConnection_iceResponsesReceived_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, long long _swig_go_1) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  size_t arg2 ;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg2 = (size_t)_swig_go_1; 
   
-  if (arg1) (arg1)->minWaitedTime = arg2;
+  if (arg1) (arg1)->iceResponsesReceived = arg2;
   
 }
 
 
-intgo _wrap_RTPIncomingSourceGroup_minWaitedTime_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+long long _wrap_RTPBundleTransportConnection_iceResponsesReceived_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
+  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
+  size_t result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->minWaitedTime);
+  result =  ((arg1)->iceResponsesReceived);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_maxWaitedTime_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, intgo _swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  uint32_t arg2 ;
-  
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+RTPBundleTransport *_wrap_new_RTPBundleTransport_native_3e8e6202ec41eede() {
+  RTPBundleTransport *result = 0 ;
+  RTPBundleTransport *_swig_go_result;
   
-  if (arg1) (arg1)->maxWaitedTime = arg2;
   
+  result = (RTPBundleTransport *)new RTPBundleTransport();
+  *(RTPBundleTransport **)&_swig_go_result = (RTPBundleTransport *)result; 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_RTPIncomingSourceGroup_maxWaitedTime_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  uint32_t result;
+intgo _wrap_RTPBundleTransport_Init__SWIG_0_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  int result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
-  result = (uint32_t) ((arg1)->maxWaitedTime);
+  result = (int)(arg1)->Init();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_avgWaitedTime_set_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, double _swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  double arg2 ;
-  
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = (double)_swig_go_1; 
+intgo _wrap_RTPBundleTransport_Init__SWIG_1_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, intgo _swig_go_1) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  int arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  if (arg1) (arg1)->avgWaitedTime = arg2;
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = (int)(arg1)->Init(arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-double _wrap_RTPIncomingSourceGroup_avgWaitedTime_get_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  double result;
-  double _swig_go_result;
+RTPBundleTransportConnection *_wrap_RTPBundleTransport_AddICETransport_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, _gostring_ _swig_go_1, Properties *_swig_go_2) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  std::string *arg2 = 0 ;
+  Properties *arg3 = 0 ;
+  RTPBundleTransportConnection *result = 0 ;
+  RTPBundleTransportConnection *_swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
-  result = (double) ((arg1)->avgWaitedTime);
-  _swig_go_result = result; 
+  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
+  arg2 = &arg2_str;
+  
+  arg3 = *(Properties **)&_swig_go_2; 
+  
+  result = (RTPBundleTransportConnection *)(arg1)->AddICETransport((std::string const &)*arg2,(Properties const &)*arg3);
+  *(RTPBundleTransportConnection **)&_swig_go_result = (RTPBundleTransportConnection *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_AddListener_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingMediaStreamListener *_swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  RTPIncomingMediaStreamListener *arg2 = (RTPIncomingMediaStreamListener *) 0 ;
+intgo _wrap_RTPBundleTransport_RemoveICETransport_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, _gostring_ _swig_go_1) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  std::string *arg2 = 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = *(RTPIncomingMediaStreamListener **)&_swig_go_1; 
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
-  (arg1)->AddListener(arg2);
+  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
+  arg2 = &arg2_str;
   
+  
+  result = (int)(arg1)->RemoveICETransport((std::string const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_RemoveListener_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0, RTPIncomingMediaStreamListener *_swig_go_1) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  RTPIncomingMediaStreamListener *arg2 = (RTPIncomingMediaStreamListener *) 0 ;
-  
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
-  arg2 = *(RTPIncomingMediaStreamListener **)&_swig_go_1; 
+intgo _wrap_RTPBundleTransport_End_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  (arg1)->RemoveListener(arg2);
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
+  result = (int)(arg1)->End();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingSourceGroup_Update_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
-  
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+intgo _wrap_RTPBundleTransport_GetLocalPort_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  (arg1)->Update();
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
+  result = (int)((RTPBundleTransport const *)arg1)->GetLocalPort();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPIncomingSourceGroup_native_3e8e6202ec41eede(RTPIncomingSourceGroup *_swig_go_0) {
-  RTPIncomingSourceGroup *arg1 = (RTPIncomingSourceGroup *) 0 ;
+intgo _wrap_RTPBundleTransport_AddRemoteCandidate_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, short _swig_go_3) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  std::string *arg2 = 0 ;
+  char *arg3 = (char *) 0 ;
+  uint16_t arg4 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPIncomingSourceGroup **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
-  delete arg1;
+  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
+  arg2 = &arg2_str;
   
-}
-
-
-RTPIncomingMediaStreamMultiplexer *_wrap_new_RTPIncomingMediaStreamMultiplexer_native_3e8e6202ec41eede(intgo _swig_go_0, TimeService *_swig_go_1) {
-  uint32_t arg1 ;
-  TimeService *arg2 = 0 ;
-  RTPIncomingMediaStreamMultiplexer *result = 0 ;
-  RTPIncomingMediaStreamMultiplexer *_swig_go_result;
   
-  arg1 = (uint32_t)_swig_go_0; 
-  arg2 = *(TimeService **)&_swig_go_1; 
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
   
-  result = (RTPIncomingMediaStreamMultiplexer *)new RTPIncomingMediaStreamMultiplexer(arg1,*arg2);
-  *(RTPIncomingMediaStreamMultiplexer **)&_swig_go_result = (RTPIncomingMediaStreamMultiplexer *)result; 
+  arg4 = (uint16_t)_swig_go_3; 
+  
+  result = (int)(arg1)->AddRemoteCandidate((std::string const &)*arg2,(char const *)arg3,arg4);
+  _swig_go_result = result; 
+  free(arg3); 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPIncomingMediaStreamMultiplexer_Stop_native_3e8e6202ec41eede(RTPIncomingMediaStreamMultiplexer *_swig_go_0) {
-  RTPIncomingMediaStreamMultiplexer *arg1 = (RTPIncomingMediaStreamMultiplexer *) 0 ;
-  
-  arg1 = *(RTPIncomingMediaStreamMultiplexer **)&_swig_go_0; 
+bool _wrap_RTPBundleTransport_SetAffinity_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, intgo _swig_go_1) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  int arg2 ;
+  bool result;
+  bool _swig_go_result;
   
-  (arg1)->Stop();
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
+  result = (bool)(arg1)->SetAffinity(arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPIncomingMediaStreamMultiplexer_native_3e8e6202ec41eede(RTPIncomingMediaStreamMultiplexer *_swig_go_0) {
-  RTPIncomingMediaStreamMultiplexer *arg1 = (RTPIncomingMediaStreamMultiplexer *) 0 ;
+void _wrap_RTPBundleTransport_SetIceTimeout_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, intgo _swig_go_1) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(RTPIncomingMediaStreamMultiplexer **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  delete arg1;
+  (arg1)->SetIceTimeout(arg2);
   
 }
 
 
-RTPIncomingMediaStream *_wrap_RTPIncomingMediaStreamMultiplexer_SwigGetRTPIncomingMediaStream_native_3e8e6202ec41eede(RTPIncomingMediaStreamMultiplexer *_swig_go_0) {
-  RTPIncomingMediaStreamMultiplexer *arg1 = (RTPIncomingMediaStreamMultiplexer *) 0 ;
-  RTPIncomingMediaStream *result = 0 ;
-  RTPIncomingMediaStream *_swig_go_result;
+TimeService *_wrap_RTPBundleTransport_GetTimeService_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+  TimeService *result = 0 ;
+  TimeService *_swig_go_result;
   
-  arg1 = *(RTPIncomingMediaStreamMultiplexer **)&_swig_go_0; 
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
-  result = (RTPIncomingMediaStream*)arg1;
-  *(RTPIncomingMediaStream **)&_swig_go_result = (RTPIncomingMediaStream *)result; 
+  result = (TimeService *) &(arg1)->GetTimeService();
+  *(TimeService **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_PropertiesFacade_SetPropertyInt_native_3e8e6202ec41eede(PropertiesFacade *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2) {
-  PropertiesFacade *arg1 = (PropertiesFacade *) 0 ;
-  char *arg2 = (char *) 0 ;
-  int arg3 ;
-  
-  arg1 = *(PropertiesFacade **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+void _wrap_delete_RTPBundleTransport_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
+  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
   
-  arg3 = (int)_swig_go_2; 
+  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
-  (arg1)->SetPropertyInt((char const *)arg2,arg3);
+  delete arg1;
   
-  free(arg2); 
 }
 
 
-void _wrap_PropertiesFacade_SetPropertyStr_native_3e8e6202ec41eede(PropertiesFacade *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2) {
-  PropertiesFacade *arg1 = (PropertiesFacade *) 0 ;
-  char *arg2 = (char *) 0 ;
-  char *arg3 = (char *) 0 ;
-  
-  arg1 = *(PropertiesFacade **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+DTLSICETransportListener *_wrap__swig_NewDirectorDTLSICETransportListenerDTLSICETransportListener_native_3e8e6202ec41eede(intgo _swig_go_0) {
+  int arg1 ;
+  DTLSICETransportListener *result = 0 ;
+  DTLSICETransportListener *_swig_go_result;
   
+  arg1 = (int)_swig_go_0; 
   
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
+  result = new SwigDirector_DTLSICETransportListener(arg1);
+  *(DTLSICETransportListener **)&_swig_go_result = (DTLSICETransportListener *)result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_DeleteDirectorDTLSICETransportListener_native_3e8e6202ec41eede(DTLSICETransportListener *_swig_go_0) {
+  DTLSICETransportListener *arg1 = (DTLSICETransportListener *) 0 ;
   
+  arg1 = *(DTLSICETransportListener **)&_swig_go_0; 
   
-  (arg1)->SetPropertyStr((char const *)arg2,(char const *)arg3);
+  delete arg1;
   
-  free(arg2); 
-  free(arg3); 
 }
 
 
-void _wrap_PropertiesFacade_SetPropertyBool_native_3e8e6202ec41eede(PropertiesFacade *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2) {
-  PropertiesFacade *arg1 = (PropertiesFacade *) 0 ;
-  char *arg2 = (char *) 0 ;
-  bool arg3 ;
-  
-  arg1 = *(PropertiesFacade **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+void _wrap__swig_DirectorDTLSICETransportListener_upcall_OnDTLSStateChange_native_3e8e6202ec41eede(SwigDirector_DTLSICETransportListener *_swig_go_0, intgo _swig_go_1) {
+  SwigDirector_DTLSICETransportListener *arg1 = (SwigDirector_DTLSICETransportListener *) 0 ;
+  uint32_t arg2 ;
   
-  arg3 = (bool)_swig_go_2; 
+  arg1 = *(SwigDirector_DTLSICETransportListener **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  (arg1)->SetPropertyBool((char const *)arg2,arg3);
+  arg1->_swig_upcall_onDTLSStateChange(arg2);
   
-  free(arg2); 
 }
 
 
-PropertiesFacade *_wrap_new_PropertiesFacade_native_3e8e6202ec41eede() {
-  PropertiesFacade *result = 0 ;
-  PropertiesFacade *_swig_go_result;
+DTLSICETransportListener *_wrap_new_DTLSICETransportListener_native_3e8e6202ec41eede() {
+  DTLSICETransportListener *result = 0 ;
+  DTLSICETransportListener *_swig_go_result;
   
   
-  result = (PropertiesFacade *)new PropertiesFacade();
-  *(PropertiesFacade **)&_swig_go_result = (PropertiesFacade *)result; 
+  result = (DTLSICETransportListener *)new DTLSICETransportListener();
+  *(DTLSICETransportListener **)&_swig_go_result = (DTLSICETransportListener *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_PropertiesFacade_native_3e8e6202ec41eede(PropertiesFacade *_swig_go_0) {
-  PropertiesFacade *arg1 = (PropertiesFacade *) 0 ;
+void _wrap_delete_DTLSICETransportListener_native_3e8e6202ec41eede(DTLSICETransportListener *_swig_go_0) {
+  DTLSICETransportListener *arg1 = (DTLSICETransportListener *) 0 ;
   
-  arg1 = *(PropertiesFacade **)&_swig_go_0; 
+  arg1 = *(DTLSICETransportListener **)&_swig_go_0; 
   
   delete arg1;
   
 }
 
 
-void _wrap_MediaServer_Initialize_native_3e8e6202ec41eede() {
-  MediaServer::Initialize();
+void _wrap_DTLSICETransportListener_onDTLSStateChange_native_3e8e6202ec41eede(DTLSICETransportListener *_swig_go_0, intgo _swig_go_1) {
+  DTLSICETransportListener *arg1 = (DTLSICETransportListener *) 0 ;
+  uint32_t arg2 ;
+  
+  arg1 = *(DTLSICETransportListener **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
+  
+  (arg1)->onDTLSStateChange(arg2);
   
 }
 
 
-void _wrap_MediaServer_EnableLog_native_3e8e6202ec41eede(bool _swig_go_0) {
-  bool arg1 ;
+void _wrap_delete_RemoteRateEstimatorListener_native_3e8e6202ec41eede(RemoteRateEstimatorListener *_swig_go_0) {
+  RemoteRateEstimatorListener *arg1 = (RemoteRateEstimatorListener *) 0 ;
   
-  arg1 = (bool)_swig_go_0; 
+  arg1 = *(RemoteRateEstimatorListener **)&_swig_go_0; 
   
-  MediaServer::EnableLog(arg1);
+  delete arg1;
   
 }
 
 
-void _wrap_MediaServer_EnableDebug_native_3e8e6202ec41eede(bool _swig_go_0) {
-  bool arg1 ;
+void _wrap_DTLSICETransport_SetListener_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, DTLSICETransportListener *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  DTLSICETransportListener *arg2 = (DTLSICETransportListener *) 0 ;
   
-  arg1 = (bool)_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(DTLSICETransportListener **)&_swig_go_1; 
   
-  MediaServer::EnableDebug(arg1);
+  (arg1)->SetListener(arg2);
   
 }
 
 
-void _wrap_MediaServer_EnableUltraDebug_native_3e8e6202ec41eede(bool _swig_go_0) {
-  bool arg1 ;
+void _wrap_DTLSICETransport_Start_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
   
-  arg1 = (bool)_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  MediaServer::EnableUltraDebug(arg1);
+  (arg1)->Start();
   
 }
 
 
-_gostring_ _wrap_MediaServer_GetFingerprint_native_3e8e6202ec41eede() {
-  std::string result;
-  _gostring_ _swig_go_result;
+void _wrap_DTLSICETransport_Stop_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
   
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  
+  (arg1)->Stop();
   
-  result = MediaServer::GetFingerprint();
-  _swig_go_result = Swig_AllocateString((&result)->data(), (&result)->length()); 
-  return _swig_go_result;
 }
 
 
-bool _wrap_MediaServer_SetPortRange_native_3e8e6202ec41eede(intgo _swig_go_0, intgo _swig_go_1) {
-  int arg1 ;
-  int arg2 ;
-  bool result;
-  bool _swig_go_result;
+void _wrap_DTLSICETransport_SetSRTPProtectionProfiles_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  std::string *arg2 = 0 ;
   
-  arg1 = (int)_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result = (bool)MediaServer::SetPortRange(arg1,arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
-}
-
-
-MediaServer *_wrap_new_MediaServer_native_3e8e6202ec41eede() {
-  MediaServer *result = 0 ;
-  MediaServer *_swig_go_result;
+  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
+  arg2 = &arg2_str;
   
   
-  result = (MediaServer *)new MediaServer();
-  *(MediaServer **)&_swig_go_result = (MediaServer *)result; 
-  return _swig_go_result;
+  (arg1)->SetSRTPProtectionProfiles((std::string const &)*arg2);
+  
 }
 
 
-void _wrap_delete_MediaServer_native_3e8e6202ec41eede(MediaServer *_swig_go_0) {
-  MediaServer *arg1 = (MediaServer *) 0 ;
+void _wrap_DTLSICETransport_SetRemoteProperties_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, Properties *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  Properties *arg2 = 0 ;
   
-  arg1 = *(MediaServer **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(Properties **)&_swig_go_1; 
   
-  delete arg1;
+  (arg1)->SetRemoteProperties((Properties const &)*arg2);
   
 }
 
 
-void _wrap_RTPBundleTransportConnection_transport_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, DTLSICETransport *_swig_go_1) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  DTLSICETransport *arg2 = (DTLSICETransport *) 0 ;
+void _wrap_DTLSICETransport_SetLocalProperties_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, Properties *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  Properties *arg2 = 0 ;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
-  arg2 = *(DTLSICETransport **)&_swig_go_1; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(Properties **)&_swig_go_1; 
   
-  if (arg1) (arg1)->transport = arg2;
+  (arg1)->SetLocalProperties((Properties const &)*arg2);
   
 }
 
 
-DTLSICETransport *_wrap_RTPBundleTransportConnection_transport_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  DTLSICETransport *result = 0 ;
-  DTLSICETransport *_swig_go_result;
+intgo _wrap_DTLSICETransport_SendPLI_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, intgo _swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  uint32_t arg2 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  result = (DTLSICETransport *) ((arg1)->transport);
-  *(DTLSICETransport **)&_swig_go_result = (DTLSICETransport *)result; 
+  result = (int)(arg1)->SendPLI(arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPBundleTransportConnection_disableSTUNKeepAlive_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, bool _swig_go_1) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  bool arg2 ;
-  
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
-  arg2 = (bool)_swig_go_1; 
+intgo _wrap_DTLSICETransport_Enqueue_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPPacket::shared *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  RTPPacket::shared *arg2 = 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  if (arg1) (arg1)->disableSTUNKeepAlive = arg2;
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(RTPPacket::shared **)&_swig_go_1; 
   
+  result = (int)(arg1)->Enqueue((RTPPacket::shared const &)*arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-bool _wrap_RTPBundleTransportConnection_disableSTUNKeepAlive_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  bool result;
-  bool _swig_go_result;
+intgo _wrap_DTLSICETransport_Dump__SWIG_0_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2, bool _swig_go_3, bool _swig_go_4, bool _swig_go_5) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool arg3 ;
+  bool arg4 ;
+  bool arg5 ;
+  bool arg6 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result = (bool) ((arg1)->disableSTUNKeepAlive);
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (bool)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
+  arg5 = (bool)_swig_go_4; 
+  arg6 = (bool)_swig_go_5; 
+  
+  result = (int)(arg1)->Dump((char const *)arg2,arg3,arg4,arg5,arg6);
   _swig_go_result = result; 
+  free(arg2); 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPBundleTransportConnection_iceRequestsSent_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, long long _swig_go_1) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  size_t arg2 ;
+intgo _wrap_DTLSICETransport_Dump__SWIG_1_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2, bool _swig_go_3, bool _swig_go_4) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool arg3 ;
+  bool arg4 ;
+  bool arg5 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  if (arg1) (arg1)->iceRequestsSent = arg2;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (bool)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
+  arg5 = (bool)_swig_go_4; 
   
+  result = (int)(arg1)->Dump((char const *)arg2,arg3,arg4,arg5);
+  _swig_go_result = result; 
+  free(arg2); 
+  return _swig_go_result;
 }
 
 
-long long _wrap_RTPBundleTransportConnection_iceRequestsSent_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  size_t result;
-  long long _swig_go_result;
+intgo _wrap_DTLSICETransport_Dump__SWIG_2_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2, bool _swig_go_3) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool arg3 ;
+  bool arg4 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result =  ((arg1)->iceRequestsSent);
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (bool)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
+  
+  result = (int)(arg1)->Dump((char const *)arg2,arg3,arg4);
   _swig_go_result = result; 
+  free(arg2); 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPBundleTransportConnection_iceRequestsReceived_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, long long _swig_go_1) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  size_t arg2 ;
+intgo _wrap_DTLSICETransport_Dump__SWIG_3_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool arg3 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  if (arg1) (arg1)->iceRequestsReceived = arg2;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (bool)_swig_go_2; 
   
+  result = (int)(arg1)->Dump((char const *)arg2,arg3);
+  _swig_go_result = result; 
+  free(arg2); 
+  return _swig_go_result;
 }
 
 
-long long _wrap_RTPBundleTransportConnection_iceRequestsReceived_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  size_t result;
-  long long _swig_go_result;
+intgo _wrap_DTLSICETransport_Dump__SWIG_4_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result =  ((arg1)->iceRequestsReceived);
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  result = (int)(arg1)->Dump((char const *)arg2);
   _swig_go_result = result; 
+  free(arg2); 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPBundleTransportConnection_iceResponsesSent_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, long long _swig_go_1) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  size_t arg2 ;
-  
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+intgo _wrap_DTLSICETransport_Dump__SWIG_5_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1, bool _swig_go_2, bool _swig_go_3, bool _swig_go_4, bool _swig_go_5) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  UDPDumper *arg2 = (UDPDumper *) 0 ;
+  bool arg3 ;
+  bool arg4 ;
+  bool arg5 ;
+  bool arg6 ;
+  int result;
+  intgo _swig_go_result;
   
-  if (arg1) (arg1)->iceResponsesSent = arg2;
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(UDPDumper **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
+  arg5 = (bool)_swig_go_4; 
+  arg6 = (bool)_swig_go_5; 
   
+  result = (int)(arg1)->Dump(arg2,arg3,arg4,arg5,arg6);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-long long _wrap_RTPBundleTransportConnection_iceResponsesSent_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  size_t result;
-  long long _swig_go_result;
+intgo _wrap_DTLSICETransport_Dump__SWIG_6_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1, bool _swig_go_2, bool _swig_go_3, bool _swig_go_4) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  UDPDumper *arg2 = (UDPDumper *) 0 ;
+  bool arg3 ;
+  bool arg4 ;
+  bool arg5 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(UDPDumper **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
+  arg5 = (bool)_swig_go_4; 
   
-  result =  ((arg1)->iceResponsesSent);
+  result = (int)(arg1)->Dump(arg2,arg3,arg4,arg5);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_RTPBundleTransportConnection_iceResponsesReceived_set_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0, long long _swig_go_1) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  size_t arg2 ;
-  
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
-  arg2 = (size_t)_swig_go_1; 
+intgo _wrap_DTLSICETransport_Dump__SWIG_7_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1, bool _swig_go_2, bool _swig_go_3) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  UDPDumper *arg2 = (UDPDumper *) 0 ;
+  bool arg3 ;
+  bool arg4 ;
+  int result;
+  intgo _swig_go_result;
   
-  if (arg1) (arg1)->iceResponsesReceived = arg2;
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(UDPDumper **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
   
+  result = (int)(arg1)->Dump(arg2,arg3,arg4);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-long long _wrap_RTPBundleTransportConnection_iceResponsesReceived_get_native_3e8e6202ec41eede(RTPBundleTransportConnection *_swig_go_0) {
-  RTPBundleTransportConnection *arg1 = (RTPBundleTransportConnection *) 0 ;
-  size_t result;
-  long long _swig_go_result;
+intgo _wrap_DTLSICETransport_Dump__SWIG_8_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1, bool _swig_go_2) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  UDPDumper *arg2 = (UDPDumper *) 0 ;
+  bool arg3 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransportConnection **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(UDPDumper **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
   
-  result =  ((arg1)->iceResponsesReceived);
+  result = (int)(arg1)->Dump(arg2,arg3);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-RTPBundleTransport *_wrap_new_RTPBundleTransport_native_3e8e6202ec41eede() {
-  RTPBundleTransport *result = 0 ;
-  RTPBundleTransport *_swig_go_result;
+intgo _wrap_DTLSICETransport_Dump__SWIG_9_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  UDPDumper *arg2 = (UDPDumper *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(UDPDumper **)&_swig_go_1; 
   
-  result = (RTPBundleTransport *)new RTPBundleTransport();
-  *(RTPBundleTransport **)&_swig_go_result = (RTPBundleTransport *)result; 
+  result = (int)(arg1)->Dump(arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPBundleTransport_Init__SWIG_0_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+intgo _wrap_DTLSICETransport_DumpBWEStats_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result = (int)(arg1)->Init();
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  result = (int)(arg1)->DumpBWEStats((char const *)arg2);
   _swig_go_result = result; 
+  free(arg2); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPBundleTransport_Init__SWIG_1_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, intgo _swig_go_1) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
-  int arg2 ;
+intgo _wrap_DTLSICETransport_StopDump_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result = (int)(arg1)->Init(arg2);
+  result = (int)(arg1)->StopDump();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-RTPBundleTransportConnection *_wrap_RTPBundleTransport_AddICETransport_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, _gostring_ _swig_go_1, Properties *_swig_go_2) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
-  std::string *arg2 = 0 ;
-  Properties *arg3 = 0 ;
-  RTPBundleTransportConnection *result = 0 ;
-  RTPBundleTransportConnection *_swig_go_result;
-  
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+void _wrap_DTLSICETransport_Reset_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
   
-  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
-  arg2 = &arg2_str;
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  arg3 = *(Properties **)&_swig_go_2; 
+  (arg1)->Reset();
   
-  result = (RTPBundleTransportConnection *)(arg1)->AddICETransport((std::string const &)*arg2,(Properties const &)*arg3);
-  *(RTPBundleTransportConnection **)&_swig_go_result = (RTPBundleTransportConnection *)result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_RTPBundleTransport_RemoveICETransport_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, _gostring_ _swig_go_1) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
-  std::string *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
-  
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+void _wrap_DTLSICETransport_ActivateRemoteCandidate_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, ICERemoteCandidate *_swig_go_1, bool _swig_go_2, intgo _swig_go_3) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  ICERemoteCandidate *arg2 = (ICERemoteCandidate *) 0 ;
+  bool arg3 ;
+  uint32_t arg4 ;
   
-  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
-  arg2 = &arg2_str;
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(ICERemoteCandidate **)&_swig_go_1; 
+  arg3 = (bool)_swig_go_2; 
+  arg4 = (uint32_t)_swig_go_3; 
   
+  (arg1)->ActivateRemoteCandidate(arg2,arg3,arg4);
   
-  result = (int)(arg1)->RemoveICETransport((std::string const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_RTPBundleTransport_End_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+intgo _wrap_DTLSICETransport_SetRemoteCryptoDTLS_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result = (int)(arg1)->End();
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  result = (int)(arg1)->SetRemoteCryptoDTLS((char const *)arg2,(char const *)arg3,(char const *)arg4);
   _swig_go_result = result; 
+  free(arg2); 
+  free(arg3); 
+  free(arg4); 
   return _swig_go_result;
 }
 
-
-intgo _wrap_RTPBundleTransport_GetLocalPort_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+intgo _wrap_DTLSICETransport_SetLocalCryptoSDES_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result = (int)((RTPBundleTransport const *)arg1)->GetLocalPort();
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  result = (int)(arg1)->SetLocalCryptoSDES((char const *)arg2,(char const *)arg3);
   _swig_go_result = result; 
+  free(arg2); 
+  free(arg3); 
   return _swig_go_result;
 }
 
-
-intgo _wrap_RTPBundleTransport_AddRemoteCandidate_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, short _swig_go_3) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
-  std::string *arg2 = 0 ;
+intgo _wrap_DTLSICETransport_SetRemoteCryptoSDES_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
   char *arg3 = (char *) 0 ;
-  uint16_t arg4 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
-  arg2 = &arg2_str;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
   
   
   arg3 = (char *)malloc(_swig_go_2.n + 1);
   memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
   arg3[_swig_go_2.n] = '\0';
   
-  arg4 = (uint16_t)_swig_go_3; 
   
-  result = (int)(arg1)->AddRemoteCandidate((std::string const &)*arg2,(char const *)arg3,arg4);
+  result = (int)(arg1)->SetRemoteCryptoSDES((char const *)arg2,(char const *)arg3);
   _swig_go_result = result; 
+  free(arg2); 
   free(arg3); 
   return _swig_go_result;
 }
 
 
-bool _wrap_RTPBundleTransport_SetAffinity_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, intgo _swig_go_1) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
-  int arg2 ;
-  bool result;
-  bool _swig_go_result;
-  
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
+intgo _wrap_DTLSICETransport_SetLocalSTUNCredentials_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  result = (bool)(arg1)->SetAffinity(arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
-}
-
-
-void _wrap_RTPBundleTransport_SetIceTimeout_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0, intgo _swig_go_1) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
-  uint32_t arg2 ;
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
   
-  (arg1)->SetIceTimeout(arg2);
   
-}
-
-
-TimeService *_wrap_RTPBundleTransport_GetTimeService_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
-  TimeService *result = 0 ;
-  TimeService *_swig_go_result;
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
   
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
   
-  result = (TimeService *) &(arg1)->GetTimeService();
-  *(TimeService **)&_swig_go_result = result; 
+  result = (int)(arg1)->SetLocalSTUNCredentials((char const *)arg2,(char const *)arg3);
+  _swig_go_result = result; 
+  free(arg2); 
+  free(arg3); 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPBundleTransport_native_3e8e6202ec41eede(RTPBundleTransport *_swig_go_0) {
-  RTPBundleTransport *arg1 = (RTPBundleTransport *) 0 ;
+intgo _wrap_DTLSICETransport_SetRemoteSTUNCredentials_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg1 = *(RTPBundleTransport **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  delete arg1;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
   
+  
+  result = (int)(arg1)->SetRemoteSTUNCredentials((char const *)arg2,(char const *)arg3);
+  _swig_go_result = result; 
+  free(arg2); 
+  free(arg3); 
+  return _swig_go_result;
 }
 
 
-DTLSICETransportListener *_wrap__swig_NewDirectorDTLSICETransportListenerDTLSICETransportListener_native_3e8e6202ec41eede(intgo _swig_go_0) {
-  int arg1 ;
-  DTLSICETransportListener *result = 0 ;
-  DTLSICETransportListener *_swig_go_result;
+bool _wrap_DTLSICETransport_AddOutgoingSourceGroup_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPOutgoingSourceGroup *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  RTPOutgoingSourceGroup *arg2 = (RTPOutgoingSourceGroup *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = (int)_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(RTPOutgoingSourceGroup **)&_swig_go_1; 
   
-  result = new SwigDirector_DTLSICETransportListener(arg1);
-  *(DTLSICETransportListener **)&_swig_go_result = (DTLSICETransportListener *)result; 
+  result = (bool)(arg1)->AddOutgoingSourceGroup(arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_DeleteDirectorDTLSICETransportListener_native_3e8e6202ec41eede(DTLSICETransportListener *_swig_go_0) {
-  DTLSICETransportListener *arg1 = (DTLSICETransportListener *) 0 ;
-  
-  arg1 = *(DTLSICETransportListener **)&_swig_go_0; 
+bool _wrap_DTLSICETransport_RemoveOutgoingSourceGroup_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPOutgoingSourceGroup *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  RTPOutgoingSourceGroup *arg2 = (RTPOutgoingSourceGroup *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  delete arg1;
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(RTPOutgoingSourceGroup **)&_swig_go_1; 
   
+  result = (bool)(arg1)->RemoveOutgoingSourceGroup(arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap__swig_DirectorDTLSICETransportListener_upcall_OnDTLSStateChange_native_3e8e6202ec41eede(SwigDirector_DTLSICETransportListener *_swig_go_0, intgo _swig_go_1) {
-  SwigDirector_DTLSICETransportListener *arg1 = (SwigDirector_DTLSICETransportListener *) 0 ;
-  uint32_t arg2 ;
-  
-  arg1 = *(SwigDirector_DTLSICETransportListener **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+bool _wrap_DTLSICETransport_AddIncomingSourceGroup_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPIncomingSourceGroup *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  RTPIncomingSourceGroup *arg2 = (RTPIncomingSourceGroup *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1->_swig_upcall_onDTLSStateChange(arg2);
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(RTPIncomingSourceGroup **)&_swig_go_1; 
   
+  result = (bool)(arg1)->AddIncomingSourceGroup(arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-DTLSICETransportListener *_wrap_new_DTLSICETransportListener_native_3e8e6202ec41eede() {
-  DTLSICETransportListener *result = 0 ;
-  DTLSICETransportListener *_swig_go_result;
+bool _wrap_DTLSICETransport_RemoveIncomingSourceGroup_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPIncomingSourceGroup *_swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  RTPIncomingSourceGroup *arg2 = (RTPIncomingSourceGroup *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(RTPIncomingSourceGroup **)&_swig_go_1; 
   
-  result = (DTLSICETransportListener *)new DTLSICETransportListener();
-  *(DTLSICETransportListener **)&_swig_go_result = (DTLSICETransportListener *)result; 
+  result = (bool)(arg1)->RemoveIncomingSourceGroup(arg2);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_DTLSICETransportListener_native_3e8e6202ec41eede(DTLSICETransportListener *_swig_go_0) {
-  DTLSICETransportListener *arg1 = (DTLSICETransportListener *) 0 ;
+void _wrap_DTLSICETransport_SetBandwidthProbing_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, bool _swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  bool arg2 ;
   
-  arg1 = *(DTLSICETransportListener **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = (bool)_swig_go_1; 
   
-  delete arg1;
+  (arg1)->SetBandwidthProbing(arg2);
   
 }
 
 
-void _wrap_DTLSICETransportListener_onDTLSStateChange_native_3e8e6202ec41eede(DTLSICETransportListener *_swig_go_0, intgo _swig_go_1) {
-  DTLSICETransportListener *arg1 = (DTLSICETransportListener *) 0 ;
+void _wrap_DTLSICETransport_SetMaxProbingBitrate_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, intgo _swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
   uint32_t arg2 ;
   
-  arg1 = *(DTLSICETransportListener **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   arg2 = (uint32_t)_swig_go_1; 
   
-  (arg1)->onDTLSStateChange(arg2);
+  (arg1)->SetMaxProbingBitrate(arg2);
   
 }
 
 
-void _wrap_delete_RemoteRateEstimatorListener_native_3e8e6202ec41eede(RemoteRateEstimatorListener *_swig_go_0) {
-  RemoteRateEstimatorListener *arg1 = (RemoteRateEstimatorListener *) 0 ;
+void _wrap_DTLSICETransport_SetProbingBitrateLimit_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, intgo _swig_go_1) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  uint32_t arg2 ;
   
-  arg1 = *(RemoteRateEstimatorListener **)&_swig_go_0; 
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  delete arg1;
+  (arg1)->SetProbingBitrateLimit(arg2);
   
 }
 
 
-void _wrap_DTLSICETransport_SetListener_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, DTLSICETransportListener *_swig_go_1) {
+void _wrap_DTLSICETransport_SetSenderSideEstimatorListener_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RemoteRateEstimatorListener *_swig_go_1) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  DTLSICETransportListener *arg2 = (DTLSICETransportListener *) 0 ;
+  RemoteRateEstimatorListener *arg2 = (RemoteRateEstimatorListener *) 0 ;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(DTLSICETransportListener **)&_swig_go_1; 
+  arg2 = *(RemoteRateEstimatorListener **)&_swig_go_1; 
   
-  (arg1)->SetListener(arg2);
+  (arg1)->SetSenderSideEstimatorListener(arg2);
   
 }
 
 
-void _wrap_DTLSICETransport_Start_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+_gostring_ _wrap_DTLSICETransport_GetRemoteUsername_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *result = 0 ;
+  _gostring_ _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  (arg1)->Start();
-  
+  result = (char *)((DTLSICETransport const *)arg1)->GetRemoteUsername();
+  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
+  return _swig_go_result;
 }
 
 
-void _wrap_DTLSICETransport_Stop_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+_gostring_ _wrap_DTLSICETransport_GetRemotePwd_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  char *result = 0 ;
+  _gostring_ _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  (arg1)->Stop();
-  
+  result = (char *)((DTLSICETransport const *)arg1)->GetRemotePwd();
+  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
+  return _swig_go_result;
 }
 
 
-void _wrap_DTLSICETransport_SetSRTPProtectionProfiles_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1) {
+_gostring_ _wrap_DTLSICETransport_GetLocalUsername_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  std::string *arg2 = 0 ;
+  char *result = 0 ;
+  _gostring_ _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  std::string arg2_str(_swig_go_1.p, _swig_go_1.n);
-  arg2 = &arg2_str;
-  
-  
-  (arg1)->SetSRTPProtectionProfiles((std::string const &)*arg2);
-  
+  result = (char *)((DTLSICETransport const *)arg1)->GetLocalUsername();
+  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
+  return _swig_go_result;
 }
 
 
-void _wrap_DTLSICETransport_SetRemoteProperties_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, Properties *_swig_go_1) {
+_gostring_ _wrap_DTLSICETransport_GetLocalPwd_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  Properties *arg2 = 0 ;
+  char *result = 0 ;
+  _gostring_ _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(Properties **)&_swig_go_1; 
-  
-  (arg1)->SetRemoteProperties((Properties const &)*arg2);
   
+  result = (char *)((DTLSICETransport const *)arg1)->GetLocalPwd();
+  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
+  return _swig_go_result;
 }
 
 
-void _wrap_DTLSICETransport_SetLocalProperties_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, Properties *_swig_go_1) {
+intgo _wrap_DTLSICETransport_GetRTT_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  Properties *arg2 = 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(Properties **)&_swig_go_1; 
-  
-  (arg1)->SetLocalProperties((Properties const &)*arg2);
   
+  result = (uint32_t)((DTLSICETransport const *)arg1)->GetRTT();
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_SendPLI_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, intgo _swig_go_1) {
+long long _wrap_DTLSICETransport_GetLastActiveTime_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  uint32_t arg2 ;
-  int result;
-  intgo _swig_go_result;
+  uint64_t result;
+  long long _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
   
-  result = (int)(arg1)->SendPLI(arg2);
+  result = (uint64_t)(arg1)->GetLastActiveTime();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Enqueue_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPPacket::shared *_swig_go_1) {
+TimeService *_wrap_DTLSICETransport_GetTimeService_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPPacket::shared *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
+  TimeService *result = 0 ;
+  TimeService *_swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(RTPPacket::shared **)&_swig_go_1; 
   
-  result = (int)(arg1)->Enqueue((RTPPacket::shared const &)*arg2);
-  _swig_go_result = result; 
+  result = (TimeService *) &(arg1)->GetTimeService();
+  *(TimeService **)&_swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_0_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2, bool _swig_go_3, bool _swig_go_4, bool _swig_go_5) {
+void _wrap_delete_DTLSICETransport_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *arg2 = (char *) 0 ;
-  bool arg3 ;
-  bool arg4 ;
-  bool arg5 ;
-  bool arg6 ;
-  int result;
-  intgo _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+  delete arg1;
   
-  arg3 = (bool)_swig_go_2; 
-  arg4 = (bool)_swig_go_3; 
-  arg5 = (bool)_swig_go_4; 
-  arg6 = (bool)_swig_go_5; 
+}
+
+
+RTPSessionFacade *_wrap_new_RTPSessionFacade_native_3e8e6202ec41eede(intgo _swig_go_0) {
+  MediaFrameType arg1 ;
+  RTPSessionFacade *result = 0 ;
+  RTPSessionFacade *_swig_go_result;
   
-  result = (int)(arg1)->Dump((char const *)arg2,arg3,arg4,arg5,arg6);
-  _swig_go_result = result; 
-  free(arg2); 
+  arg1 = (MediaFrameType)_swig_go_0; 
+  
+  result = (RTPSessionFacade *)new RTPSessionFacade(arg1);
+  *(RTPSessionFacade **)&_swig_go_result = (RTPSessionFacade *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_1_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2, bool _swig_go_3, bool _swig_go_4) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *arg2 = (char *) 0 ;
-  bool arg3 ;
-  bool arg4 ;
-  bool arg5 ;
+intgo _wrap_RTPSessionFacade_Init_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, Properties *_swig_go_1) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  Properties *arg2 = 0 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  arg3 = (bool)_swig_go_2; 
-  arg4 = (bool)_swig_go_3; 
-  arg5 = (bool)_swig_go_4; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg2 = *(Properties **)&_swig_go_1; 
   
-  result = (int)(arg1)->Dump((char const *)arg2,arg3,arg4,arg5);
+  result = (int)(arg1)->Init((Properties const &)*arg2);
   _swig_go_result = result; 
-  free(arg2); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_2_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2, bool _swig_go_3) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *arg2 = (char *) 0 ;
-  bool arg3 ;
-  bool arg4 ;
+intgo _wrap_RTPSessionFacade_SetLocalPort_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, intgo _swig_go_1) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  int arg2 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  arg3 = (bool)_swig_go_2; 
-  arg4 = (bool)_swig_go_3; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
   
-  result = (int)(arg1)->Dump((char const *)arg2,arg3,arg4);
+  result = (int)(arg1)->SetLocalPort(arg2);
   _swig_go_result = result; 
-  free(arg2); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_3_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *arg2 = (char *) 0 ;
-  bool arg3 ;
+intgo _wrap_RTPSessionFacade_GetLocalPort_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  arg3 = (bool)_swig_go_2; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
   
-  result = (int)(arg1)->Dump((char const *)arg2,arg3);
+  result = (int)(arg1)->GetLocalPort();
   _swig_go_result = result; 
-  free(arg2); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_4_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+intgo _wrap_RTPSessionFacade_SetRemotePort_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
   char *arg2 = (char *) 0 ;
+  int arg3 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
   
   arg2 = (char *)malloc(_swig_go_1.n + 1);
   memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
   arg2[_swig_go_1.n] = '\0';
   
+  arg3 = (int)_swig_go_2; 
   
-  result = (int)(arg1)->Dump((char const *)arg2);
+  result = (int)(arg1)->SetRemotePort(arg2,arg3);
   _swig_go_result = result; 
   free(arg2); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_5_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1, bool _swig_go_2, bool _swig_go_3, bool _swig_go_4, bool _swig_go_5) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  UDPDumper *arg2 = (UDPDumper *) 0 ;
-  bool arg3 ;
-  bool arg4 ;
-  bool arg5 ;
-  bool arg6 ;
-  int result;
-  intgo _swig_go_result;
+RTPOutgoingSourceGroup *_wrap_RTPSessionFacade_GetOutgoingSourceGroup_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  RTPOutgoingSourceGroup *result = 0 ;
+  RTPOutgoingSourceGroup *_swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(UDPDumper **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
-  arg4 = (bool)_swig_go_3; 
-  arg5 = (bool)_swig_go_4; 
-  arg6 = (bool)_swig_go_5; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  
+  result = (RTPOutgoingSourceGroup *)(arg1)->GetOutgoingSourceGroup();
+  *(RTPOutgoingSourceGroup **)&_swig_go_result = (RTPOutgoingSourceGroup *)result; 
+  return _swig_go_result;
+}
+
+
+RTPIncomingSourceGroup *_wrap_RTPSessionFacade_GetIncomingSourceGroup_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  RTPIncomingSourceGroup *result = 0 ;
+  RTPIncomingSourceGroup *_swig_go_result;
   
-  result = (int)(arg1)->Dump(arg2,arg3,arg4,arg5,arg6);
-  _swig_go_result = result; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  
+  result = (RTPIncomingSourceGroup *)(arg1)->GetIncomingSourceGroup();
+  *(RTPIncomingSourceGroup **)&_swig_go_result = (RTPIncomingSourceGroup *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_6_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1, bool _swig_go_2, bool _swig_go_3, bool _swig_go_4) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  UDPDumper *arg2 = (UDPDumper *) 0 ;
-  bool arg3 ;
-  bool arg4 ;
-  bool arg5 ;
+intgo _wrap_RTPSessionFacade_End_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(UDPDumper **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
-  arg4 = (bool)_swig_go_3; 
-  arg5 = (bool)_swig_go_4; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
   
-  result = (int)(arg1)->Dump(arg2,arg3,arg4,arg5);
+  result = (int)(arg1)->End();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_7_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1, bool _swig_go_2, bool _swig_go_3) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  UDPDumper *arg2 = (UDPDumper *) 0 ;
-  bool arg3 ;
-  bool arg4 ;
+intgo _wrap_RTPSessionFacade_Enqueue_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, RTPPacket::shared *_swig_go_1) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  RTPPacket::shared *arg2 = 0 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(UDPDumper **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
-  arg4 = (bool)_swig_go_3; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg2 = *(RTPPacket::shared **)&_swig_go_1; 
   
-  result = (int)(arg1)->Dump(arg2,arg3,arg4);
+  result = (int)(arg1)->Enqueue((RTPPacket::shared const &)*arg2);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_8_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1, bool _swig_go_2) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  UDPDumper *arg2 = (UDPDumper *) 0 ;
-  bool arg3 ;
+intgo _wrap_RTPSessionFacade_SendPLI_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, intgo _swig_go_1) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  uint32_t arg2 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(UDPDumper **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  result = (int)(arg1)->Dump(arg2,arg3);
+  result = (int)(arg1)->SendPLI(arg2);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_Dump__SWIG_9_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, UDPDumper *_swig_go_1) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  UDPDumper *arg2 = (UDPDumper *) 0 ;
-  int result;
-  intgo _swig_go_result;
+void _wrap_delete_RTPSessionFacade_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(UDPDumper **)&_swig_go_1; 
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
   
-  result = (int)(arg1)->Dump(arg2);
-  _swig_go_result = result; 
+  delete arg1;
+  
+}
+
+
+RTPReceiver *_wrap_RTPSessionFacade_SwigGetRTPReceiver_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  RTPReceiver *result = 0 ;
+  RTPReceiver *_swig_go_result;
+  
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  
+  result = (RTPReceiver*)arg1;
+  *(RTPReceiver **)&_swig_go_result = (RTPReceiver *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_DumpBWEStats_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1) {
+RTPSenderFacade *_wrap_new_RTPSenderFacade__SWIG_0_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *arg2 = (char *) 0 ;
-  int result;
-  intgo _swig_go_result;
+  RTPSenderFacade *result = 0 ;
+  RTPSenderFacade *_swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+  result = (RTPSenderFacade *)new RTPSenderFacade(arg1);
+  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
+  return _swig_go_result;
+}
+
+
+RTPSenderFacade *_wrap_new_RTPSenderFacade__SWIG_1_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  RTPSenderFacade *result = 0 ;
+  RTPSenderFacade *_swig_go_result;
   
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
   
-  result = (int)(arg1)->DumpBWEStats((char const *)arg2);
-  _swig_go_result = result; 
-  free(arg2); 
+  result = (RTPSenderFacade *)new RTPSenderFacade(arg1);
+  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_DTLSICETransport_Reset_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+RTPSender *_wrap_RTPSenderFacade_get_native_3e8e6202ec41eede(RTPSenderFacade *_swig_go_0) {
+  RTPSenderFacade *arg1 = (RTPSenderFacade *) 0 ;
+  RTPSender *result = 0 ;
+  RTPSender *_swig_go_result;
   
-  (arg1)->Reset();
+  arg1 = *(RTPSenderFacade **)&_swig_go_0; 
   
+  result = (RTPSender *)(arg1)->get();
+  *(RTPSender **)&_swig_go_result = (RTPSender *)result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_DTLSICETransport_ActivateRemoteCandidate_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, ICERemoteCandidate *_swig_go_1, bool _swig_go_2, intgo _swig_go_3) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  ICERemoteCandidate *arg2 = (ICERemoteCandidate *) 0 ;
-  bool arg3 ;
-  uint32_t arg4 ;
+void _wrap_delete_RTPSenderFacade_native_3e8e6202ec41eede(RTPSenderFacade *_swig_go_0) {
+  RTPSenderFacade *arg1 = (RTPSenderFacade *) 0 ;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(ICERemoteCandidate **)&_swig_go_1; 
-  arg3 = (bool)_swig_go_2; 
-  arg4 = (uint32_t)_swig_go_3; 
+  arg1 = *(RTPSenderFacade **)&_swig_go_0; 
   
-  (arg1)->ActivateRemoteCandidate(arg2,arg3,arg4);
+  delete arg1;
   
 }
 
 
-intgo _wrap_DTLSICETransport_SetRemoteCryptoDTLS_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3) {
+RTPReceiverFacade *_wrap_new_RTPReceiverFacade__SWIG_0_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *arg2 = (char *) 0 ;
-  char *arg3 = (char *) 0 ;
-  char *arg4 = (char *) 0 ;
-  int result;
-  intgo _swig_go_result;
+  RTPReceiverFacade *result = 0 ;
+  RTPReceiverFacade *_swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
+  result = (RTPReceiverFacade *)new RTPReceiverFacade(arg1);
+  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
+  return _swig_go_result;
+}
+
+
+RTPReceiverFacade *_wrap_new_RTPReceiverFacade__SWIG_1_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  RTPReceiverFacade *result = 0 ;
+  RTPReceiverFacade *_swig_go_result;
   
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
   
-  arg4 = (char *)malloc(_swig_go_3.n + 1);
-  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
-  arg4[_swig_go_3.n] = '\0';
+  result = (RTPReceiverFacade *)new RTPReceiverFacade(arg1);
+  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
+  return _swig_go_result;
+}
+
+
+RTPReceiver *_wrap_RTPReceiverFacade_get_native_3e8e6202ec41eede(RTPReceiverFacade *_swig_go_0) {
+  RTPReceiverFacade *arg1 = (RTPReceiverFacade *) 0 ;
+  RTPReceiver *result = 0 ;
+  RTPReceiver *_swig_go_result;
   
+  arg1 = *(RTPReceiverFacade **)&_swig_go_0; 
   
-  result = (int)(arg1)->SetRemoteCryptoDTLS((char const *)arg2,(char const *)arg3,(char const *)arg4);
-  _swig_go_result = result; 
-  free(arg2); 
-  free(arg3); 
-  free(arg4); 
+  result = (RTPReceiver *)(arg1)->get();
+  *(RTPReceiver **)&_swig_go_result = (RTPReceiver *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_SetLocalSTUNCredentials_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *arg2 = (char *) 0 ;
-  char *arg3 = (char *) 0 ;
+intgo _wrap_RTPReceiverFacade_SendPLI_native_3e8e6202ec41eede(RTPReceiverFacade *_swig_go_0, intgo _swig_go_1) {
+  RTPReceiverFacade *arg1 = (RTPReceiverFacade *) 0 ;
+  uint32_t arg2 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(RTPReceiverFacade **)&_swig_go_0; 
+  arg2 = (uint32_t)_swig_go_1; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+  result = (int)(arg1)->SendPLI(arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_delete_RTPReceiverFacade_native_3e8e6202ec41eede(RTPReceiverFacade *_swig_go_0) {
+  RTPReceiverFacade *arg1 = (RTPReceiverFacade *) 0 ;
   
+  arg1 = *(RTPReceiverFacade **)&_swig_go_0; 
   
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
+  delete arg1;
+  
+}
+
+
+RTPSenderFacade *_wrap_TransportToSender_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+  RTPSenderFacade *result = 0 ;
+  RTPSenderFacade *_swig_go_result;
   
+  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result = (int)(arg1)->SetLocalSTUNCredentials((char const *)arg2,(char const *)arg3);
-  _swig_go_result = result; 
-  free(arg2); 
-  free(arg3); 
+  result = (RTPSenderFacade *)TransportToSender(arg1);
+  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_SetRemoteSTUNCredentials_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2) {
+RTPReceiverFacade *_wrap_TransportToReceiver_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *arg2 = (char *) 0 ;
-  char *arg3 = (char *) 0 ;
-  int result;
-  intgo _swig_go_result;
+  RTPReceiverFacade *result = 0 ;
+  RTPReceiverFacade *_swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+  result = (RTPReceiverFacade *)TransportToReceiver(arg1);
+  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
+  return _swig_go_result;
+}
+
+
+RTPSenderFacade *_wrap_SessionToSender_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  RTPSenderFacade *result = 0 ;
+  RTPSenderFacade *_swig_go_result;
+  
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  
+  result = (RTPSenderFacade *)SessionToSender(arg1);
+  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
+  return _swig_go_result;
+}
+
+
+RTPReceiverFacade *_wrap_SessionToReceiver_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
+  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+  RTPReceiverFacade *result = 0 ;
+  RTPReceiverFacade *_swig_go_result;
   
+  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
   
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
+  result = (RTPReceiverFacade *)SessionToReceiver(arg1);
+  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
+  return _swig_go_result;
+}
+
+
+RTPReceiverFacade *_wrap_RTPSessionToReceiver_native_3e8e6202ec41eede(MediaFrameSessionFacade *_swig_go_0) {
+  MediaFrameSessionFacade *arg1 = (MediaFrameSessionFacade *) 0 ;
+  RTPReceiverFacade *result = 0 ;
+  RTPReceiverFacade *_swig_go_result;
   
+  arg1 = *(MediaFrameSessionFacade **)&_swig_go_0; 
   
-  result = (int)(arg1)->SetRemoteSTUNCredentials((char const *)arg2,(char const *)arg3);
-  _swig_go_result = result; 
-  free(arg2); 
-  free(arg3); 
+  result = (RTPReceiverFacade *)RTPSessionToReceiver(arg1);
+  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_DTLSICETransport_AddOutgoingSourceGroup_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPOutgoingSourceGroup *_swig_go_1) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPOutgoingSourceGroup *arg2 = (RTPOutgoingSourceGroup *) 0 ;
-  bool result;
-  bool _swig_go_result;
+intgo _wrap_MediaFrameGetLength_native_3e8e6202ec41eede(MediaFrame *_swig_go_0) {
+  MediaFrame *arg1 = (MediaFrame *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(RTPOutgoingSourceGroup **)&_swig_go_1; 
+  arg1 = *(MediaFrame **)&_swig_go_0; 
   
-  result = (bool)(arg1)->AddOutgoingSourceGroup(arg2);
+  result = (uint32_t)MediaFrameGetLength((MediaFrame const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_DTLSICETransport_RemoveOutgoingSourceGroup_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPOutgoingSourceGroup *_swig_go_1) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPOutgoingSourceGroup *arg2 = (RTPOutgoingSourceGroup *) 0 ;
-  bool result;
-  bool _swig_go_result;
+long long _wrap_MediaFrameGetTimestamp_native_3e8e6202ec41eede(MediaFrame *_swig_go_0) {
+  MediaFrame *arg1 = (MediaFrame *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(RTPOutgoingSourceGroup **)&_swig_go_1; 
+  arg1 = *(MediaFrame **)&_swig_go_0; 
   
-  result = (bool)(arg1)->RemoveOutgoingSourceGroup(arg2);
+  result = (uint64_t)MediaFrameGetTimestamp((MediaFrame const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_DTLSICETransport_AddIncomingSourceGroup_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPIncomingSourceGroup *_swig_go_1) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPIncomingSourceGroup *arg2 = (RTPIncomingSourceGroup *) 0 ;
-  bool result;
-  bool _swig_go_result;
+intgo _wrap_MediaFrameGetClockRate_native_3e8e6202ec41eede(MediaFrame *_swig_go_0) {
+  MediaFrame *arg1 = (MediaFrame *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(RTPIncomingSourceGroup **)&_swig_go_1; 
+  arg1 = *(MediaFrame **)&_swig_go_0; 
   
-  result = (bool)(arg1)->AddIncomingSourceGroup(arg2);
+  result = (uint32_t)MediaFrameGetClockRate((MediaFrame const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-bool _wrap_DTLSICETransport_RemoveIncomingSourceGroup_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPIncomingSourceGroup *_swig_go_1) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPIncomingSourceGroup *arg2 = (RTPIncomingSourceGroup *) 0 ;
+bool _wrap_MediaFrameIsIntra_native_3e8e6202ec41eede(MediaFrame *_swig_go_0) {
+  MediaFrame *arg1 = (MediaFrame *) 0 ;
   bool result;
   bool _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(RTPIncomingSourceGroup **)&_swig_go_1; 
+  arg1 = *(MediaFrame **)&_swig_go_0; 
   
-  result = (bool)(arg1)->RemoveIncomingSourceGroup(arg2);
+  result = (bool)MediaFrameIsIntra((MediaFrame const *)arg1);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_DTLSICETransport_SetBandwidthProbing_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, bool _swig_go_1) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  bool arg2 ;
+void _wrap_MediaFrameCopyData_native_3e8e6202ec41eede(MediaFrame *_swig_go_0, char *_swig_go_1, intgo _swig_go_2) {
+  MediaFrame *arg1 = (MediaFrame *) 0 ;
+  uint8_t *arg2 = (uint8_t *) 0 ;
+  int arg3 ;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = (bool)_swig_go_1; 
+  arg1 = *(MediaFrame **)&_swig_go_0; 
+  arg2 = *(uint8_t **)&_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
   
-  (arg1)->SetBandwidthProbing(arg2);
+  MediaFrameCopyData((MediaFrame const *)arg1,arg2,arg3);
   
 }
 
 
-void _wrap_DTLSICETransport_SetMaxProbingBitrate_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, intgo _swig_go_1) {
+bool _wrap_TransportSendSenderReport_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RTPOutgoingSourceGroup *_swig_go_1) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  uint32_t arg2 ;
+  RTPOutgoingSourceGroup *arg2 = (RTPOutgoingSourceGroup *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
-  
-  (arg1)->SetMaxProbingBitrate(arg2);
+  arg2 = *(RTPOutgoingSourceGroup **)&_swig_go_1; 
   
+  result = (bool)TransportSendSenderReport(arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
 }
 
 
-void _wrap_DTLSICETransport_SetProbingBitrateLimit_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, intgo _swig_go_1) {
+bool _wrap_TransportSendRTCPApp_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2, _gostring_ _swig_go_3, char *_swig_go_4, intgo _swig_go_5) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  uint32_t arg2 ;
+  DWORD arg2 ;
+  int arg3 ;
+  char *arg4 = (char *) 0 ;
+  uint8_t *arg5 = (uint8_t *) 0 ;
+  int arg6 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
-  
-  (arg1)->SetProbingBitrateLimit(arg2);
-  
-}
-
-
-void _wrap_DTLSICETransport_SetSenderSideEstimatorListener_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, RemoteRateEstimatorListener *_swig_go_1) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RemoteRateEstimatorListener *arg2 = (RemoteRateEstimatorListener *) 0 ;
+  arg2 = (DWORD)_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
-  arg2 = *(RemoteRateEstimatorListener **)&_swig_go_1; 
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
   
-  (arg1)->SetSenderSideEstimatorListener(arg2);
+  arg5 = *(uint8_t **)&_swig_go_4; 
+  arg6 = (int)_swig_go_5; 
   
+  result = (bool)TransportSendRTCPApp(arg1,arg2,arg3,(char const *)arg4,(uint8_t const *)arg5,arg6);
+  _swig_go_result = result; 
+  free(arg4); 
+  return _swig_go_result;
 }
 
 
-_gostring_ _wrap_DTLSICETransport_GetRemoteUsername_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+bool _wrap_TransportSendREMB_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2, char *_swig_go_3, intgo _swig_go_4) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *result = 0 ;
-  _gostring_ _swig_go_result;
+  DWORD arg2 ;
+  DWORD arg3 ;
+  uint8_t *arg4 = (uint8_t *) 0 ;
+  int arg5 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = (DWORD)_swig_go_1; 
+  arg3 = (DWORD)_swig_go_2; 
+  arg4 = *(uint8_t **)&_swig_go_3; 
+  arg5 = (int)_swig_go_4; 
   
-  result = (char *)((DTLSICETransport const *)arg1)->GetRemoteUsername();
-  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
+  result = (bool)TransportSendREMB(arg1,arg2,arg3,(uint8_t const *)arg4,arg5);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-_gostring_ _wrap_DTLSICETransport_GetRemotePwd_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
+bool _wrap_TransportSendBYE_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0, char *_swig_go_1, intgo _swig_go_2) {
   DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *result = 0 ;
-  _gostring_ _swig_go_result;
+  uint8_t *arg2 = (uint8_t *) 0 ;
+  int arg3 ;
+  bool result;
+  bool _swig_go_result;
   
   arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg2 = *(uint8_t **)&_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
   
-  result = (char *)((DTLSICETransport const *)arg1)->GetRemotePwd();
-  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
+  result = (bool)TransportSendBYE(arg1,(uint8_t const *)arg2,arg3);
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-_gostring_ _wrap_DTLSICETransport_GetLocalUsername_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *result = 0 ;
-  _gostring_ _swig_go_result;
+TimeServiceProbe *_wrap_new_TimeServiceProbe_native_3e8e6202ec41eede(TimeService *_swig_go_0) {
+  TimeService *arg1 = 0 ;
+  TimeServiceProbe *result = 0 ;
+  TimeServiceProbe *_swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(TimeService **)&_swig_go_0; 
   
-  result = (char *)((DTLSICETransport const *)arg1)->GetLocalUsername();
-  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
+  result = (TimeServiceProbe *)new TimeServiceProbe(*arg1);
+  *(TimeServiceProbe **)&_swig_go_result = (TimeServiceProbe *)result; 
   return _swig_go_result;
 }
 
 
-_gostring_ _wrap_DTLSICETransport_GetLocalPwd_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  char *result = 0 ;
-  _gostring_ _swig_go_result;
+void _wrap_TimeServiceProbe_Ping_native_3e8e6202ec41eede(TimeServiceProbe *_swig_go_0) {
+  TimeServiceProbe *arg1 = (TimeServiceProbe *) 0 ;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(TimeServiceProbe **)&_swig_go_0; 
+  
+  (arg1)->Ping();
   
-  result = (char *)((DTLSICETransport const *)arg1)->GetLocalPwd();
-  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_DTLSICETransport_GetRTT_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  uint32_t result;
-  intgo _swig_go_result;
+long long _wrap_TimeServiceProbe_GetPendingTime_native_3e8e6202ec41eede(TimeServiceProbe *_swig_go_0) {
+  TimeServiceProbe *arg1 = (TimeServiceProbe *) 0 ;
+  uint64_t result;
+  long long _swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(TimeServiceProbe **)&_swig_go_0; 
   
-  result = (uint32_t)((DTLSICETransport const *)arg1)->GetRTT();
+  result = (uint64_t)(arg1)->GetPendingTime();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-long long _wrap_DTLSICETransport_GetLastActiveTime_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  uint64_t result;
-  long long _swig_go_result;
+void _wrap_delete_TimeServiceProbe_native_3e8e6202ec41eede(TimeServiceProbe *_swig_go_0) {
+  TimeServiceProbe *arg1 = (TimeServiceProbe *) 0 ;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(TimeServiceProbe **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (uint64_t)(arg1)->GetLastActiveTime();
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-TimeService *_wrap_DTLSICETransport_GetTimeService_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  TimeService *result = 0 ;
-  TimeService *_swig_go_result;
+SimulcastFrameSelector *_wrap_new_SimulcastFrameSelector_native_3e8e6202ec41eede(MediaFrameListener *_swig_go_0) {
+  MediaFrameListener *arg1 = (MediaFrameListener *) 0 ;
+  SimulcastFrameSelector *result = 0 ;
+  SimulcastFrameSelector *_swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(MediaFrameListener **)&_swig_go_0; 
   
-  result = (TimeService *) &(arg1)->GetTimeService();
-  *(TimeService **)&_swig_go_result = result; 
+  result = (SimulcastFrameSelector *)new SimulcastFrameSelector(arg1);
+  *(SimulcastFrameSelector **)&_swig_go_result = (SimulcastFrameSelector *)result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_DTLSICETransport_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
+void _wrap_SimulcastFrameSelector_Select_native_3e8e6202ec41eede(SimulcastFrameSelector *_swig_go_0, intgo _swig_go_1) {
+  SimulcastFrameSelector *arg1 = (SimulcastFrameSelector *) 0 ;
+  DWORD arg2 ;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(SimulcastFrameSelector **)&_swig_go_0; 
+  arg2 = (DWORD)_swig_go_1; 
   
-  delete arg1;
+  (arg1)->Select(arg2);
   
 }
 
 
-RTPSessionFacade *_wrap_new_RTPSessionFacade_native_3e8e6202ec41eede(intgo _swig_go_0) {
-  MediaFrameType arg1 ;
-  RTPSessionFacade *result = 0 ;
-  RTPSessionFacade *_swig_go_result;
+intgo _wrap_SimulcastFrameSelector_GetSelected_native_3e8e6202ec41eede(SimulcastFrameSelector *_swig_go_0) {
+  SimulcastFrameSelector *arg1 = (SimulcastFrameSelector *) 0 ;
+  DWORD result;
+  intgo _swig_go_result;
   
-  arg1 = (MediaFrameType)_swig_go_0; 
+  arg1 = *(SimulcastFrameSelector **)&_swig_go_0; 
   
-  result = (RTPSessionFacade *)new RTPSessionFacade(arg1);
-  *(RTPSessionFacade **)&_swig_go_result = (RTPSessionFacade *)result; 
+  result = (DWORD)(arg1)->GetSelected();
+  _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPSessionFacade_Init_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, Properties *_swig_go_1) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  Properties *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
+void _wrap_delete_SimulcastFrameSelector_native_3e8e6202ec41eede(SimulcastFrameSelector *_swig_go_0) {
+  SimulcastFrameSelector *arg1 = (SimulcastFrameSelector *) 0 ;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
-  arg2 = *(Properties **)&_swig_go_1; 
+  arg1 = *(SimulcastFrameSelector **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (int)(arg1)->Init((Properties const &)*arg2);
-  _swig_go_result = result; 
-  return _swig_go_result;
 }
 
 
-intgo _wrap_RTPSessionFacade_SetLocalPort_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, intgo _swig_go_1) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  int arg2 ;
-  int result;
-  intgo _swig_go_result;
+RTPSenderTee *_wrap_new_RTPSenderTee_native_3e8e6202ec41eede(RTPSenderFacade *_swig_go_0, MediaFrameListener *_swig_go_1) {
+  RTPSenderFacade *arg1 = (RTPSenderFacade *) 0 ;
+  MediaFrameListener *arg2 = (MediaFrameListener *) 0 ;
+  RTPSenderTee *result = 0 ;
+  RTPSenderTee *_swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
-  arg2 = (int)_swig_go_1; 
+  arg1 = *(RTPSenderFacade **)&_swig_go_0; 
+  arg2 = *(MediaFrameListener **)&_swig_go_1; 
   
-  result = (int)(arg1)->SetLocalPort(arg2);
-  _swig_go_result = result; 
+  result = (RTPSenderTee *)new RTPSenderTee(arg1,arg2);
+  *(RTPSenderTee **)&_swig_go_result = (RTPSenderTee *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPSessionFacade_GetLocalPort_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  int result;
-  intgo _swig_go_result;
+RTPSenderFacade *_wrap_RTPSenderTee_GetSender_native_3e8e6202ec41eede(RTPSenderTee *_swig_go_0) {
+  RTPSenderTee *arg1 = (RTPSenderTee *) 0 ;
+  RTPSenderFacade *result = 0 ;
+  RTPSenderFacade *_swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPSenderTee **)&_swig_go_0; 
   
-  result = (int)(arg1)->GetLocalPort();
-  _swig_go_result = result; 
+  result = (RTPSenderFacade *)(arg1)->GetSender();
+  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPSessionFacade_SetRemotePort_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  char *arg2 = (char *) 0 ;
-  int arg3 ;
-  int result;
-  intgo _swig_go_result;
+void _wrap_RTPSenderTee_Stop_native_3e8e6202ec41eede(RTPSenderTee *_swig_go_0) {
+  RTPSenderTee *arg1 = (RTPSenderTee *) 0 ;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPSenderTee **)&_swig_go_0; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+  (arg1)->Stop();
   
-  arg3 = (int)_swig_go_2; 
+}
+
+
+void _wrap_delete_RTPSenderTee_native_3e8e6202ec41eede(RTPSenderTee *_swig_go_0) {
+  RTPSenderTee *arg1 = (RTPSenderTee *) 0 ;
+  
+  arg1 = *(RTPSenderTee **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (int)(arg1)->SetRemotePort(arg2,arg3);
-  _swig_go_result = result; 
-  free(arg2); 
-  return _swig_go_result;
 }
 
 
-RTPOutgoingSourceGroup *_wrap_RTPSessionFacade_GetOutgoingSourceGroup_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  RTPOutgoingSourceGroup *result = 0 ;
-  RTPOutgoingSourceGroup *_swig_go_result;
+RTPPacer *_wrap_new_RTPPacer_native_3e8e6202ec41eede(RTPSenderFacade *_swig_go_0, TimeService *_swig_go_1) {
+  RTPSenderFacade *arg1 = (RTPSenderFacade *) 0 ;
+  TimeService *arg2 = 0 ;
+  RTPPacer *result = 0 ;
+  RTPPacer *_swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPSenderFacade **)&_swig_go_0; 
+  arg2 = *(TimeService **)&_swig_go_1; 
   
-  result = (RTPOutgoingSourceGroup *)(arg1)->GetOutgoingSourceGroup();
-  *(RTPOutgoingSourceGroup **)&_swig_go_result = (RTPOutgoingSourceGroup *)result; 
+  result = (RTPPacer *)new RTPPacer(arg1,*arg2);
+  *(RTPPacer **)&_swig_go_result = (RTPPacer *)result; 
   return _swig_go_result;
 }
 
 
-RTPIncomingSourceGroup *_wrap_RTPSessionFacade_GetIncomingSourceGroup_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  RTPIncomingSourceGroup *result = 0 ;
-  RTPIncomingSourceGroup *_swig_go_result;
+void _wrap_RTPPacer_Configure_native_3e8e6202ec41eede(RTPPacer *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2, intgo _swig_go_3) {
+  RTPPacer *arg1 = (RTPPacer *) 0 ;
+  DWORD arg2 ;
+  DWORD arg3 ;
+  DWORD arg4 ;
+  
+  arg1 = *(RTPPacer **)&_swig_go_0; 
+  arg2 = (DWORD)_swig_go_1; 
+  arg3 = (DWORD)_swig_go_2; 
+  arg4 = (DWORD)_swig_go_3; 
+  
+  (arg1)->Configure(arg2,arg3,arg4);
+  
+}
+
+
+RTPSenderFacade *_wrap_RTPPacer_GetSender_native_3e8e6202ec41eede(RTPPacer *_swig_go_0) {
+  RTPPacer *arg1 = (RTPPacer *) 0 ;
+  RTPSenderFacade *result = 0 ;
+  RTPSenderFacade *_swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPPacer **)&_swig_go_0; 
   
-  result = (RTPIncomingSourceGroup *)(arg1)->GetIncomingSourceGroup();
-  *(RTPIncomingSourceGroup **)&_swig_go_result = (RTPIncomingSourceGroup *)result; 
+  result = (RTPSenderFacade *)(arg1)->GetSender();
+  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPSessionFacade_End_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  int result;
+intgo _wrap_RTPPacer_GetQueued_native_3e8e6202ec41eede(RTPPacer *_swig_go_0) {
+  RTPPacer *arg1 = (RTPPacer *) 0 ;
+  DWORD result;
   intgo _swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPPacer **)&_swig_go_0; 
   
-  result = (int)(arg1)->End();
+  result = (DWORD)(arg1)->GetQueued();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPSessionFacade_Enqueue_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, RTPPacket::shared *_swig_go_1) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  RTPPacket::shared *arg2 = 0 ;
-  int result;
-  intgo _swig_go_result;
+long long _wrap_RTPPacer_GetSent_native_3e8e6202ec41eede(RTPPacer *_swig_go_0) {
+  RTPPacer *arg1 = (RTPPacer *) 0 ;
+  QWORD result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
-  arg2 = *(RTPPacket::shared **)&_swig_go_1; 
+  arg1 = *(RTPPacer **)&_swig_go_0; 
   
-  result = (int)(arg1)->Enqueue((RTPPacket::shared const &)*arg2);
+  result = (QWORD)(arg1)->GetSent();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPSessionFacade_SendPLI_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0, intgo _swig_go_1) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  uint32_t arg2 ;
-  int result;
-  intgo _swig_go_result;
+long long _wrap_RTPPacer_GetDropped_native_3e8e6202ec41eede(RTPPacer *_swig_go_0) {
+  RTPPacer *arg1 = (RTPPacer *) 0 ;
+  QWORD result;
+  long long _swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(RTPPacer **)&_swig_go_0; 
   
-  result = (int)(arg1)->SendPLI(arg2);
+  result = (QWORD)(arg1)->GetDropped();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPSessionFacade_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
+void _wrap_RTPPacer_Stop_native_3e8e6202ec41eede(RTPPacer *_swig_go_0) {
+  RTPPacer *arg1 = (RTPPacer *) 0 ;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPPacer **)&_swig_go_0; 
   
-  delete arg1;
+  (arg1)->Stop();
   
 }
 
 
-RTPReceiver *_wrap_RTPSessionFacade_SwigGetRTPReceiver_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  RTPReceiver *result = 0 ;
-  RTPReceiver *_swig_go_result;
+void _wrap_delete_RTPPacer_native_3e8e6202ec41eede(RTPPacer *_swig_go_0) {
+  RTPPacer *arg1 = (RTPPacer *) 0 ;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPPacer **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (RTPReceiver*)arg1;
-  *(RTPReceiver **)&_swig_go_result = (RTPReceiver *)result; 
-  return _swig_go_result;
 }
 
 
-RTPSenderFacade *_wrap_new_RTPSenderFacade__SWIG_0_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPSenderFacade *result = 0 ;
-  RTPSenderFacade *_swig_go_result;
+RTPDumpWriter *_wrap_new_RTPDumpWriter_native_3e8e6202ec41eede() {
+  RTPDumpWriter *result = 0 ;
+  RTPDumpWriter *_swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
   
-  result = (RTPSenderFacade *)new RTPSenderFacade(arg1);
-  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
+  result = (RTPDumpWriter *)new RTPDumpWriter();
+  *(RTPDumpWriter **)&_swig_go_result = (RTPDumpWriter *)result; 
   return _swig_go_result;
 }
 
 
-RTPSenderFacade *_wrap_new_RTPSenderFacade__SWIG_1_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  RTPSenderFacade *result = 0 ;
-  RTPSenderFacade *_swig_go_result;
+bool _wrap_RTPDumpWriter_Open_native_3e8e6202ec41eede(RTPDumpWriter *_swig_go_0, _gostring_ _swig_go_1) {
+  RTPDumpWriter *arg1 = (RTPDumpWriter *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool result;
+  bool _swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPDumpWriter **)&_swig_go_0; 
   
-  result = (RTPSenderFacade *)new RTPSenderFacade(arg1);
-  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
-  return _swig_go_result;
-}
-
-
-RTPSender *_wrap_RTPSenderFacade_get_native_3e8e6202ec41eede(RTPSenderFacade *_swig_go_0) {
-  RTPSenderFacade *arg1 = (RTPSenderFacade *) 0 ;
-  RTPSender *result = 0 ;
-  RTPSender *_swig_go_result;
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
   
-  arg1 = *(RTPSenderFacade **)&_swig_go_0; 
   
-  result = (RTPSender *)(arg1)->get();
-  *(RTPSender **)&_swig_go_result = (RTPSender *)result; 
+  result = (bool)(arg1)->Open((char const *)arg2);
+  _swig_go_result = result; 
+  free(arg2); 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPSenderFacade_native_3e8e6202ec41eede(RTPSenderFacade *_swig_go_0) {
-  RTPSenderFacade *arg1 = (RTPSenderFacade *) 0 ;
+void _wrap_RTPDumpWriter_AddIncoming_native_3e8e6202ec41eede(RTPDumpWriter *_swig_go_0, RTPIncomingMediaStream *_swig_go_1) {
+  RTPDumpWriter *arg1 = (RTPDumpWriter *) 0 ;
+  RTPIncomingMediaStream *arg2 = (RTPIncomingMediaStream *) 0 ;
   
-  arg1 = *(RTPSenderFacade **)&_swig_go_0; 
+  arg1 = *(RTPDumpWriter **)&_swig_go_0; 
+  arg2 = *(RTPIncomingMediaStream **)&_swig_go_1; 
   
-  delete arg1;
+  (arg1)->AddIncoming(arg2);
   
 }
 
 
-RTPReceiverFacade *_wrap_new_RTPReceiverFacade__SWIG_0_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPReceiverFacade *result = 0 ;
-  RTPReceiverFacade *_swig_go_result;
+void _wrap_RTPDumpWriter_Close_native_3e8e6202ec41eede(RTPDumpWriter *_swig_go_0) {
+  RTPDumpWriter *arg1 = (RTPDumpWriter *) 0 ;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(RTPDumpWriter **)&_swig_go_0; 
+  
+  (arg1)->Close();
   
-  result = (RTPReceiverFacade *)new RTPReceiverFacade(arg1);
-  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
-  return _swig_go_result;
 }
 
 
-RTPReceiverFacade *_wrap_new_RTPReceiverFacade__SWIG_1_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  RTPReceiverFacade *result = 0 ;
-  RTPReceiverFacade *_swig_go_result;
+void _wrap_delete_RTPDumpWriter_native_3e8e6202ec41eede(RTPDumpWriter *_swig_go_0) {
+  RTPDumpWriter *arg1 = (RTPDumpWriter *) 0 ;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(RTPDumpWriter **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (RTPReceiverFacade *)new RTPReceiverFacade(arg1);
-  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
-  return _swig_go_result;
 }
 
 
-RTPReceiver *_wrap_RTPReceiverFacade_get_native_3e8e6202ec41eede(RTPReceiverFacade *_swig_go_0) {
-  RTPReceiverFacade *arg1 = (RTPReceiverFacade *) 0 ;
-  RTPReceiver *result = 0 ;
-  RTPReceiver *_swig_go_result;
+AudioLevelMeter *_wrap_new_AudioLevelMeter_native_3e8e6202ec41eede(RTPIncomingMediaStream *_swig_go_0) {
+  RTPIncomingMediaStream *arg1 = (RTPIncomingMediaStream *) 0 ;
+  AudioLevelMeter *result = 0 ;
+  AudioLevelMeter *_swig_go_result;
   
-  arg1 = *(RTPReceiverFacade **)&_swig_go_0; 
+  arg1 = *(RTPIncomingMediaStream **)&_swig_go_0; 
   
-  result = (RTPReceiver *)(arg1)->get();
-  *(RTPReceiver **)&_swig_go_result = (RTPReceiver *)result; 
+  result = (AudioLevelMeter *)new AudioLevelMeter(arg1);
+  *(AudioLevelMeter **)&_swig_go_result = (AudioLevelMeter *)result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_RTPReceiverFacade_SendPLI_native_3e8e6202ec41eede(RTPReceiverFacade *_swig_go_0, intgo _swig_go_1) {
-  RTPReceiverFacade *arg1 = (RTPReceiverFacade *) 0 ;
-  uint32_t arg2 ;
-  int result;
-  intgo _swig_go_result;
+char _wrap_AudioLevelMeter_ReadLevel_native_3e8e6202ec41eede(AudioLevelMeter *_swig_go_0) {
+  AudioLevelMeter *arg1 = (AudioLevelMeter *) 0 ;
+  BYTE result;
+  char _swig_go_result;
   
-  arg1 = *(RTPReceiverFacade **)&_swig_go_0; 
-  arg2 = (uint32_t)_swig_go_1; 
+  arg1 = *(AudioLevelMeter **)&_swig_go_0; 
   
-  result = (int)(arg1)->SendPLI(arg2);
+  result = (BYTE)(arg1)->ReadLevel();
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-void _wrap_delete_RTPReceiverFacade_native_3e8e6202ec41eede(RTPReceiverFacade *_swig_go_0) {
-  RTPReceiverFacade *arg1 = (RTPReceiverFacade *) 0 ;
+void _wrap_AudioLevelMeter_Stop_native_3e8e6202ec41eede(AudioLevelMeter *_swig_go_0) {
+  AudioLevelMeter *arg1 = (AudioLevelMeter *) 0 ;
   
-  arg1 = *(RTPReceiverFacade **)&_swig_go_0; 
+  arg1 = *(AudioLevelMeter **)&_swig_go_0; 
   
-  delete arg1;
+  (arg1)->Stop();
   
 }
 
 
-RTPSenderFacade *_wrap_TransportToSender_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPSenderFacade *result = 0 ;
-  RTPSenderFacade *_swig_go_result;
+void _wrap_delete_AudioLevelMeter_native_3e8e6202ec41eede(AudioLevelMeter *_swig_go_0) {
+  AudioLevelMeter *arg1 = (AudioLevelMeter *) 0 ;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(AudioLevelMeter **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (RTPSenderFacade *)TransportToSender(arg1);
-  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
-  return _swig_go_result;
 }
 
 
-RTPReceiverFacade *_wrap_TransportToReceiver_native_3e8e6202ec41eede(DTLSICETransport *_swig_go_0) {
-  DTLSICETransport *arg1 = (DTLSICETransport *) 0 ;
-  RTPReceiverFacade *result = 0 ;
-  RTPReceiverFacade *_swig_go_result;
+PayloadCodecMonitor *_wrap_new_PayloadCodecMonitor_native_3e8e6202ec41eede(RTPIncomingMediaStream *_swig_go_0) {
+  RTPIncomingMediaStream *arg1 = (RTPIncomingMediaStream *) 0 ;
+  PayloadCodecMonitor *result = 0 ;
+  PayloadCodecMonitor *_swig_go_result;
   
-  arg1 = *(DTLSICETransport **)&_swig_go_0; 
+  arg1 = *(RTPIncomingMediaStream **)&_swig_go_0; 
   
-  result = (RTPReceiverFacade *)TransportToReceiver(arg1);
-  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
+  result = (PayloadCodecMonitor *)new PayloadCodecMonitor(arg1);
+  *(PayloadCodecMonitor **)&_swig_go_result = (PayloadCodecMonitor *)result; 
   return _swig_go_result;
 }
 
 
-RTPSenderFacade *_wrap_SessionToSender_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  RTPSenderFacade *result = 0 ;
-  RTPSenderFacade *_swig_go_result;
+_gostring_ _wrap_PayloadCodecMonitor_GetCodec_native_3e8e6202ec41eede(PayloadCodecMonitor *_swig_go_0) {
+  PayloadCodecMonitor *arg1 = (PayloadCodecMonitor *) 0 ;
+  char *result = 0 ;
+  _gostring_ _swig_go_result;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(PayloadCodecMonitor **)&_swig_go_0; 
   
-  result = (RTPSenderFacade *)SessionToSender(arg1);
-  *(RTPSenderFacade **)&_swig_go_result = (RTPSenderFacade *)result; 
+  result = (char *)(arg1)->GetCodec();
+  _swig_go_result = Swig_AllocateString((char*)result, result ? strlen((char*)result) : 0); 
   return _swig_go_result;
 }
 
 
-RTPReceiverFacade *_wrap_SessionToReceiver_native_3e8e6202ec41eede(RTPSessionFacade *_swig_go_0) {
-  RTPSessionFacade *arg1 = (RTPSessionFacade *) 0 ;
-  RTPReceiverFacade *result = 0 ;
-  RTPReceiverFacade *_swig_go_result;
+void _wrap_PayloadCodecMonitor_Stop_native_3e8e6202ec41eede(PayloadCodecMonitor *_swig_go_0) {
+  PayloadCodecMonitor *arg1 = (PayloadCodecMonitor *) 0 ;
   
-  arg1 = *(RTPSessionFacade **)&_swig_go_0; 
+  arg1 = *(PayloadCodecMonitor **)&_swig_go_0; 
+  
+  (arg1)->Stop();
   
-  result = (RTPReceiverFacade *)SessionToReceiver(arg1);
-  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
-  return _swig_go_result;
 }
 
 
-RTPReceiverFacade *_wrap_RTPSessionToReceiver_native_3e8e6202ec41eede(MediaFrameSessionFacade *_swig_go_0) {
-  MediaFrameSessionFacade *arg1 = (MediaFrameSessionFacade *) 0 ;
-  RTPReceiverFacade *result = 0 ;
-  RTPReceiverFacade *_swig_go_result;
+void _wrap_delete_PayloadCodecMonitor_native_3e8e6202ec41eede(PayloadCodecMonitor *_swig_go_0) {
+  PayloadCodecMonitor *arg1 = (PayloadCodecMonitor *) 0 ;
   
-  arg1 = *(MediaFrameSessionFacade **)&_swig_go_0; 
+  arg1 = *(PayloadCodecMonitor **)&_swig_go_0; 
+  
+  delete arg1;
   
-  result = (RTPReceiverFacade *)RTPSessionToReceiver(arg1);
-  *(RTPReceiverFacade **)&_swig_go_result = (RTPReceiverFacade *)result; 
-  return _swig_go_result;
 }
 
 
@@ -5429,6 +6896,45 @@ void _wrap_RTPStreamTransponderFacade_Close_native_3e8e6202ec41eede(RTPStreamTra
 }
 
 
+intgo _wrap_RTPStreamTransponderFacade_GetTotalPLIRequests_native_3e8e6202ec41eede(RTPStreamTransponderFacade *_swig_go_0) {
+  RTPStreamTransponderFacade *arg1 = (RTPStreamTransponderFacade *) 0 ;
+  DWORD result;
+  intgo _swig_go_result;
+  
+  arg1 = *(RTPStreamTransponderFacade **)&_swig_go_0; 
+  
+  result = (DWORD)(arg1)->GetTotalPLIRequests();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_RTPStreamTransponderFacade_GetTotalREMBs_native_3e8e6202ec41eede(RTPStreamTransponderFacade *_swig_go_0) {
+  RTPStreamTransponderFacade *arg1 = (RTPStreamTransponderFacade *) 0 ;
+  DWORD result;
+  intgo _swig_go_result;
+  
+  arg1 = *(RTPStreamTransponderFacade **)&_swig_go_0; 
+  
+  result = (DWORD)(arg1)->GetTotalREMBs();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_RTPStreamTransponderFacade_GetLastREMB_native_3e8e6202ec41eede(RTPStreamTransponderFacade *_swig_go_0) {
+  RTPStreamTransponderFacade *arg1 = (RTPStreamTransponderFacade *) 0 ;
+  DWORD result;
+  intgo _swig_go_result;
+  
+  arg1 = *(RTPStreamTransponderFacade **)&_swig_go_0; 
+  
+  result = (DWORD)(arg1)->GetLastREMB();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
 void _wrap_delete_RTPStreamTransponderFacade_native_3e8e6202ec41eede(RTPStreamTransponderFacade *_swig_go_0) {
   RTPStreamTransponderFacade *arg1 = (RTPStreamTransponderFacade *) 0 ;
   
@@ -5439,6 +6945,56 @@ void _wrap_delete_RTPStreamTransponderFacade_native_3e8e6202ec41eede(RTPStreamTr
 }
 
 
+KeyframeCache *_wrap_new_KeyframeCache_native_3e8e6202ec41eede(RTPIncomingMediaStream *_swig_go_0) {
+  RTPIncomingMediaStream *arg1 = (RTPIncomingMediaStream *) 0 ;
+  KeyframeCache *result = 0 ;
+  KeyframeCache *_swig_go_result;
+  
+  arg1 = *(RTPIncomingMediaStream **)&_swig_go_0; 
+  
+  result = (KeyframeCache *)new KeyframeCache(arg1);
+  *(KeyframeCache **)&_swig_go_result = (KeyframeCache *)result; 
+  return _swig_go_result;
+}
+
+
+bool _wrap_KeyframeCache_Replay_native_3e8e6202ec41eede(KeyframeCache *_swig_go_0, RTPStreamTransponderFacade *_swig_go_1, intgo _swig_go_2) {
+  KeyframeCache *arg1 = (KeyframeCache *) 0 ;
+  RTPStreamTransponderFacade *arg2 = (RTPStreamTransponderFacade *) 0 ;
+  DWORD arg3 ;
+  bool result;
+  bool _swig_go_result;
+  
+  arg1 = *(KeyframeCache **)&_swig_go_0; 
+  arg2 = *(RTPStreamTransponderFacade **)&_swig_go_1; 
+  arg3 = (DWORD)_swig_go_2; 
+  
+  result = (bool)(arg1)->Replay(arg2,arg3);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+void _wrap_KeyframeCache_Stop_native_3e8e6202ec41eede(KeyframeCache *_swig_go_0) {
+  KeyframeCache *arg1 = (KeyframeCache *) 0 ;
+  
+  arg1 = *(KeyframeCache **)&_swig_go_0; 
+  
+  (arg1)->Stop();
+  
+}
+
+
+void _wrap_delete_KeyframeCache_native_3e8e6202ec41eede(KeyframeCache *_swig_go_0) {
+  KeyframeCache *arg1 = (KeyframeCache *) 0 ;
+  
+  arg1 = *(KeyframeCache **)&_swig_go_0; 
+  
+  delete arg1;
+  
+}
+
+
 StreamTrackDepacketizer *_wrap_new_StreamTrackDepacketizer_native_3e8e6202ec41eede(RTPIncomingMediaStream *_swig_go_0) {
   RTPIncomingMediaStream *arg1 = (RTPIncomingMediaStream *) 0 ;
   StreamTrackDepacketizer *result = 0 ;
@@ -5593,6 +7149,19 @@ void _wrap_MP4RecorderFacade_SetTimeShiftDuration_native_3e8e6202ec41eede(MP4Rec
 }
 
 
+intgo _wrap_MP4RecorderFacade_GetWriteErrors_native_3e8e6202ec41eede(MP4RecorderFacade *_swig_go_0) {
+  MP4RecorderFacade *arg1 = (MP4RecorderFacade *) 0 ;
+  uint32_t result;
+  intgo _swig_go_result;
+  
+  arg1 = *(MP4RecorderFacade **)&_swig_go_0; 
+  
+  result = (uint32_t)(arg1)->GetWriteErrors();
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
 bool _wrap_MP4RecorderFacade_Close__SWIG_1_native_3e8e6202ec41eede(MP4RecorderFacade *_swig_go_0, bool _swig_go_1) {
   MP4RecorderFacade *arg1 = (MP4RecorderFacade *) 0 ;
   bool arg2 ;
@@ -5740,6 +7309,18 @@ SenderSideEstimatorListener *_wrap__swig_NewDirectorSenderSideEstimatorListenerS
 }
 
 
+void _wrap__swig_DirectorSenderSideEstimatorListener_upcall_OnTargetBitrateRequested_native_3e8e6202ec41eede(SwigDirector_SenderSideEstimatorListener *_swig_go_0, intgo _swig_go_1) {
+  SwigDirector_SenderSideEstimatorListener *arg1 = (SwigDirector_SenderSideEstimatorListener *) 0 ;
+  DWORD arg2 ;
+  
+  arg1 = *(SwigDirector_SenderSideEstimatorListener **)&_swig_go_0; 
+  arg2 = (DWORD)_swig_go_1; 
+  
+  arg1->_swig_upcall_onTargetBitrateRequested(arg2);
+  
+}
+
+
 void _wrap_DeleteDirectorSenderSideEstimatorListener_native_3e8e6202ec41eede(SenderSideEstimatorListener *_swig_go_0) {
   SenderSideEstimatorListener *arg1 = (SenderSideEstimatorListener *) 0 ;
   
@@ -1,3 +1,5 @@
+// +build !stub
+
 /* ----------------------------------------------------------------------------
  * This file was automatically generated by SWIG (http://www.swig.org).
  * Version 4.0.0
@@ -34,6 +36,10 @@ class SwigDirector_SenderSideEstimatorListener : public SenderSideEstimatorListe
  public:
   SwigDirector_SenderSideEstimatorListener(int swig_p);
   virtual ~SwigDirector_SenderSideEstimatorListener();
+  void _swig_upcall_onTargetBitrateRequested(DWORD bitrate) {
+    SenderSideEstimatorListener::onTargetBitrateRequested(bitrate);
+  }
+  virtual void onTargetBitrateRequested(DWORD bitrate);
  private:
   intgo go_val;
   Swig_memory *swig_mem;
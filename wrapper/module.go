@@ -1,3 +1,7 @@
+// +build !stub
+
+//go:generate go run gen_stub.go
+
 package native
 
 
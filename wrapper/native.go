@@ -1,3 +1,5 @@
+// +build !stub
+
 /* ----------------------------------------------------------------------------
  * This file was automatically generated by SWIG (http://www.swig.org).
  * Version 4.0.0
@@ -97,6 +99,20 @@ typedef long long swig_type_66;
 typedef _gostring_ swig_type_67;
 typedef _gostring_ swig_type_68;
 typedef long long swig_type_69;
+typedef _gostring_ swig_type_70;
+typedef _gostring_ swig_type_71;
+typedef _gostring_ swig_type_72;
+typedef _gostring_ swig_type_73;
+typedef long long swig_type_74;
+typedef long long swig_type_75;
+typedef _gostring_ swig_type_76;
+typedef long long swig_type_77;
+typedef long long swig_type_78;
+typedef _gostring_ swig_type_79;
+typedef _gostring_ swig_type_80;
+typedef _gostring_ swig_type_81;
+typedef _gostring_ swig_type_82;
+typedef _gostring_ swig_type_83;
 extern void _wrap_Swig_free_native_3e8e6202ec41eede(uintptr_t arg1);
 extern uintptr_t _wrap_Swig_malloc_native_3e8e6202ec41eede(swig_intgo arg1);
 extern uintptr_t _wrap_new_Acumulator__SWIG_0_native_3e8e6202ec41eede(swig_intgo arg1, swig_intgo arg2);
@@ -297,6 +313,8 @@ extern void _wrap_MediaServer_EnableDebug_native_3e8e6202ec41eede(_Bool arg1);
 extern void _wrap_MediaServer_EnableUltraDebug_native_3e8e6202ec41eede(_Bool arg1);
 extern swig_type_35 _wrap_MediaServer_GetFingerprint_native_3e8e6202ec41eede(void);
 extern _Bool _wrap_MediaServer_SetPortRange_native_3e8e6202ec41eede(swig_intgo arg1, swig_intgo arg2);
+extern _Bool _wrap_MediaServer_SetCertificate_native_3e8e6202ec41eede(swig_type_80 arg1, swig_type_81 arg2);
+extern _Bool _wrap_MediaServer_SupportsCodec_native_3e8e6202ec41eede(swig_type_82 arg1);
 extern uintptr_t _wrap_new_MediaServer_native_3e8e6202ec41eede(void);
 extern void _wrap_delete_MediaServer_native_3e8e6202ec41eede(uintptr_t arg1);
 extern void _wrap_RTPBundleTransportConnection_transport_set_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
@@ -349,9 +367,12 @@ extern swig_intgo _wrap_DTLSICETransport_Dump__SWIG_7_native_3e8e6202ec41eede(ui
 extern swig_intgo _wrap_DTLSICETransport_Dump__SWIG_8_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2, _Bool arg3);
 extern swig_intgo _wrap_DTLSICETransport_Dump__SWIG_9_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
 extern swig_intgo _wrap_DTLSICETransport_DumpBWEStats_native_3e8e6202ec41eede(uintptr_t arg1, swig_type_54 arg2);
+extern swig_intgo _wrap_DTLSICETransport_StopDump_native_3e8e6202ec41eede(uintptr_t arg1);
 extern void _wrap_DTLSICETransport_Reset_native_3e8e6202ec41eede(uintptr_t arg1);
 extern void _wrap_DTLSICETransport_ActivateRemoteCandidate_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2, _Bool arg3, swig_intgo arg4);
 extern swig_intgo _wrap_DTLSICETransport_SetRemoteCryptoDTLS_native_3e8e6202ec41eede(uintptr_t arg1, swig_type_55 arg2, swig_type_56 arg3, swig_type_57 arg4);
+extern swig_intgo _wrap_DTLSICETransport_SetLocalCryptoSDES_native_3e8e6202ec41eede(uintptr_t arg1, swig_type_70 arg2, swig_type_71 arg3);
+extern swig_intgo _wrap_DTLSICETransport_SetRemoteCryptoSDES_native_3e8e6202ec41eede(uintptr_t arg1, swig_type_72 arg2, swig_type_73 arg3);
 extern swig_intgo _wrap_DTLSICETransport_SetLocalSTUNCredentials_native_3e8e6202ec41eede(uintptr_t arg1, swig_type_58 arg2, swig_type_59 arg3);
 extern swig_intgo _wrap_DTLSICETransport_SetRemoteSTUNCredentials_native_3e8e6202ec41eede(uintptr_t arg1, swig_type_60 arg2, swig_type_61 arg3);
 extern _Bool _wrap_DTLSICETransport_AddOutgoingSourceGroup_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
@@ -396,13 +417,62 @@ extern uintptr_t _wrap_TransportToReceiver_native_3e8e6202ec41eede(uintptr_t arg
 extern uintptr_t _wrap_SessionToSender_native_3e8e6202ec41eede(uintptr_t arg1);
 extern uintptr_t _wrap_SessionToReceiver_native_3e8e6202ec41eede(uintptr_t arg1);
 extern uintptr_t _wrap_RTPSessionToReceiver_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_intgo _wrap_MediaFrameGetLength_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_type_74 _wrap_MediaFrameGetTimestamp_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_intgo _wrap_MediaFrameGetClockRate_native_3e8e6202ec41eede(uintptr_t arg1);
+extern _Bool _wrap_MediaFrameIsIntra_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_MediaFrameCopyData_native_3e8e6202ec41eede(uintptr_t arg1, swig_voidp arg2, swig_intgo arg3);
+extern _Bool _wrap_TransportSendSenderReport_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
+extern _Bool _wrap_TransportSendRTCPApp_native_3e8e6202ec41eede(uintptr_t arg1, swig_intgo arg2, swig_intgo arg3, swig_type_79 arg4, swig_voidp arg5, swig_intgo arg6);
+extern _Bool _wrap_TransportSendREMB_native_3e8e6202ec41eede(uintptr_t arg1, swig_intgo arg2, swig_intgo arg3, swig_voidp arg4, swig_intgo arg5);
+extern _Bool _wrap_TransportSendBYE_native_3e8e6202ec41eede(uintptr_t arg1, swig_voidp arg2, swig_intgo arg3);
+extern uintptr_t _wrap_new_TimeServiceProbe_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_TimeServiceProbe_Ping_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_type_75 _wrap_TimeServiceProbe_GetPendingTime_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_delete_TimeServiceProbe_native_3e8e6202ec41eede(uintptr_t arg1);
+extern uintptr_t _wrap_new_SimulcastFrameSelector_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_SimulcastFrameSelector_Select_native_3e8e6202ec41eede(uintptr_t arg1, swig_intgo arg2);
+extern swig_intgo _wrap_SimulcastFrameSelector_GetSelected_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_delete_SimulcastFrameSelector_native_3e8e6202ec41eede(uintptr_t arg1);
+extern uintptr_t _wrap_new_RTPSenderTee_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
+extern uintptr_t _wrap_RTPSenderTee_GetSender_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_RTPSenderTee_Stop_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_delete_RTPSenderTee_native_3e8e6202ec41eede(uintptr_t arg1);
+extern uintptr_t _wrap_new_RTPPacer_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
+extern void _wrap_RTPPacer_Configure_native_3e8e6202ec41eede(uintptr_t arg1, swig_intgo arg2, swig_intgo arg3, swig_intgo arg4);
+extern uintptr_t _wrap_RTPPacer_GetSender_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_intgo _wrap_RTPPacer_GetQueued_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_type_77 _wrap_RTPPacer_GetSent_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_type_78 _wrap_RTPPacer_GetDropped_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_RTPPacer_Stop_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_delete_RTPPacer_native_3e8e6202ec41eede(uintptr_t arg1);
+extern uintptr_t _wrap_new_RTPDumpWriter_native_3e8e6202ec41eede(void);
+extern _Bool _wrap_RTPDumpWriter_Open_native_3e8e6202ec41eede(uintptr_t arg1, swig_type_76 arg2);
+extern void _wrap_RTPDumpWriter_AddIncoming_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
+extern void _wrap_RTPDumpWriter_Close_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_delete_RTPDumpWriter_native_3e8e6202ec41eede(uintptr_t arg1);
+extern uintptr_t _wrap_new_AudioLevelMeter_native_3e8e6202ec41eede(uintptr_t arg1);
+extern char _wrap_AudioLevelMeter_ReadLevel_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_AudioLevelMeter_Stop_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_delete_AudioLevelMeter_native_3e8e6202ec41eede(uintptr_t arg1);
+extern uintptr_t _wrap_new_PayloadCodecMonitor_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_type_83 _wrap_PayloadCodecMonitor_GetCodec_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_PayloadCodecMonitor_Stop_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_delete_PayloadCodecMonitor_native_3e8e6202ec41eede(uintptr_t arg1);
 extern uintptr_t _wrap_new_RTPStreamTransponderFacade_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
 extern _Bool _wrap_RTPStreamTransponderFacade_SetIncoming__SWIG_0_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3);
 extern _Bool _wrap_RTPStreamTransponderFacade_SetIncoming__SWIG_1_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3);
 extern void _wrap_RTPStreamTransponderFacade_SelectLayer_native_3e8e6202ec41eede(uintptr_t arg1, swig_intgo arg2, swig_intgo arg3);
 extern void _wrap_RTPStreamTransponderFacade_Mute_native_3e8e6202ec41eede(uintptr_t arg1, _Bool arg2);
 extern void _wrap_RTPStreamTransponderFacade_Close_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_intgo _wrap_RTPStreamTransponderFacade_GetTotalPLIRequests_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_intgo _wrap_RTPStreamTransponderFacade_GetTotalREMBs_native_3e8e6202ec41eede(uintptr_t arg1);
+extern swig_intgo _wrap_RTPStreamTransponderFacade_GetLastREMB_native_3e8e6202ec41eede(uintptr_t arg1);
 extern void _wrap_delete_RTPStreamTransponderFacade_native_3e8e6202ec41eede(uintptr_t arg1);
+extern uintptr_t _wrap_new_KeyframeCache_native_3e8e6202ec41eede(uintptr_t arg1);
+extern _Bool _wrap_KeyframeCache_Replay_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2, swig_intgo arg3);
+extern void _wrap_KeyframeCache_Stop_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap_delete_KeyframeCache_native_3e8e6202ec41eede(uintptr_t arg1);
 extern uintptr_t _wrap_new_StreamTrackDepacketizer_native_3e8e6202ec41eede(uintptr_t arg1);
 extern void _wrap_StreamTrackDepacketizer_AddMediaListener_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
 extern void _wrap_StreamTrackDepacketizer_RemoveMediaListener_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
@@ -416,6 +486,7 @@ extern _Bool _wrap_MP4RecorderFacade_Stop_native_3e8e6202ec41eede(uintptr_t arg1
 extern _Bool _wrap_MP4RecorderFacade_Close__SWIG_0_native_3e8e6202ec41eede(uintptr_t arg1);
 extern void _wrap_MP4RecorderFacade_SetTimeShiftDuration_native_3e8e6202ec41eede(uintptr_t arg1, swig_intgo arg2);
 extern _Bool _wrap_MP4RecorderFacade_Close__SWIG_1_native_3e8e6202ec41eede(uintptr_t arg1, _Bool arg2);
+extern swig_intgo _wrap_MP4RecorderFacade_GetWriteErrors_native_3e8e6202ec41eede(uintptr_t arg1);
 extern void _wrap_delete_MP4RecorderFacade_native_3e8e6202ec41eede(uintptr_t arg1);
 extern uintptr_t _wrap_new_MediaFrameSessionFacade_native_3e8e6202ec41eede(swig_intgo arg1);
 extern swig_intgo _wrap_MediaFrameSessionFacade_Init_native_3e8e6202ec41eede(uintptr_t arg1, uintptr_t arg2);
@@ -427,6 +498,7 @@ extern swig_intgo _wrap_MediaFrameSessionFacade_SendPLI_native_3e8e6202ec41eede(
 extern void _wrap_delete_MediaFrameSessionFacade_native_3e8e6202ec41eede(uintptr_t arg1);
 extern uintptr_t _wrap__swig_NewDirectorSenderSideEstimatorListenerSenderSideEstimatorListener_native_3e8e6202ec41eede(int);
 extern void _wrap_DeleteDirectorSenderSideEstimatorListener_native_3e8e6202ec41eede(uintptr_t arg1);
+extern void _wrap__swig_DirectorSenderSideEstimatorListener_upcall_OnTargetBitrateRequested_native_3e8e6202ec41eede(uintptr_t, swig_intgo bitrate);
 extern uintptr_t _wrap_new_SenderSideEstimatorListener_native_3e8e6202ec41eede(void);
 extern void _wrap_delete_SenderSideEstimatorListener_native_3e8e6202ec41eede(uintptr_t arg1);
 extern void _wrap_SenderSideEstimatorListener_onTargetBitrateRequested_native_3e8e6202ec41eede(uintptr_t arg1, swig_intgo arg2);
@@ -2241,6 +2313,30 @@ func MediaServerSetPortRange(arg1 int, arg2 int) (_swig_ret bool) {
 	return swig_r
 }
 
+func MediaServerSetCertificate(arg1 string, arg2 string) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	swig_r = (bool)(C._wrap_MediaServer_SetCertificate_native_3e8e6202ec41eede(*(*C.swig_type_80)(unsafe.Pointer(&_swig_i_0)), *(*C.swig_type_81)(unsafe.Pointer(&_swig_i_1))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg1
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func MediaServerSupportsCodec(arg1 string) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1
+	swig_r = (bool)(C._wrap_MediaServer_SupportsCodec_native_3e8e6202ec41eede(*(*C.swig_type_82)(unsafe.Pointer(&_swig_i_0))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg1
+	}
+	return swig_r
+}
+
 func NewMediaServer() (_swig_ret MediaServer) {
 	var swig_r MediaServer
 	swig_r = (MediaServer)(SwigcptrMediaServer(C._wrap_new_MediaServer_native_3e8e6202ec41eede()))
@@ -2851,6 +2947,13 @@ func (arg1 SwigcptrDTLSICETransport) DumpBWEStats(arg2 string) (_swig_ret int) {
 	return swig_r
 }
 
+func (arg1 SwigcptrDTLSICETransport) StopDump() (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	swig_r = (int)(C._wrap_DTLSICETransport_StopDump_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
 func (arg1 SwigcptrDTLSICETransport) Reset() {
 	_swig_i_0 := arg1
 	C._wrap_DTLSICETransport_Reset_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
@@ -2883,6 +2986,36 @@ func (arg1 SwigcptrDTLSICETransport) SetRemoteCryptoDTLS(arg2 string, arg3 strin
 	return swig_r
 }
 
+func (arg1 SwigcptrDTLSICETransport) SetLocalCryptoSDES(arg2 string, arg3 string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	swig_r = (int)(C._wrap_DTLSICETransport_SetLocalCryptoSDES_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), *(*C.swig_type_70)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_71)(unsafe.Pointer(&_swig_i_2))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	return swig_r
+}
+
+func (arg1 SwigcptrDTLSICETransport) SetRemoteCryptoSDES(arg2 string, arg3 string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	swig_r = (int)(C._wrap_DTLSICETransport_SetRemoteCryptoSDES_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), *(*C.swig_type_72)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_73)(unsafe.Pointer(&_swig_i_2))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	return swig_r
+}
+
 func (arg1 SwigcptrDTLSICETransport) SetLocalSTUNCredentials(arg2 string, arg3 string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
@@ -3048,9 +3181,12 @@ type DTLSICETransport interface {
 	Enqueue(arg2 RTPPacket_shared) (_swig_ret int)
 	Dump(a ...interface{}) int
 	DumpBWEStats(arg2 string) (_swig_ret int)
+	StopDump() (_swig_ret int)
 	Reset()
 	ActivateRemoteCandidate(arg2 ICERemoteCandidate, arg3 bool, arg4 uint)
 	SetRemoteCryptoDTLS(arg2 string, arg3 string, arg4 string) (_swig_ret int)
+	SetLocalCryptoSDES(arg2 string, arg3 string) (_swig_ret int)
+	SetRemoteCryptoSDES(arg2 string, arg3 string) (_swig_ret int)
 	SetLocalSTUNCredentials(arg2 string, arg3 string) (_swig_ret int)
 	SetRemoteSTUNCredentials(arg2 string, arg3 string) (_swig_ret int)
 	AddOutgoingSourceGroup(arg2 RTPOutgoingSourceGroup) (_swig_ret bool)
@@ -3350,6 +3486,422 @@ func RTPSessionToReceiver(arg1 MediaFrameSessionFacade) (_swig_ret RTPReceiverFa
 	return swig_r
 }
 
+func MediaFrameGetLength(arg1 MediaFrame) (_swig_ret uint) {
+	var swig_r uint
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (uint)(C._wrap_MediaFrameGetLength_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func MediaFrameGetTimestamp(arg1 MediaFrame) (_swig_ret uint64) {
+	var swig_r uint64
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (uint64)(C._wrap_MediaFrameGetTimestamp_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func MediaFrameGetClockRate(arg1 MediaFrame) (_swig_ret uint) {
+	var swig_r uint
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (uint)(C._wrap_MediaFrameGetClockRate_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func MediaFrameIsIntra(arg1 MediaFrame) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (bool)(C._wrap_MediaFrameIsIntra_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func MediaFrameCopyData(arg1 MediaFrame, arg2 *byte, arg3 int) {
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	C._wrap_MediaFrameCopyData_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.swig_voidp(_swig_i_1), C.swig_intgo(_swig_i_2))
+}
+
+func TransportSendSenderReport(arg1 DTLSICETransport, arg2 RTPOutgoingSourceGroup) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2.Swigcptr()
+	swig_r = (bool)(C._wrap_TransportSendSenderReport_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1)))
+	return swig_r
+}
+
+func TransportSendRTCPApp(arg1 DTLSICETransport, arg2 uint, arg3 int, arg4 string, arg5 *byte, arg6 int) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	swig_r = (bool)(C._wrap_TransportSendRTCPApp_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.swig_intgo(_swig_i_2), *(*C.swig_type_79)(unsafe.Pointer(&_swig_i_3)), C.swig_voidp(_swig_i_4), C.swig_intgo(_swig_i_5)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	return swig_r
+}
+
+func TransportSendREMB(arg1 DTLSICETransport, arg2 uint, arg3 uint, arg4 *byte, arg5 int) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	swig_r = (bool)(C._wrap_TransportSendREMB_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_intgo(_swig_i_4)))
+	return swig_r
+}
+
+func TransportSendBYE(arg1 DTLSICETransport, arg2 *byte, arg3 int) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	swig_r = (bool)(C._wrap_TransportSendBYE_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.swig_voidp(_swig_i_1), C.swig_intgo(_swig_i_2)))
+	return swig_r
+}
+
+type SwigcptrTimeServiceProbe uintptr
+
+func (p SwigcptrTimeServiceProbe) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+
+func (p SwigcptrTimeServiceProbe) SwigIsTimeServiceProbe() {
+}
+
+func NewTimeServiceProbe(arg1 TimeService) (_swig_ret TimeServiceProbe) {
+	var swig_r TimeServiceProbe
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (TimeServiceProbe)(SwigcptrTimeServiceProbe(C._wrap_new_TimeServiceProbe_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func (arg1 SwigcptrTimeServiceProbe) Ping() {
+	_swig_i_0 := arg1
+	C._wrap_TimeServiceProbe_Ping_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+func (arg1 SwigcptrTimeServiceProbe) GetPendingTime() (_swig_ret uint64) {
+	var swig_r uint64
+	_swig_i_0 := arg1
+	swig_r = (uint64)(C._wrap_TimeServiceProbe_GetPendingTime_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func DeleteTimeServiceProbe(arg1 TimeServiceProbe) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_delete_TimeServiceProbe_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+type TimeServiceProbe interface {
+	Swigcptr() uintptr
+	SwigIsTimeServiceProbe()
+	Ping()
+	GetPendingTime() (_swig_ret uint64)
+}
+
+type SwigcptrSimulcastFrameSelector uintptr
+
+func (p SwigcptrSimulcastFrameSelector) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+
+func (p SwigcptrSimulcastFrameSelector) SwigIsSimulcastFrameSelector() {
+}
+
+func NewSimulcastFrameSelector(arg1 MediaFrameListener) (_swig_ret SimulcastFrameSelector) {
+	var swig_r SimulcastFrameSelector
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (SimulcastFrameSelector)(SwigcptrSimulcastFrameSelector(C._wrap_new_SimulcastFrameSelector_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func (arg1 SwigcptrSimulcastFrameSelector) Select(arg2 uint) {
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	C._wrap_SimulcastFrameSelector_Select_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1))
+}
+
+func (arg1 SwigcptrSimulcastFrameSelector) GetSelected() (_swig_ret uint) {
+	var swig_r uint
+	_swig_i_0 := arg1
+	swig_r = (uint)(C._wrap_SimulcastFrameSelector_GetSelected_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func DeleteSimulcastFrameSelector(arg1 SimulcastFrameSelector) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_delete_SimulcastFrameSelector_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+func (p SwigcptrSimulcastFrameSelector) SwigIsMediaFrameListener() {
+}
+
+func (p SwigcptrSimulcastFrameSelector) SwigGetMediaFrameListener() MediaFrameListener {
+	return SwigcptrMediaFrameListener(p.Swigcptr())
+}
+
+type SimulcastFrameSelector interface {
+	Swigcptr() uintptr
+	SwigIsSimulcastFrameSelector()
+	Select(arg2 uint)
+	GetSelected() (_swig_ret uint)
+	SwigIsMediaFrameListener()
+	SwigGetMediaFrameListener() MediaFrameListener
+}
+
+type SwigcptrRTPSenderTee uintptr
+
+func (p SwigcptrRTPSenderTee) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+
+func (p SwigcptrRTPSenderTee) SwigIsRTPSenderTee() {
+}
+
+func NewRTPSenderTee(arg1 RTPSenderFacade, arg2 MediaFrameListener) (_swig_ret RTPSenderTee) {
+	var swig_r RTPSenderTee
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2.Swigcptr()
+	swig_r = (RTPSenderTee)(SwigcptrRTPSenderTee(C._wrap_new_RTPSenderTee_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1))))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPSenderTee) GetSender() (_swig_ret RTPSenderFacade) {
+	var swig_r RTPSenderFacade
+	_swig_i_0 := arg1
+	swig_r = (RTPSenderFacade)(SwigcptrRTPSenderFacade(C._wrap_RTPSenderTee_GetSender_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPSenderTee) Stop() {
+	_swig_i_0 := arg1
+	C._wrap_RTPSenderTee_Stop_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+func DeleteRTPSenderTee(arg1 RTPSenderTee) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_delete_RTPSenderTee_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+type RTPSenderTee interface {
+	Swigcptr() uintptr
+	SwigIsRTPSenderTee()
+	GetSender() (_swig_ret RTPSenderFacade)
+	Stop()
+}
+
+type SwigcptrRTPPacer uintptr
+
+func (p SwigcptrRTPPacer) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+
+func (p SwigcptrRTPPacer) SwigIsRTPPacer() {
+}
+
+func NewRTPPacer(arg1 RTPSenderFacade, arg2 TimeService) (_swig_ret RTPPacer) {
+	var swig_r RTPPacer
+	_swig_i_0 := arg1.Swigcptr()
+	_swig_i_1 := arg2.Swigcptr()
+	swig_r = (RTPPacer)(SwigcptrRTPPacer(C._wrap_new_RTPPacer_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1))))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPPacer) Configure(arg2 uint, arg3 uint, arg4 uint) {
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	C._wrap_RTPPacer_Configure_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.swig_intgo(_swig_i_2), C.swig_intgo(_swig_i_3))
+}
+
+func (arg1 SwigcptrRTPPacer) GetSender() (_swig_ret RTPSenderFacade) {
+	var swig_r RTPSenderFacade
+	_swig_i_0 := arg1
+	swig_r = (RTPSenderFacade)(SwigcptrRTPSenderFacade(C._wrap_RTPPacer_GetSender_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPPacer) GetQueued() (_swig_ret uint) {
+	var swig_r uint
+	_swig_i_0 := arg1
+	swig_r = (uint)(C._wrap_RTPPacer_GetQueued_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPPacer) GetSent() (_swig_ret uint64) {
+	var swig_r uint64
+	_swig_i_0 := arg1
+	swig_r = (uint64)(C._wrap_RTPPacer_GetSent_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPPacer) GetDropped() (_swig_ret uint64) {
+	var swig_r uint64
+	_swig_i_0 := arg1
+	swig_r = (uint64)(C._wrap_RTPPacer_GetDropped_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPPacer) Stop() {
+	_swig_i_0 := arg1
+	C._wrap_RTPPacer_Stop_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+func DeleteRTPPacer(arg1 RTPPacer) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_delete_RTPPacer_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+type RTPPacer interface {
+	Swigcptr() uintptr
+	SwigIsRTPPacer()
+	Configure(arg2 uint, arg3 uint, arg4 uint)
+	GetSender() (_swig_ret RTPSenderFacade)
+	GetQueued() (_swig_ret uint)
+	GetSent() (_swig_ret uint64)
+	GetDropped() (_swig_ret uint64)
+	Stop()
+}
+
+type SwigcptrRTPDumpWriter uintptr
+
+func (p SwigcptrRTPDumpWriter) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+
+func (p SwigcptrRTPDumpWriter) SwigIsRTPDumpWriter() {
+}
+
+func NewRTPDumpWriter() (_swig_ret RTPDumpWriter) {
+	var swig_r RTPDumpWriter
+	swig_r = (RTPDumpWriter)(SwigcptrRTPDumpWriter(C._wrap_new_RTPDumpWriter_native_3e8e6202ec41eede()))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPDumpWriter) Open(arg2 string) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	swig_r = (bool)(C._wrap_RTPDumpWriter_Open_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), *(*C.swig_type_76)(unsafe.Pointer(&_swig_i_1))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPDumpWriter) AddIncoming(arg2 RTPIncomingMediaStream) {
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2.Swigcptr()
+	C._wrap_RTPDumpWriter_AddIncoming_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1))
+}
+
+func (arg1 SwigcptrRTPDumpWriter) Close() {
+	_swig_i_0 := arg1
+	C._wrap_RTPDumpWriter_Close_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+func DeleteRTPDumpWriter(arg1 RTPDumpWriter) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_delete_RTPDumpWriter_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+type RTPDumpWriter interface {
+	Swigcptr() uintptr
+	SwigIsRTPDumpWriter()
+	Open(arg2 string) (_swig_ret bool)
+	AddIncoming(arg2 RTPIncomingMediaStream)
+	Close()
+}
+
+type SwigcptrAudioLevelMeter uintptr
+
+func (p SwigcptrAudioLevelMeter) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+
+func (p SwigcptrAudioLevelMeter) SwigIsAudioLevelMeter() {
+}
+
+func NewAudioLevelMeter(arg1 RTPIncomingMediaStream) (_swig_ret AudioLevelMeter) {
+	var swig_r AudioLevelMeter
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (AudioLevelMeter)(SwigcptrAudioLevelMeter(C._wrap_new_AudioLevelMeter_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func (arg1 SwigcptrAudioLevelMeter) ReadLevel() (_swig_ret byte) {
+	var swig_r byte
+	_swig_i_0 := arg1
+	swig_r = (byte)(C._wrap_AudioLevelMeter_ReadLevel_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func (arg1 SwigcptrAudioLevelMeter) Stop() {
+	_swig_i_0 := arg1
+	C._wrap_AudioLevelMeter_Stop_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+func DeleteAudioLevelMeter(arg1 AudioLevelMeter) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_delete_AudioLevelMeter_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+type AudioLevelMeter interface {
+	Swigcptr() uintptr
+	SwigIsAudioLevelMeter()
+	ReadLevel() (_swig_ret byte)
+	Stop()
+}
+
+type SwigcptrPayloadCodecMonitor uintptr
+
+func (p SwigcptrPayloadCodecMonitor) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+
+func (p SwigcptrPayloadCodecMonitor) SwigIsPayloadCodecMonitor() {
+}
+
+func NewPayloadCodecMonitor(arg1 RTPIncomingMediaStream) (_swig_ret PayloadCodecMonitor) {
+	var swig_r PayloadCodecMonitor
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (PayloadCodecMonitor)(SwigcptrPayloadCodecMonitor(C._wrap_new_PayloadCodecMonitor_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func (arg1 SwigcptrPayloadCodecMonitor) GetCodec() (_swig_ret string) {
+	var swig_r string
+	_swig_i_0 := arg1
+	swig_r_p := C._wrap_PayloadCodecMonitor_GetCodec_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+	swig_r = *(*string)(unsafe.Pointer(&swig_r_p))
+	var swig_r_1 string
+ swig_r_1 = swigCopyString(swig_r) 
+	return swig_r_1
+}
+
+func (arg1 SwigcptrPayloadCodecMonitor) Stop() {
+	_swig_i_0 := arg1
+	C._wrap_PayloadCodecMonitor_Stop_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+func DeletePayloadCodecMonitor(arg1 PayloadCodecMonitor) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_delete_PayloadCodecMonitor_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+type PayloadCodecMonitor interface {
+	Swigcptr() uintptr
+	SwigIsPayloadCodecMonitor()
+	GetCodec() (_swig_ret string)
+	Stop()
+}
+
 type SwigcptrRTPStreamTransponderFacade uintptr
 
 func (p SwigcptrRTPStreamTransponderFacade) Swigcptr() uintptr {
@@ -3418,6 +3970,27 @@ func (arg1 SwigcptrRTPStreamTransponderFacade) Close() {
 	C._wrap_RTPStreamTransponderFacade_Close_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
 }
 
+func (arg1 SwigcptrRTPStreamTransponderFacade) GetTotalPLIRequests() (_swig_ret uint) {
+	var swig_r uint
+	_swig_i_0 := arg1
+	swig_r = (uint)(C._wrap_RTPStreamTransponderFacade_GetTotalPLIRequests_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPStreamTransponderFacade) GetTotalREMBs() (_swig_ret uint) {
+	var swig_r uint
+	_swig_i_0 := arg1
+	swig_r = (uint)(C._wrap_RTPStreamTransponderFacade_GetTotalREMBs_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
+func (arg1 SwigcptrRTPStreamTransponderFacade) GetLastREMB() (_swig_ret uint) {
+	var swig_r uint
+	_swig_i_0 := arg1
+	swig_r = (uint)(C._wrap_RTPStreamTransponderFacade_GetLastREMB_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
 func DeleteRTPStreamTransponderFacade(arg1 RTPStreamTransponderFacade) {
 	_swig_i_0 := arg1.Swigcptr()
 	C._wrap_delete_RTPStreamTransponderFacade_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
@@ -3430,6 +4003,9 @@ type RTPStreamTransponderFacade interface {
 	SelectLayer(arg2 int, arg3 int)
 	Mute(arg2 bool)
 	Close()
+	GetTotalPLIRequests() (_swig_ret uint)
+	GetTotalREMBs() (_swig_ret uint)
+	GetLastREMB() (_swig_ret uint)
 }
 
 type SwigcptrMediaFrameListener uintptr
@@ -3446,6 +4022,48 @@ type MediaFrameListener interface {
 	SwigIsMediaFrameListener()
 }
 
+type SwigcptrKeyframeCache uintptr
+
+func (p SwigcptrKeyframeCache) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+
+func (p SwigcptrKeyframeCache) SwigIsKeyframeCache() {
+}
+
+func NewKeyframeCache(arg1 RTPIncomingMediaStream) (_swig_ret KeyframeCache) {
+	var swig_r KeyframeCache
+	_swig_i_0 := arg1.Swigcptr()
+	swig_r = (KeyframeCache)(SwigcptrKeyframeCache(C._wrap_new_KeyframeCache_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))))
+	return swig_r
+}
+
+func (arg1 SwigcptrKeyframeCache) Replay(arg2 RTPStreamTransponderFacade, arg3 uint) (_swig_ret bool) {
+	var swig_r bool
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2.Swigcptr()
+	_swig_i_2 := arg3
+	swig_r = (bool)(C._wrap_KeyframeCache_Replay_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.swig_intgo(_swig_i_2)))
+	return swig_r
+}
+
+func (arg1 SwigcptrKeyframeCache) Stop() {
+	_swig_i_0 := arg1
+	C._wrap_KeyframeCache_Stop_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+func DeleteKeyframeCache(arg1 KeyframeCache) {
+	_swig_i_0 := arg1.Swigcptr()
+	C._wrap_delete_KeyframeCache_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0))
+}
+
+type KeyframeCache interface {
+	Swigcptr() uintptr
+	SwigIsKeyframeCache()
+	Replay(arg2 RTPStreamTransponderFacade, arg3 uint) (_swig_ret bool)
+	Stop()
+}
+
 type SwigcptrStreamTrackDepacketizer uintptr
 
 func (p SwigcptrStreamTrackDepacketizer) Swigcptr() uintptr {
@@ -3572,6 +4190,13 @@ func (arg1 SwigcptrMP4RecorderFacade) Close__SWIG_1(arg2 bool) (_swig_ret bool)
 	return swig_r
 }
 
+func (arg1 SwigcptrMP4RecorderFacade) GetWriteErrors() (_swig_ret uint) {
+	var swig_r uint
+	_swig_i_0 := arg1
+	swig_r = (uint)(C._wrap_MP4RecorderFacade_GetWriteErrors_native_3e8e6202ec41eede(C.uintptr_t(_swig_i_0)))
+	return swig_r
+}
+
 func (p SwigcptrMP4RecorderFacade) Close(a ...interface{}) bool {
 	argc := len(a)
 	if argc == 0 {
@@ -3603,6 +4228,7 @@ type MP4RecorderFacade interface {
 	Stop() (_swig_ret bool)
 	SetTimeShiftDuration(arg2 uint)
 	Close(a ...interface{}) bool
+	GetWriteErrors() (_swig_ret uint)
 	SwigIsMediaFrameListener()
 	SwigGetMediaFrameListener() MediaFrameListener
 }
@@ -3726,6 +4352,30 @@ func Swiggo_DeleteDirector_SenderSideEstimatorListener_native_3e8e6202ec41eede(c
 	swigDirectorDelete(c)
 }
 
+type _swig_DirectorInterfaceSenderSideEstimatorListenerOnTargetBitrateRequested interface {
+	OnTargetBitrateRequested(uint)
+}
+
+func (swig_p *_swig_DirectorSenderSideEstimatorListener) OnTargetBitrateRequested(bitrate uint) {
+	if swig_g, swig_ok := swig_p.v.(_swig_DirectorInterfaceSenderSideEstimatorListenerOnTargetBitrateRequested); swig_ok {
+		swig_g.OnTargetBitrateRequested(bitrate)
+		return
+	}
+	_swig_i_0 := bitrate
+	C._wrap__swig_DirectorSenderSideEstimatorListener_upcall_OnTargetBitrateRequested_native_3e8e6202ec41eede(C.uintptr_t(swig_p.SwigcptrSenderSideEstimatorListener), C.swig_intgo(_swig_i_0))
+}
+
+func DirectorSenderSideEstimatorListenerOnTargetBitrateRequested(p SenderSideEstimatorListener, arg2 uint) {
+	_swig_i_0 := arg2
+	C._wrap__swig_DirectorSenderSideEstimatorListener_upcall_OnTargetBitrateRequested_native_3e8e6202ec41eede(C.uintptr_t(p.(*_swig_DirectorSenderSideEstimatorListener).SwigcptrSenderSideEstimatorListener), C.swig_intgo(_swig_i_0))
+}
+
+//export Swig_DirectorSenderSideEstimatorListener_callback_onTargetBitrateRequested_native_3e8e6202ec41eede
+func Swig_DirectorSenderSideEstimatorListener_callback_onTargetBitrateRequested_native_3e8e6202ec41eede(swig_c int, arg2 uint) {
+	swig_p := swigDirectorLookup(swig_c).(*_swig_DirectorSenderSideEstimatorListener)
+	swig_p.OnTargetBitrateRequested(arg2)
+}
+
 type SwigcptrSenderSideEstimatorListener uintptr
 
 func (p SwigcptrSenderSideEstimatorListener) Swigcptr() uintptr {
@@ -0,0 +1,2323 @@
+// Code generated by gen_stub.go from native.go. DO NOT EDIT.
+
+//go:build stub
+// +build stub
+
+package native
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+var stubHandles uintptr
+
+// stubHandle a unique non zero handle for the stubbed classes
+func stubHandle() uintptr {
+	return atomic.AddUintptr(&stubHandles, 1)
+}
+
+type _ unsafe.Pointer
+
+var Swig_escape_always_false bool
+var Swig_escape_val interface{}
+
+type _swig_fnptr *byte
+type _swig_memberptr *byte
+type _ sync.Mutex
+type swig_gostring struct {
+	p uintptr
+	n int
+}
+
+func swigCopyString(s string) string {
+	return *new(string)
+}
+func Swig_free(arg1 uintptr) {
+}
+func Swig_malloc(arg1 int) (_swig_ret uintptr) {
+	return *new(uintptr)
+}
+
+type SwigcptrAcumulator uintptr
+
+func (p SwigcptrAcumulator) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrAcumulator) SwigIsAcumulator() {
+}
+func NewAcumulator__SWIG_0(arg1 uint, arg2 uint) (_swig_ret Acumulator) {
+	return SwigcptrAcumulator(stubHandle())
+}
+func NewAcumulator__SWIG_1(arg1 uint) (_swig_ret Acumulator) {
+	return SwigcptrAcumulator(stubHandle())
+}
+func NewAcumulator(a ...interface{}) Acumulator {
+	argc := len(a)
+	if argc == 1 {
+		return NewAcumulator__SWIG_1(a[0].(uint))
+	}
+	if argc == 2 {
+		return NewAcumulator__SWIG_0(a[0].(uint), a[1].(uint))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrAcumulator) GetAcumulated() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrAcumulator) GetDiff() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrAcumulator) GetInstant() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrAcumulator) GetMin() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrAcumulator) GetMax() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrAcumulator) GetWindow() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrAcumulator) IsInWindow() (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrAcumulator) IsInMinMaxWindow() (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrAcumulator) GetInstantMedia() (_swig_ret Long_SS_double) {
+	return SwigcptrLong_SS_double(stubHandle())
+}
+func (arg1 SwigcptrAcumulator) GetInstantAvg() (_swig_ret Long_SS_double) {
+	return SwigcptrLong_SS_double(stubHandle())
+}
+func (arg1 SwigcptrAcumulator) GetAverage() (_swig_ret Long_SS_double) {
+	return SwigcptrLong_SS_double(stubHandle())
+}
+func (arg1 SwigcptrAcumulator) GetMinAvg() (_swig_ret Long_SS_double) {
+	return SwigcptrLong_SS_double(stubHandle())
+}
+func (arg1 SwigcptrAcumulator) GetMaxAvg() (_swig_ret Long_SS_double) {
+	return SwigcptrLong_SS_double(stubHandle())
+}
+func (arg1 SwigcptrAcumulator) ResetMinMax() {
+}
+func (arg1 SwigcptrAcumulator) Reset(arg2 uint64) {
+}
+func (arg1 SwigcptrAcumulator) Update__SWIG_0(arg2 uint64, arg3 uint) (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrAcumulator) Update__SWIG_1(arg2 uint64) (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (p SwigcptrAcumulator) Update(a ...interface{}) uint64 {
+	argc := len(a)
+	if argc == 1 {
+		return p.Update__SWIG_1(a[0].(uint64))
+	}
+	if argc == 2 {
+		return p.Update__SWIG_0(a[0].(uint64), a[1].(uint))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrAcumulator) GetMinValueInWindow() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrAcumulator) GetMaxValueInWindow() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrAcumulator) GetCount() (_swig_ret uint) {
+	return *new(uint)
+}
+func DeleteAcumulator(arg1 Acumulator) {
+}
+
+type Acumulator interface {
+	Swigcptr() uintptr
+	SwigIsAcumulator()
+	GetAcumulated() (_swig_ret uint64)
+	GetDiff() (_swig_ret uint64)
+	GetInstant() (_swig_ret uint64)
+	GetMin() (_swig_ret uint64)
+	GetMax() (_swig_ret uint64)
+	GetWindow() (_swig_ret uint)
+	IsInWindow() (_swig_ret bool)
+	IsInMinMaxWindow() (_swig_ret bool)
+	GetInstantMedia() (_swig_ret Long_SS_double)
+	GetInstantAvg() (_swig_ret Long_SS_double)
+	GetAverage() (_swig_ret Long_SS_double)
+	GetMinAvg() (_swig_ret Long_SS_double)
+	GetMaxAvg() (_swig_ret Long_SS_double)
+	ResetMinMax()
+	Reset(arg2 uint64)
+	Update(a ...interface{}) uint64
+	GetMinValueInWindow() (_swig_ret uint)
+	GetMaxValueInWindow() (_swig_ret uint)
+	GetCount() (_swig_ret uint)
+}
+type MediaFrameType int
+type SwigcptrLayerInfo uintptr
+
+func (p SwigcptrLayerInfo) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrLayerInfo) SwigIsLayerInfo() {
+}
+func SetLayerInfoMaxLayerId(arg1 byte) {
+}
+func GetLayerInfoMaxLayerId() (_swig_ret byte) {
+	return *new(byte)
+}
+func (arg1 SwigcptrLayerInfo) SetTemporalLayerId(arg2 byte) {
+}
+func (arg1 SwigcptrLayerInfo) GetTemporalLayerId() (_swig_ret byte) {
+	return *new(byte)
+}
+func (arg1 SwigcptrLayerInfo) SetSpatialLayerId(arg2 byte) {
+}
+func (arg1 SwigcptrLayerInfo) GetSpatialLayerId() (_swig_ret byte) {
+	return *new(byte)
+}
+func NewLayerInfo() (_swig_ret LayerInfo) {
+	return SwigcptrLayerInfo(stubHandle())
+}
+func DeleteLayerInfo(arg1 LayerInfo) {
+}
+
+type LayerInfo interface {
+	Swigcptr() uintptr
+	SwigIsLayerInfo()
+	SetTemporalLayerId(arg2 byte)
+	GetTemporalLayerId() (_swig_ret byte)
+	SetSpatialLayerId(arg2 byte)
+	GetSpatialLayerId() (_swig_ret byte)
+}
+type SwigcptrLayerSource uintptr
+
+func (p SwigcptrLayerSource) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrLayerSource) SwigIsLayerSource() {
+}
+func (arg1 SwigcptrLayerSource) SetNumPackets(arg2 uint) {
+}
+func (arg1 SwigcptrLayerSource) GetNumPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrLayerSource) SetTotalBytes(arg2 uint) {
+}
+func (arg1 SwigcptrLayerSource) GetTotalBytes() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrLayerSource) SetBitrate(arg2 uint) {
+}
+func (arg1 SwigcptrLayerSource) GetBitrate() (_swig_ret uint) {
+	return *new(uint)
+}
+func NewLayerSource() (_swig_ret LayerSource) {
+	return SwigcptrLayerSource(stubHandle())
+}
+func DeleteLayerSource(arg1 LayerSource) {
+}
+func (_swig_base SwigcptrLayerSource) SetTemporalLayerId(arg1 byte) {
+}
+func (_swig_base SwigcptrLayerSource) GetTemporalLayerId() (_swig_ret byte) {
+	return *new(byte)
+}
+func (_swig_base SwigcptrLayerSource) SetSpatialLayerId(arg1 byte) {
+}
+func (_swig_base SwigcptrLayerSource) GetSpatialLayerId() (_swig_ret byte) {
+	return *new(byte)
+}
+func (p SwigcptrLayerSource) SwigIsLayerInfo() {
+}
+func (p SwigcptrLayerSource) SwigGetLayerInfo() LayerInfo {
+	return SwigcptrLayerInfo(p.Swigcptr())
+}
+
+type LayerSource interface {
+	Swigcptr() uintptr
+	SwigIsLayerSource()
+	SetNumPackets(arg2 uint)
+	GetNumPackets() (_swig_ret uint)
+	SetTotalBytes(arg2 uint)
+	GetTotalBytes() (_swig_ret uint)
+	SetBitrate(arg2 uint)
+	GetBitrate() (_swig_ret uint)
+	SetTemporalLayerId(arg1 byte)
+	GetTemporalLayerId() (_swig_ret byte)
+	SetSpatialLayerId(arg1 byte)
+	GetSpatialLayerId() (_swig_ret byte)
+	SwigIsLayerInfo()
+	SwigGetLayerInfo() LayerInfo
+}
+type SwigcptrLayerSources uintptr
+
+func (p SwigcptrLayerSources) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrLayerSources) SwigIsLayerSources() {
+}
+func (arg1 SwigcptrLayerSources) Size() (_swig_ret int64) {
+	return *new(int64)
+}
+func (arg1 SwigcptrLayerSources) Get(arg2 int64) (_swig_ret LayerSource) {
+	return SwigcptrLayerSource(stubHandle())
+}
+func NewLayerSources() (_swig_ret LayerSources) {
+	return SwigcptrLayerSources(stubHandle())
+}
+func DeleteLayerSources(arg1 LayerSources) {
+}
+
+type LayerSources interface {
+	Swigcptr() uintptr
+	SwigIsLayerSources()
+	Size() (_swig_ret int64)
+	Get(arg2 int64) (_swig_ret LayerSource)
+}
+type SwigcptrRTPSource uintptr
+
+func (p SwigcptrRTPSource) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPSource) SwigIsRTPSource() {
+}
+func (arg1 SwigcptrRTPSource) SetSsrc(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetSsrc() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPSource) SetExtSeqNum(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetExtSeqNum() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPSource) SetCycles(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetCycles() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPSource) SetJitter(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetJitter() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPSource) SetNumPackets(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetNumPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPSource) SetNumRTCPPackets(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetNumRTCPPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPSource) SetTotalBytes(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetTotalBytes() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPSource) SetTotalRTCPBytes(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetTotalRTCPBytes() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPSource) SetBitrate(arg2 uint) {
+}
+func (arg1 SwigcptrRTPSource) GetBitrate() (_swig_ret uint) {
+	return *new(uint)
+}
+func NewRTPSource() (_swig_ret RTPSource) {
+	return SwigcptrRTPSource(stubHandle())
+}
+func DeleteRTPSource(arg1 RTPSource) {
+}
+
+type RTPSource interface {
+	Swigcptr() uintptr
+	SwigIsRTPSource()
+	SetSsrc(arg2 uint)
+	GetSsrc() (_swig_ret uint)
+	SetExtSeqNum(arg2 uint)
+	GetExtSeqNum() (_swig_ret uint)
+	SetCycles(arg2 uint)
+	GetCycles() (_swig_ret uint)
+	SetJitter(arg2 uint)
+	GetJitter() (_swig_ret uint)
+	SetNumPackets(arg2 uint)
+	GetNumPackets() (_swig_ret uint)
+	SetNumRTCPPackets(arg2 uint)
+	GetNumRTCPPackets() (_swig_ret uint)
+	SetTotalBytes(arg2 uint)
+	GetTotalBytes() (_swig_ret uint)
+	SetTotalRTCPBytes(arg2 uint)
+	GetTotalRTCPBytes() (_swig_ret uint)
+	SetBitrate(arg2 uint)
+	GetBitrate() (_swig_ret uint)
+}
+type SwigcptrRTPIncomingSource uintptr
+
+func (p SwigcptrRTPIncomingSource) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPIncomingSource) SwigIsRTPIncomingSource() {
+}
+func (arg1 SwigcptrRTPIncomingSource) SetLostPackets(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetLostPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetDropPackets(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetDropPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetTotalPacketsSinceLastSR(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetTotalPacketsSinceLastSR() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetTotalBytesSinceLastSR(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetTotalBytesSinceLastSR() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetMinExtSeqNumSinceLastSR(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetMinExtSeqNumSinceLastSR() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetLostPacketsSinceLastSR(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetLostPacketsSinceLastSR() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetLastReceivedSenderNTPTimestamp(arg2 uint64) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetLastReceivedSenderNTPTimestamp() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetLastReceivedSenderReport(arg2 uint64) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetLastReceivedSenderReport() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetLastReport(arg2 uint64) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetLastReport() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetLastPLI(arg2 uint64) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetLastPLI() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetTotalPLIs(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetTotalPLIs() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetTotalNACKs(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetTotalNACKs() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSource) SetLastNACKed(arg2 uint64) {
+}
+func (arg1 SwigcptrRTPIncomingSource) GetLastNACKed() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrRTPIncomingSource) Layers() (_swig_ret LayerSources) {
+	return SwigcptrLayerSources(stubHandle())
+}
+func NewRTPIncomingSource() (_swig_ret RTPIncomingSource) {
+	return SwigcptrRTPIncomingSource(stubHandle())
+}
+func DeleteRTPIncomingSource(arg1 RTPIncomingSource) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetSsrc(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetSsrc() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetExtSeqNum(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetExtSeqNum() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetCycles(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetCycles() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetJitter(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetJitter() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetNumPackets(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetNumPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetNumRTCPPackets(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetNumRTCPPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetTotalBytes(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetTotalBytes() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetTotalRTCPBytes(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetTotalRTCPBytes() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPIncomingSource) SetBitrate(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPIncomingSource) GetBitrate() (_swig_ret uint) {
+	return *new(uint)
+}
+func (p SwigcptrRTPIncomingSource) SwigIsRTPSource() {
+}
+func (p SwigcptrRTPIncomingSource) SwigGetRTPSource() RTPSource {
+	return SwigcptrRTPSource(p.Swigcptr())
+}
+
+type RTPIncomingSource interface {
+	Swigcptr() uintptr
+	SwigIsRTPIncomingSource()
+	SetLostPackets(arg2 uint)
+	GetLostPackets() (_swig_ret uint)
+	SetDropPackets(arg2 uint)
+	GetDropPackets() (_swig_ret uint)
+	SetTotalPacketsSinceLastSR(arg2 uint)
+	GetTotalPacketsSinceLastSR() (_swig_ret uint)
+	SetTotalBytesSinceLastSR(arg2 uint)
+	GetTotalBytesSinceLastSR() (_swig_ret uint)
+	SetMinExtSeqNumSinceLastSR(arg2 uint)
+	GetMinExtSeqNumSinceLastSR() (_swig_ret uint)
+	SetLostPacketsSinceLastSR(arg2 uint)
+	GetLostPacketsSinceLastSR() (_swig_ret uint)
+	SetLastReceivedSenderNTPTimestamp(arg2 uint64)
+	GetLastReceivedSenderNTPTimestamp() (_swig_ret uint64)
+	SetLastReceivedSenderReport(arg2 uint64)
+	GetLastReceivedSenderReport() (_swig_ret uint64)
+	SetLastReport(arg2 uint64)
+	GetLastReport() (_swig_ret uint64)
+	SetLastPLI(arg2 uint64)
+	GetLastPLI() (_swig_ret uint64)
+	SetTotalPLIs(arg2 uint)
+	GetTotalPLIs() (_swig_ret uint)
+	SetTotalNACKs(arg2 uint)
+	GetTotalNACKs() (_swig_ret uint)
+	SetLastNACKed(arg2 uint64)
+	GetLastNACKed() (_swig_ret uint64)
+	Layers() (_swig_ret LayerSources)
+	SetSsrc(arg1 uint)
+	GetSsrc() (_swig_ret uint)
+	SetExtSeqNum(arg1 uint)
+	GetExtSeqNum() (_swig_ret uint)
+	SetCycles(arg1 uint)
+	GetCycles() (_swig_ret uint)
+	SetJitter(arg1 uint)
+	GetJitter() (_swig_ret uint)
+	SetNumPackets(arg1 uint)
+	GetNumPackets() (_swig_ret uint)
+	SetNumRTCPPackets(arg1 uint)
+	GetNumRTCPPackets() (_swig_ret uint)
+	SetTotalBytes(arg1 uint)
+	GetTotalBytes() (_swig_ret uint)
+	SetTotalRTCPBytes(arg1 uint)
+	GetTotalRTCPBytes() (_swig_ret uint)
+	SetBitrate(arg1 uint)
+	GetBitrate() (_swig_ret uint)
+	SwigIsRTPSource()
+	SwigGetRTPSource() RTPSource
+}
+type SwigcptrRTPOutgoingSource uintptr
+
+func (p SwigcptrRTPOutgoingSource) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPOutgoingSource) SwigIsRTPOutgoingSource() {
+}
+func (arg1 SwigcptrRTPOutgoingSource) SetTime(arg2 uint) {
+}
+func (arg1 SwigcptrRTPOutgoingSource) GetTime() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPOutgoingSource) SetLastTime(arg2 uint) {
+}
+func (arg1 SwigcptrRTPOutgoingSource) GetLastTime() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPOutgoingSource) SetNumPackets(arg2 uint) {
+}
+func (arg1 SwigcptrRTPOutgoingSource) GetNumPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPOutgoingSource) SetNumRTCPPackets(arg2 uint) {
+}
+func (arg1 SwigcptrRTPOutgoingSource) GetNumRTCPPackets() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPOutgoingSource) SetTotalBytes(arg2 uint) {
+}
+func (arg1 SwigcptrRTPOutgoingSource) GetTotalBytes() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPOutgoingSource) SetTotalRTCPBytes(arg2 uint) {
+}
+func (arg1 SwigcptrRTPOutgoingSource) GetTotalRTCPBytes() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPOutgoingSource) SetLastSenderReport(arg2 uint64) {
+}
+func (arg1 SwigcptrRTPOutgoingSource) GetLastSenderReport() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrRTPOutgoingSource) SetLastSenderReportNTP(arg2 uint64) {
+}
+func (arg1 SwigcptrRTPOutgoingSource) GetLastSenderReportNTP() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func NewRTPOutgoingSource() (_swig_ret RTPOutgoingSource) {
+	return SwigcptrRTPOutgoingSource(stubHandle())
+}
+func DeleteRTPOutgoingSource(arg1 RTPOutgoingSource) {
+}
+func (_swig_base SwigcptrRTPOutgoingSource) SetSsrc(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPOutgoingSource) GetSsrc() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPOutgoingSource) SetExtSeqNum(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPOutgoingSource) GetExtSeqNum() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPOutgoingSource) SetCycles(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPOutgoingSource) GetCycles() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPOutgoingSource) SetJitter(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPOutgoingSource) GetJitter() (_swig_ret uint) {
+	return *new(uint)
+}
+func (_swig_base SwigcptrRTPOutgoingSource) SetBitrate(arg1 uint) {
+}
+func (_swig_base SwigcptrRTPOutgoingSource) GetBitrate() (_swig_ret uint) {
+	return *new(uint)
+}
+func (p SwigcptrRTPOutgoingSource) SwigIsRTPSource() {
+}
+func (p SwigcptrRTPOutgoingSource) SwigGetRTPSource() RTPSource {
+	return SwigcptrRTPSource(p.Swigcptr())
+}
+
+type RTPOutgoingSource interface {
+	Swigcptr() uintptr
+	SwigIsRTPOutgoingSource()
+	SetTime(arg2 uint)
+	GetTime() (_swig_ret uint)
+	SetLastTime(arg2 uint)
+	GetLastTime() (_swig_ret uint)
+	SetNumPackets(arg2 uint)
+	GetNumPackets() (_swig_ret uint)
+	SetNumRTCPPackets(arg2 uint)
+	GetNumRTCPPackets() (_swig_ret uint)
+	SetTotalBytes(arg2 uint)
+	GetTotalBytes() (_swig_ret uint)
+	SetTotalRTCPBytes(arg2 uint)
+	GetTotalRTCPBytes() (_swig_ret uint)
+	SetLastSenderReport(arg2 uint64)
+	GetLastSenderReport() (_swig_ret uint64)
+	SetLastSenderReportNTP(arg2 uint64)
+	GetLastSenderReportNTP() (_swig_ret uint64)
+	SetSsrc(arg1 uint)
+	GetSsrc() (_swig_ret uint)
+	SetExtSeqNum(arg1 uint)
+	GetExtSeqNum() (_swig_ret uint)
+	SetCycles(arg1 uint)
+	GetCycles() (_swig_ret uint)
+	SetJitter(arg1 uint)
+	GetJitter() (_swig_ret uint)
+	SetBitrate(arg1 uint)
+	GetBitrate() (_swig_ret uint)
+	SwigIsRTPSource()
+	SwigGetRTPSource() RTPSource
+}
+type SwigcptrTimeService uintptr
+
+func (p SwigcptrTimeService) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrTimeService) SwigIsTimeService() {
+}
+func DeleteTimeService(arg1 TimeService) {
+}
+
+type TimeService interface {
+	Swigcptr() uintptr
+	SwigIsTimeService()
+}
+type SwigcptrRTPOutgoingSourceGroup uintptr
+
+func (p SwigcptrRTPOutgoingSourceGroup) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPOutgoingSourceGroup) SwigIsRTPOutgoingSourceGroup() {
+}
+func NewRTPOutgoingSourceGroup__SWIG_0(arg1 MediaFrameType) (_swig_ret RTPOutgoingSourceGroup) {
+	return SwigcptrRTPOutgoingSourceGroup(stubHandle())
+}
+func NewRTPOutgoingSourceGroup__SWIG_1(arg1 *string, arg2 MediaFrameType) (_swig_ret RTPOutgoingSourceGroup) {
+	return SwigcptrRTPOutgoingSourceGroup(stubHandle())
+}
+func NewRTPOutgoingSourceGroup(a ...interface{}) RTPOutgoingSourceGroup {
+	argc := len(a)
+	if argc == 1 {
+		return NewRTPOutgoingSourceGroup__SWIG_0(a[0].(MediaFrameType))
+	}
+	if argc == 2 {
+		return NewRTPOutgoingSourceGroup__SWIG_1(a[0].(*string), a[1].(MediaFrameType))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) SetXtype(arg2 MediaFrameType) {
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) GetXtype() (_swig_ret MediaFrameType) {
+	return *new(MediaFrameType)
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) SetMedia(arg2 RTPOutgoingSource) {
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) GetMedia() (_swig_ret RTPOutgoingSource) {
+	return SwigcptrRTPOutgoingSource(stubHandle())
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) SetFec(arg2 RTPOutgoingSource) {
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) GetFec() (_swig_ret RTPOutgoingSource) {
+	return SwigcptrRTPOutgoingSource(stubHandle())
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) SetRtx(arg2 RTPOutgoingSource) {
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) GetRtx() (_swig_ret RTPOutgoingSource) {
+	return SwigcptrRTPOutgoingSource(stubHandle())
+}
+func (arg1 SwigcptrRTPOutgoingSourceGroup) Update() {
+}
+func DeleteRTPOutgoingSourceGroup(arg1 RTPOutgoingSourceGroup) {
+}
+
+type RTPOutgoingSourceGroup interface {
+	Swigcptr() uintptr
+	SwigIsRTPOutgoingSourceGroup()
+	SetXtype(arg2 MediaFrameType)
+	GetXtype() (_swig_ret MediaFrameType)
+	SetMedia(arg2 RTPOutgoingSource)
+	GetMedia() (_swig_ret RTPOutgoingSource)
+	SetFec(arg2 RTPOutgoingSource)
+	GetFec() (_swig_ret RTPOutgoingSource)
+	SetRtx(arg2 RTPOutgoingSource)
+	GetRtx() (_swig_ret RTPOutgoingSource)
+	Update()
+}
+type SwigcptrRTPSender uintptr
+
+func (p SwigcptrRTPSender) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPSender) SwigIsRTPSender() {
+}
+
+type RTPSender interface {
+	Swigcptr() uintptr
+	SwigIsRTPSender()
+}
+type SwigcptrRTPReceiver uintptr
+
+func (p SwigcptrRTPReceiver) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPReceiver) SwigIsRTPReceiver() {
+}
+
+type RTPReceiver interface {
+	Swigcptr() uintptr
+	SwigIsRTPReceiver()
+}
+type SwigcptrRTPIncomingMediaStreamListener uintptr
+
+func (p SwigcptrRTPIncomingMediaStreamListener) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPIncomingMediaStreamListener) SwigIsRTPIncomingMediaStreamListener() {
+}
+func DeleteRTPIncomingMediaStreamListener(arg1 RTPIncomingMediaStreamListener) {
+}
+
+type RTPIncomingMediaStreamListener interface {
+	Swigcptr() uintptr
+	SwigIsRTPIncomingMediaStreamListener()
+}
+type SwigcptrRTPIncomingMediaStream uintptr
+
+func (p SwigcptrRTPIncomingMediaStream) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPIncomingMediaStream) SwigIsRTPIncomingMediaStream() {
+}
+
+type RTPIncomingMediaStream interface {
+	Swigcptr() uintptr
+	SwigIsRTPIncomingMediaStream()
+}
+type SwigcptrRTPIncomingSourceGroup uintptr
+
+func (p SwigcptrRTPIncomingSourceGroup) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPIncomingSourceGroup) SwigIsRTPIncomingSourceGroup() {
+}
+func NewRTPIncomingSourceGroup(arg1 MediaFrameType, arg2 TimeService) (_swig_ret RTPIncomingSourceGroup) {
+	return SwigcptrRTPIncomingSourceGroup(stubHandle())
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetRid(arg2 string) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetRid() (_swig_ret string) {
+	return *new(string)
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetMid(arg2 string) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetMid() (_swig_ret string) {
+	return *new(string)
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetRtt(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetRtt() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetXtype(arg2 MediaFrameType) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetXtype() (_swig_ret MediaFrameType) {
+	return *new(MediaFrameType)
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetMedia(arg2 RTPIncomingSource) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetMedia() (_swig_ret RTPIncomingSource) {
+	return SwigcptrRTPIncomingSource(stubHandle())
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetFec(arg2 RTPIncomingSource) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetFec() (_swig_ret RTPIncomingSource) {
+	return SwigcptrRTPIncomingSource(stubHandle())
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetRtx(arg2 RTPIncomingSource) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetRtx() (_swig_ret RTPIncomingSource) {
+	return SwigcptrRTPIncomingSource(stubHandle())
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetLost(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetLost() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetMinWaitedTime(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetMinWaitedTime() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetMaxWaitedTime(arg2 uint) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetMaxWaitedTime() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) SetAvgWaitedTime(arg2 float64) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) GetAvgWaitedTime() (_swig_ret float64) {
+	return *new(float64)
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) AddListener(arg2 RTPIncomingMediaStreamListener) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) RemoveListener(arg2 RTPIncomingMediaStreamListener) {
+}
+func (arg1 SwigcptrRTPIncomingSourceGroup) Update() {
+}
+func DeleteRTPIncomingSourceGroup(arg1 RTPIncomingSourceGroup) {
+}
+func (p SwigcptrRTPIncomingSourceGroup) SwigIsRTPIncomingMediaStream() {
+}
+func (p SwigcptrRTPIncomingSourceGroup) SwigGetRTPIncomingMediaStream() RTPIncomingMediaStream {
+	return SwigcptrRTPIncomingMediaStream(p.Swigcptr())
+}
+
+type RTPIncomingSourceGroup interface {
+	Swigcptr() uintptr
+	SwigIsRTPIncomingSourceGroup()
+	SetRid(arg2 string)
+	GetRid() (_swig_ret string)
+	SetMid(arg2 string)
+	GetMid() (_swig_ret string)
+	SetRtt(arg2 uint)
+	GetRtt() (_swig_ret uint)
+	SetXtype(arg2 MediaFrameType)
+	GetXtype() (_swig_ret MediaFrameType)
+	SetMedia(arg2 RTPIncomingSource)
+	GetMedia() (_swig_ret RTPIncomingSource)
+	SetFec(arg2 RTPIncomingSource)
+	GetFec() (_swig_ret RTPIncomingSource)
+	SetRtx(arg2 RTPIncomingSource)
+	GetRtx() (_swig_ret RTPIncomingSource)
+	SetLost(arg2 uint)
+	GetLost() (_swig_ret uint)
+	SetMinWaitedTime(arg2 uint)
+	GetMinWaitedTime() (_swig_ret uint)
+	SetMaxWaitedTime(arg2 uint)
+	GetMaxWaitedTime() (_swig_ret uint)
+	SetAvgWaitedTime(arg2 float64)
+	GetAvgWaitedTime() (_swig_ret float64)
+	AddListener(arg2 RTPIncomingMediaStreamListener)
+	RemoveListener(arg2 RTPIncomingMediaStreamListener)
+	Update()
+	SwigIsRTPIncomingMediaStream()
+	SwigGetRTPIncomingMediaStream() RTPIncomingMediaStream
+}
+type SwigcptrRTPIncomingMediaStreamMultiplexer uintptr
+
+func (p SwigcptrRTPIncomingMediaStreamMultiplexer) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPIncomingMediaStreamMultiplexer) SwigIsRTPIncomingMediaStreamMultiplexer() {
+}
+func NewRTPIncomingMediaStreamMultiplexer(arg1 uint, arg2 TimeService) (_swig_ret RTPIncomingMediaStreamMultiplexer) {
+	return SwigcptrRTPIncomingMediaStreamMultiplexer(stubHandle())
+}
+func (arg1 SwigcptrRTPIncomingMediaStreamMultiplexer) Stop() {
+}
+func DeleteRTPIncomingMediaStreamMultiplexer(arg1 RTPIncomingMediaStreamMultiplexer) {
+}
+func (p SwigcptrRTPIncomingMediaStreamMultiplexer) SwigIsRTPIncomingMediaStreamListener() {
+}
+func (p SwigcptrRTPIncomingMediaStreamMultiplexer) SwigGetRTPIncomingMediaStreamListener() RTPIncomingMediaStreamListener {
+	return SwigcptrRTPIncomingMediaStreamListener(p.Swigcptr())
+}
+func (arg1 SwigcptrRTPIncomingMediaStreamMultiplexer) SwigGetRTPIncomingMediaStream() (_swig_ret RTPIncomingMediaStream) {
+	return SwigcptrRTPIncomingMediaStream(stubHandle())
+}
+
+type RTPIncomingMediaStreamMultiplexer interface {
+	Swigcptr() uintptr
+	SwigIsRTPIncomingMediaStreamMultiplexer()
+	Stop()
+	SwigIsRTPIncomingMediaStreamListener()
+	SwigGetRTPIncomingMediaStreamListener() RTPIncomingMediaStreamListener
+	SwigGetRTPIncomingMediaStream() (_swig_ret RTPIncomingMediaStream)
+}
+type SwigcptrPropertiesFacade uintptr
+
+func (p SwigcptrPropertiesFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrPropertiesFacade) SwigIsPropertiesFacade() {
+}
+func (arg1 SwigcptrPropertiesFacade) SetPropertyInt(arg2 string, arg3 int) {
+}
+func (arg1 SwigcptrPropertiesFacade) SetPropertyStr(arg2 string, arg3 string) {
+}
+func (arg1 SwigcptrPropertiesFacade) SetPropertyBool(arg2 string, arg3 bool) {
+}
+func NewPropertiesFacade() (_swig_ret PropertiesFacade) {
+	return SwigcptrPropertiesFacade(stubHandle())
+}
+func DeletePropertiesFacade(arg1 PropertiesFacade) {
+}
+
+type PropertiesFacade interface {
+	Swigcptr() uintptr
+	SwigIsPropertiesFacade()
+	SetPropertyInt(arg2 string, arg3 int)
+	SetPropertyStr(arg2 string, arg3 string)
+	SetPropertyBool(arg2 string, arg3 bool)
+}
+type SwigcptrMediaServer uintptr
+
+func (p SwigcptrMediaServer) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrMediaServer) SwigIsMediaServer() {
+}
+func MediaServerInitialize() {
+}
+func MediaServerEnableLog(arg1 bool) {
+}
+func MediaServerEnableDebug(arg1 bool) {
+}
+func MediaServerEnableUltraDebug(arg1 bool) {
+}
+func MediaServerGetFingerprint() (_swig_ret string) {
+	return *new(string)
+}
+func MediaServerSetPortRange(arg1 int, arg2 int) (_swig_ret bool) {
+	return *new(bool)
+}
+func MediaServerSetCertificate(arg1 string, arg2 string) (_swig_ret bool) {
+	return *new(bool)
+}
+func MediaServerSupportsCodec(arg1 string) (_swig_ret bool) {
+	return *new(bool)
+}
+func NewMediaServer() (_swig_ret MediaServer) {
+	return SwigcptrMediaServer(stubHandle())
+}
+func DeleteMediaServer(arg1 MediaServer) {
+}
+
+type MediaServer interface {
+	Swigcptr() uintptr
+	SwigIsMediaServer()
+}
+type SwigcptrRTPBundleTransportConnection uintptr
+
+func (p SwigcptrRTPBundleTransportConnection) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPBundleTransportConnection) SwigIsRTPBundleTransportConnection() {
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) SetTransport(arg2 DTLSICETransport) {
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) GetTransport() (_swig_ret DTLSICETransport) {
+	return SwigcptrDTLSICETransport(stubHandle())
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) SetDisableSTUNKeepAlive(arg2 bool) {
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) GetDisableSTUNKeepAlive() (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) SetIceRequestsSent(arg2 int64) {
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) GetIceRequestsSent() (_swig_ret int64) {
+	return *new(int64)
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) SetIceRequestsReceived(arg2 int64) {
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) GetIceRequestsReceived() (_swig_ret int64) {
+	return *new(int64)
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) SetIceResponsesSent(arg2 int64) {
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) GetIceResponsesSent() (_swig_ret int64) {
+	return *new(int64)
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) SetIceResponsesReceived(arg2 int64) {
+}
+func (arg1 SwigcptrRTPBundleTransportConnection) GetIceResponsesReceived() (_swig_ret int64) {
+	return *new(int64)
+}
+
+type RTPBundleTransportConnection interface {
+	Swigcptr() uintptr
+	SwigIsRTPBundleTransportConnection()
+	SetTransport(arg2 DTLSICETransport)
+	GetTransport() (_swig_ret DTLSICETransport)
+	SetDisableSTUNKeepAlive(arg2 bool)
+	GetDisableSTUNKeepAlive() (_swig_ret bool)
+	SetIceRequestsSent(arg2 int64)
+	GetIceRequestsSent() (_swig_ret int64)
+	SetIceRequestsReceived(arg2 int64)
+	GetIceRequestsReceived() (_swig_ret int64)
+	SetIceResponsesSent(arg2 int64)
+	GetIceResponsesSent() (_swig_ret int64)
+	SetIceResponsesReceived(arg2 int64)
+	GetIceResponsesReceived() (_swig_ret int64)
+}
+type SwigcptrRTPBundleTransport uintptr
+
+func (p SwigcptrRTPBundleTransport) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPBundleTransport) SwigIsRTPBundleTransport() {
+}
+func NewRTPBundleTransport() (_swig_ret RTPBundleTransport) {
+	return SwigcptrRTPBundleTransport(stubHandle())
+}
+func (arg1 SwigcptrRTPBundleTransport) Init__SWIG_0() (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPBundleTransport) Init__SWIG_1(arg2 int) (_swig_ret int) {
+	return *new(int)
+}
+func (p SwigcptrRTPBundleTransport) Init(a ...interface{}) int {
+	argc := len(a)
+	if argc == 0 {
+		return p.Init__SWIG_0()
+	}
+	if argc == 1 {
+		return p.Init__SWIG_1(a[0].(int))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrRTPBundleTransport) AddICETransport(arg2 string, arg3 Properties) (_swig_ret RTPBundleTransportConnection) {
+	return SwigcptrRTPBundleTransportConnection(stubHandle())
+}
+func (arg1 SwigcptrRTPBundleTransport) RemoveICETransport(arg2 string) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPBundleTransport) End() (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPBundleTransport) GetLocalPort() (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPBundleTransport) AddRemoteCandidate(arg2 string, arg3 string, arg4 uint16) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPBundleTransport) SetAffinity(arg2 int) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrRTPBundleTransport) SetIceTimeout(arg2 uint) {
+}
+func (arg1 SwigcptrRTPBundleTransport) GetTimeService() (_swig_ret TimeService) {
+	return SwigcptrTimeService(stubHandle())
+}
+func DeleteRTPBundleTransport(arg1 RTPBundleTransport) {
+}
+
+type RTPBundleTransport interface {
+	Swigcptr() uintptr
+	SwigIsRTPBundleTransport()
+	Init(a ...interface{}) int
+	AddICETransport(arg2 string, arg3 Properties) (_swig_ret RTPBundleTransportConnection)
+	RemoveICETransport(arg2 string) (_swig_ret int)
+	End() (_swig_ret int)
+	GetLocalPort() (_swig_ret int)
+	AddRemoteCandidate(arg2 string, arg3 string, arg4 uint16) (_swig_ret int)
+	SetAffinity(arg2 int) (_swig_ret bool)
+	SetIceTimeout(arg2 uint)
+	GetTimeService() (_swig_ret TimeService)
+}
+type _swig_DirectorDTLSICETransportListener struct {
+	SwigcptrDTLSICETransportListener
+	v interface{}
+}
+
+func (p *_swig_DirectorDTLSICETransportListener) Swigcptr() uintptr {
+	return p.SwigcptrDTLSICETransportListener.Swigcptr()
+}
+func (p *_swig_DirectorDTLSICETransportListener) SwigIsDTLSICETransportListener() {
+}
+func (p *_swig_DirectorDTLSICETransportListener) DirectorInterface() interface{} {
+	return p.v
+}
+func NewDirectorDTLSICETransportListener(v interface{}) DTLSICETransportListener {
+	return SwigcptrDTLSICETransportListener(stubHandle())
+}
+func DeleteDirectorDTLSICETransportListener(arg1 DTLSICETransportListener) {
+}
+
+type _swig_DirectorInterfaceDTLSICETransportListenerOnDTLSStateChange interface{ OnDTLSStateChange(uint) }
+
+func (swig_p *_swig_DirectorDTLSICETransportListener) OnDTLSStateChange(state uint) {
+}
+func DirectorDTLSICETransportListenerOnDTLSStateChange(p DTLSICETransportListener, arg2 uint) {
+}
+
+type SwigcptrDTLSICETransportListener uintptr
+
+func (p SwigcptrDTLSICETransportListener) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrDTLSICETransportListener) SwigIsDTLSICETransportListener() {
+}
+func (p SwigcptrDTLSICETransportListener) DirectorInterface() interface{} {
+	return nil
+}
+func NewDTLSICETransportListener() (_swig_ret DTLSICETransportListener) {
+	return SwigcptrDTLSICETransportListener(stubHandle())
+}
+func DeleteDTLSICETransportListener(arg1 DTLSICETransportListener) {
+}
+func (arg1 SwigcptrDTLSICETransportListener) OnDTLSStateChange(arg2 uint) {
+}
+
+type DTLSICETransportListener interface {
+	Swigcptr() uintptr
+	SwigIsDTLSICETransportListener()
+	DirectorInterface() interface{}
+	OnDTLSStateChange(arg2 uint)
+}
+type SwigcptrRemoteRateEstimatorListener uintptr
+
+func (p SwigcptrRemoteRateEstimatorListener) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRemoteRateEstimatorListener) SwigIsRemoteRateEstimatorListener() {
+}
+func DeleteRemoteRateEstimatorListener(arg1 RemoteRateEstimatorListener) {
+}
+
+type RemoteRateEstimatorListener interface {
+	Swigcptr() uintptr
+	SwigIsRemoteRateEstimatorListener()
+}
+type SwigcptrDTLSICETransport uintptr
+
+func (p SwigcptrDTLSICETransport) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrDTLSICETransport) SwigIsDTLSICETransport() {
+}
+func (arg1 SwigcptrDTLSICETransport) SetListener(arg2 DTLSICETransportListener) {
+}
+func (arg1 SwigcptrDTLSICETransport) Start() {
+}
+func (arg1 SwigcptrDTLSICETransport) Stop() {
+}
+func (arg1 SwigcptrDTLSICETransport) SetSRTPProtectionProfiles(arg2 string) {
+}
+func (arg1 SwigcptrDTLSICETransport) SetRemoteProperties(arg2 Properties) {
+}
+func (arg1 SwigcptrDTLSICETransport) SetLocalProperties(arg2 Properties) {
+}
+func (arg1 SwigcptrDTLSICETransport) SendPLI(arg2 uint) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Enqueue(arg2 RTPPacket_shared) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_0(arg2 string, arg3 bool, arg4 bool, arg5 bool, arg6 bool) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_1(arg2 string, arg3 bool, arg4 bool, arg5 bool) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_2(arg2 string, arg3 bool, arg4 bool) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_3(arg2 string, arg3 bool) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_4(arg2 string) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_5(arg2 UDPDumper, arg3 bool, arg4 bool, arg5 bool, arg6 bool) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_6(arg2 UDPDumper, arg3 bool, arg4 bool, arg5 bool) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_7(arg2 UDPDumper, arg3 bool, arg4 bool) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_8(arg2 UDPDumper, arg3 bool) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Dump__SWIG_9(arg2 UDPDumper) (_swig_ret int) {
+	return *new(int)
+}
+func (p SwigcptrDTLSICETransport) Dump(a ...interface{}) int {
+	argc := len(a)
+	if argc == 1 {
+		if _, ok := a[0].(SwigcptrUDPDumper); !ok {
+			goto check_1
+		}
+		return p.Dump__SWIG_9(a[0].(UDPDumper))
+	}
+check_1:
+	if argc == 1 {
+		return p.Dump__SWIG_4(a[0].(string))
+	}
+	if argc == 2 {
+		if _, ok := a[0].(SwigcptrUDPDumper); !ok {
+			goto check_3
+		}
+		return p.Dump__SWIG_8(a[0].(UDPDumper), a[1].(bool))
+	}
+check_3:
+	if argc == 2 {
+		return p.Dump__SWIG_3(a[0].(string), a[1].(bool))
+	}
+	if argc == 3 {
+		if _, ok := a[0].(SwigcptrUDPDumper); !ok {
+			goto check_5
+		}
+		return p.Dump__SWIG_7(a[0].(UDPDumper), a[1].(bool), a[2].(bool))
+	}
+check_5:
+	if argc == 3 {
+		return p.Dump__SWIG_2(a[0].(string), a[1].(bool), a[2].(bool))
+	}
+	if argc == 4 {
+		if _, ok := a[0].(SwigcptrUDPDumper); !ok {
+			goto check_7
+		}
+		return p.Dump__SWIG_6(a[0].(UDPDumper), a[1].(bool), a[2].(bool), a[3].(bool))
+	}
+check_7:
+	if argc == 4 {
+		return p.Dump__SWIG_1(a[0].(string), a[1].(bool), a[2].(bool), a[3].(bool))
+	}
+	if argc == 5 {
+		if _, ok := a[0].(SwigcptrUDPDumper); !ok {
+			goto check_9
+		}
+		return p.Dump__SWIG_5(a[0].(UDPDumper), a[1].(bool), a[2].(bool), a[3].(bool), a[4].(bool))
+	}
+check_9:
+	if argc == 5 {
+		return p.Dump__SWIG_0(a[0].(string), a[1].(bool), a[2].(bool), a[3].(bool), a[4].(bool))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrDTLSICETransport) DumpBWEStats(arg2 string) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) StopDump() (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) Reset() {
+}
+func (arg1 SwigcptrDTLSICETransport) ActivateRemoteCandidate(arg2 ICERemoteCandidate, arg3 bool, arg4 uint) {
+}
+func (arg1 SwigcptrDTLSICETransport) SetRemoteCryptoDTLS(arg2 string, arg3 string, arg4 string) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) SetLocalCryptoSDES(arg2 string, arg3 string) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) SetRemoteCryptoSDES(arg2 string, arg3 string) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) SetLocalSTUNCredentials(arg2 string, arg3 string) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) SetRemoteSTUNCredentials(arg2 string, arg3 string) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrDTLSICETransport) AddOutgoingSourceGroup(arg2 RTPOutgoingSourceGroup) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrDTLSICETransport) RemoveOutgoingSourceGroup(arg2 RTPOutgoingSourceGroup) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrDTLSICETransport) AddIncomingSourceGroup(arg2 RTPIncomingSourceGroup) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrDTLSICETransport) RemoveIncomingSourceGroup(arg2 RTPIncomingSourceGroup) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrDTLSICETransport) SetBandwidthProbing(arg2 bool) {
+}
+func (arg1 SwigcptrDTLSICETransport) SetMaxProbingBitrate(arg2 uint) {
+}
+func (arg1 SwigcptrDTLSICETransport) SetProbingBitrateLimit(arg2 uint) {
+}
+func (arg1 SwigcptrDTLSICETransport) SetSenderSideEstimatorListener(arg2 RemoteRateEstimatorListener) {
+}
+func (arg1 SwigcptrDTLSICETransport) GetRemoteUsername() (_swig_ret string) {
+	return *new(string)
+}
+func (arg1 SwigcptrDTLSICETransport) GetRemotePwd() (_swig_ret string) {
+	return *new(string)
+}
+func (arg1 SwigcptrDTLSICETransport) GetLocalUsername() (_swig_ret string) {
+	return *new(string)
+}
+func (arg1 SwigcptrDTLSICETransport) GetLocalPwd() (_swig_ret string) {
+	return *new(string)
+}
+func (arg1 SwigcptrDTLSICETransport) GetRTT() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrDTLSICETransport) GetLastActiveTime() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrDTLSICETransport) GetTimeService() (_swig_ret TimeService) {
+	return SwigcptrTimeService(stubHandle())
+}
+func DeleteDTLSICETransport(arg1 DTLSICETransport) {
+}
+
+type DTLSICETransport interface {
+	Swigcptr() uintptr
+	SwigIsDTLSICETransport()
+	SetListener(arg2 DTLSICETransportListener)
+	Start()
+	Stop()
+	SetSRTPProtectionProfiles(arg2 string)
+	SetRemoteProperties(arg2 Properties)
+	SetLocalProperties(arg2 Properties)
+	SendPLI(arg2 uint) (_swig_ret int)
+	Enqueue(arg2 RTPPacket_shared) (_swig_ret int)
+	Dump(a ...interface{}) int
+	DumpBWEStats(arg2 string) (_swig_ret int)
+	StopDump() (_swig_ret int)
+	Reset()
+	ActivateRemoteCandidate(arg2 ICERemoteCandidate, arg3 bool, arg4 uint)
+	SetRemoteCryptoDTLS(arg2 string, arg3 string, arg4 string) (_swig_ret int)
+	SetLocalCryptoSDES(arg2 string, arg3 string) (_swig_ret int)
+	SetRemoteCryptoSDES(arg2 string, arg3 string) (_swig_ret int)
+	SetLocalSTUNCredentials(arg2 string, arg3 string) (_swig_ret int)
+	SetRemoteSTUNCredentials(arg2 string, arg3 string) (_swig_ret int)
+	AddOutgoingSourceGroup(arg2 RTPOutgoingSourceGroup) (_swig_ret bool)
+	RemoveOutgoingSourceGroup(arg2 RTPOutgoingSourceGroup) (_swig_ret bool)
+	AddIncomingSourceGroup(arg2 RTPIncomingSourceGroup) (_swig_ret bool)
+	RemoveIncomingSourceGroup(arg2 RTPIncomingSourceGroup) (_swig_ret bool)
+	SetBandwidthProbing(arg2 bool)
+	SetMaxProbingBitrate(arg2 uint)
+	SetProbingBitrateLimit(arg2 uint)
+	SetSenderSideEstimatorListener(arg2 RemoteRateEstimatorListener)
+	GetRemoteUsername() (_swig_ret string)
+	GetRemotePwd() (_swig_ret string)
+	GetLocalUsername() (_swig_ret string)
+	GetLocalPwd() (_swig_ret string)
+	GetRTT() (_swig_ret uint)
+	GetLastActiveTime() (_swig_ret uint64)
+	GetTimeService() (_swig_ret TimeService)
+}
+type SwigcptrRTPSessionFacade uintptr
+
+func (p SwigcptrRTPSessionFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPSessionFacade) SwigIsRTPSessionFacade() {
+}
+func NewRTPSessionFacade(arg1 MediaFrameType) (_swig_ret RTPSessionFacade) {
+	return SwigcptrRTPSessionFacade(stubHandle())
+}
+func (arg1 SwigcptrRTPSessionFacade) Init(arg2 Properties) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPSessionFacade) SetLocalPort(arg2 int) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPSessionFacade) GetLocalPort() (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPSessionFacade) SetRemotePort(arg2 string, arg3 int) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPSessionFacade) GetOutgoingSourceGroup() (_swig_ret RTPOutgoingSourceGroup) {
+	return SwigcptrRTPOutgoingSourceGroup(stubHandle())
+}
+func (arg1 SwigcptrRTPSessionFacade) GetIncomingSourceGroup() (_swig_ret RTPIncomingSourceGroup) {
+	return SwigcptrRTPIncomingSourceGroup(stubHandle())
+}
+func (arg1 SwigcptrRTPSessionFacade) End() (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPSessionFacade) Enqueue(arg2 RTPPacket_shared) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrRTPSessionFacade) SendPLI(arg2 uint) (_swig_ret int) {
+	return *new(int)
+}
+func DeleteRTPSessionFacade(arg1 RTPSessionFacade) {
+}
+func (p SwigcptrRTPSessionFacade) SwigIsRTPSender() {
+}
+func (p SwigcptrRTPSessionFacade) SwigGetRTPSender() RTPSender {
+	return SwigcptrRTPSender(p.Swigcptr())
+}
+func (arg1 SwigcptrRTPSessionFacade) SwigGetRTPReceiver() (_swig_ret RTPReceiver) {
+	return SwigcptrRTPReceiver(stubHandle())
+}
+
+type RTPSessionFacade interface {
+	Swigcptr() uintptr
+	SwigIsRTPSessionFacade()
+	Init(arg2 Properties) (_swig_ret int)
+	SetLocalPort(arg2 int) (_swig_ret int)
+	GetLocalPort() (_swig_ret int)
+	SetRemotePort(arg2 string, arg3 int) (_swig_ret int)
+	GetOutgoingSourceGroup() (_swig_ret RTPOutgoingSourceGroup)
+	GetIncomingSourceGroup() (_swig_ret RTPIncomingSourceGroup)
+	End() (_swig_ret int)
+	Enqueue(arg2 RTPPacket_shared) (_swig_ret int)
+	SendPLI(arg2 uint) (_swig_ret int)
+	SwigIsRTPSender()
+	SwigGetRTPSender() RTPSender
+	SwigGetRTPReceiver() (_swig_ret RTPReceiver)
+}
+type SwigcptrRTPSenderFacade uintptr
+
+func (p SwigcptrRTPSenderFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPSenderFacade) SwigIsRTPSenderFacade() {
+}
+func NewRTPSenderFacade__SWIG_0(arg1 DTLSICETransport) (_swig_ret RTPSenderFacade) {
+	return SwigcptrRTPSenderFacade(stubHandle())
+}
+func NewRTPSenderFacade__SWIG_1(arg1 RTPSessionFacade) (_swig_ret RTPSenderFacade) {
+	return SwigcptrRTPSenderFacade(stubHandle())
+}
+func NewRTPSenderFacade(a ...interface{}) RTPSenderFacade {
+	argc := len(a)
+	if argc == 1 {
+		if _, ok := a[0].(DTLSICETransport); !ok {
+			goto check_1
+		}
+		return NewRTPSenderFacade__SWIG_0(a[0].(DTLSICETransport))
+	}
+check_1:
+	if argc == 1 {
+		return NewRTPSenderFacade__SWIG_1(a[0].(RTPSessionFacade))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrRTPSenderFacade) Get() (_swig_ret RTPSender) {
+	return SwigcptrRTPSender(stubHandle())
+}
+func DeleteRTPSenderFacade(arg1 RTPSenderFacade) {
+}
+
+type RTPSenderFacade interface {
+	Swigcptr() uintptr
+	SwigIsRTPSenderFacade()
+	Get() (_swig_ret RTPSender)
+}
+type SwigcptrRTPReceiverFacade uintptr
+
+func (p SwigcptrRTPReceiverFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPReceiverFacade) SwigIsRTPReceiverFacade() {
+}
+func NewRTPReceiverFacade__SWIG_0(arg1 DTLSICETransport) (_swig_ret RTPReceiverFacade) {
+	return SwigcptrRTPReceiverFacade(stubHandle())
+}
+func NewRTPReceiverFacade__SWIG_1(arg1 RTPSessionFacade) (_swig_ret RTPReceiverFacade) {
+	return SwigcptrRTPReceiverFacade(stubHandle())
+}
+func NewRTPReceiverFacade(a ...interface{}) RTPReceiverFacade {
+	argc := len(a)
+	if argc == 1 {
+		if _, ok := a[0].(DTLSICETransport); !ok {
+			goto check_1
+		}
+		return NewRTPReceiverFacade__SWIG_0(a[0].(DTLSICETransport))
+	}
+check_1:
+	if argc == 1 {
+		return NewRTPReceiverFacade__SWIG_1(a[0].(RTPSessionFacade))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrRTPReceiverFacade) Get() (_swig_ret RTPReceiver) {
+	return SwigcptrRTPReceiver(stubHandle())
+}
+func (arg1 SwigcptrRTPReceiverFacade) SendPLI(arg2 uint) (_swig_ret int) {
+	return *new(int)
+}
+func DeleteRTPReceiverFacade(arg1 RTPReceiverFacade) {
+}
+
+type RTPReceiverFacade interface {
+	Swigcptr() uintptr
+	SwigIsRTPReceiverFacade()
+	Get() (_swig_ret RTPReceiver)
+	SendPLI(arg2 uint) (_swig_ret int)
+}
+
+func TransportToSender(arg1 DTLSICETransport) (_swig_ret RTPSenderFacade) {
+	return SwigcptrRTPSenderFacade(stubHandle())
+}
+func TransportToReceiver(arg1 DTLSICETransport) (_swig_ret RTPReceiverFacade) {
+	return SwigcptrRTPReceiverFacade(stubHandle())
+}
+func SessionToSender(arg1 RTPSessionFacade) (_swig_ret RTPSenderFacade) {
+	return SwigcptrRTPSenderFacade(stubHandle())
+}
+func SessionToReceiver(arg1 RTPSessionFacade) (_swig_ret RTPReceiverFacade) {
+	return SwigcptrRTPReceiverFacade(stubHandle())
+}
+func RTPSessionToReceiver(arg1 MediaFrameSessionFacade) (_swig_ret RTPReceiverFacade) {
+	return SwigcptrRTPReceiverFacade(stubHandle())
+}
+func MediaFrameGetLength(arg1 MediaFrame) (_swig_ret uint) {
+	return *new(uint)
+}
+func MediaFrameGetTimestamp(arg1 MediaFrame) (_swig_ret uint64) {
+	return *new(uint64)
+}
+func MediaFrameGetClockRate(arg1 MediaFrame) (_swig_ret uint) {
+	return *new(uint)
+}
+func MediaFrameIsIntra(arg1 MediaFrame) (_swig_ret bool) {
+	return *new(bool)
+}
+func MediaFrameCopyData(arg1 MediaFrame, arg2 *byte, arg3 int) {
+}
+func TransportSendSenderReport(arg1 DTLSICETransport, arg2 RTPOutgoingSourceGroup) (_swig_ret bool) {
+	return *new(bool)
+}
+func TransportSendRTCPApp(arg1 DTLSICETransport, arg2 uint, arg3 int, arg4 string, arg5 *byte, arg6 int) (_swig_ret bool) {
+	return *new(bool)
+}
+func TransportSendREMB(arg1 DTLSICETransport, arg2 uint, arg3 uint, arg4 *byte, arg5 int) (_swig_ret bool) {
+	return *new(bool)
+}
+func TransportSendBYE(arg1 DTLSICETransport, arg2 *byte, arg3 int) (_swig_ret bool) {
+	return *new(bool)
+}
+
+type SwigcptrTimeServiceProbe uintptr
+
+func (p SwigcptrTimeServiceProbe) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrTimeServiceProbe) SwigIsTimeServiceProbe() {
+}
+func NewTimeServiceProbe(arg1 TimeService) (_swig_ret TimeServiceProbe) {
+	return SwigcptrTimeServiceProbe(stubHandle())
+}
+func (arg1 SwigcptrTimeServiceProbe) Ping() {
+}
+func (arg1 SwigcptrTimeServiceProbe) GetPendingTime() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func DeleteTimeServiceProbe(arg1 TimeServiceProbe) {
+}
+
+type TimeServiceProbe interface {
+	Swigcptr() uintptr
+	SwigIsTimeServiceProbe()
+	Ping()
+	GetPendingTime() (_swig_ret uint64)
+}
+type SwigcptrSimulcastFrameSelector uintptr
+
+func (p SwigcptrSimulcastFrameSelector) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrSimulcastFrameSelector) SwigIsSimulcastFrameSelector() {
+}
+func NewSimulcastFrameSelector(arg1 MediaFrameListener) (_swig_ret SimulcastFrameSelector) {
+	return SwigcptrSimulcastFrameSelector(stubHandle())
+}
+func (arg1 SwigcptrSimulcastFrameSelector) Select(arg2 uint) {
+}
+func (arg1 SwigcptrSimulcastFrameSelector) GetSelected() (_swig_ret uint) {
+	return *new(uint)
+}
+func DeleteSimulcastFrameSelector(arg1 SimulcastFrameSelector) {
+}
+func (p SwigcptrSimulcastFrameSelector) SwigIsMediaFrameListener() {
+}
+func (p SwigcptrSimulcastFrameSelector) SwigGetMediaFrameListener() MediaFrameListener {
+	return SwigcptrMediaFrameListener(p.Swigcptr())
+}
+
+type SimulcastFrameSelector interface {
+	Swigcptr() uintptr
+	SwigIsSimulcastFrameSelector()
+	Select(arg2 uint)
+	GetSelected() (_swig_ret uint)
+	SwigIsMediaFrameListener()
+	SwigGetMediaFrameListener() MediaFrameListener
+}
+type SwigcptrRTPSenderTee uintptr
+
+func (p SwigcptrRTPSenderTee) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPSenderTee) SwigIsRTPSenderTee() {
+}
+func NewRTPSenderTee(arg1 RTPSenderFacade, arg2 MediaFrameListener) (_swig_ret RTPSenderTee) {
+	return SwigcptrRTPSenderTee(stubHandle())
+}
+func (arg1 SwigcptrRTPSenderTee) GetSender() (_swig_ret RTPSenderFacade) {
+	return SwigcptrRTPSenderFacade(stubHandle())
+}
+func (arg1 SwigcptrRTPSenderTee) Stop() {
+}
+func DeleteRTPSenderTee(arg1 RTPSenderTee) {
+}
+
+type RTPSenderTee interface {
+	Swigcptr() uintptr
+	SwigIsRTPSenderTee()
+	GetSender() (_swig_ret RTPSenderFacade)
+	Stop()
+}
+type SwigcptrRTPPacer uintptr
+
+func (p SwigcptrRTPPacer) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPPacer) SwigIsRTPPacer() {
+}
+func NewRTPPacer(arg1 RTPSenderFacade, arg2 TimeService) (_swig_ret RTPPacer) {
+	return SwigcptrRTPPacer(stubHandle())
+}
+func (arg1 SwigcptrRTPPacer) Configure(arg2 uint, arg3 uint, arg4 uint) {
+}
+func (arg1 SwigcptrRTPPacer) GetSender() (_swig_ret RTPSenderFacade) {
+	return SwigcptrRTPSenderFacade(stubHandle())
+}
+func (arg1 SwigcptrRTPPacer) GetQueued() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPPacer) GetSent() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrRTPPacer) GetDropped() (_swig_ret uint64) {
+	return *new(uint64)
+}
+func (arg1 SwigcptrRTPPacer) Stop() {
+}
+func DeleteRTPPacer(arg1 RTPPacer) {
+}
+
+type RTPPacer interface {
+	Swigcptr() uintptr
+	SwigIsRTPPacer()
+	Configure(arg2 uint, arg3 uint, arg4 uint)
+	GetSender() (_swig_ret RTPSenderFacade)
+	GetQueued() (_swig_ret uint)
+	GetSent() (_swig_ret uint64)
+	GetDropped() (_swig_ret uint64)
+	Stop()
+}
+type SwigcptrRTPDumpWriter uintptr
+
+func (p SwigcptrRTPDumpWriter) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPDumpWriter) SwigIsRTPDumpWriter() {
+}
+func NewRTPDumpWriter() (_swig_ret RTPDumpWriter) {
+	return SwigcptrRTPDumpWriter(stubHandle())
+}
+func (arg1 SwigcptrRTPDumpWriter) Open(arg2 string) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrRTPDumpWriter) AddIncoming(arg2 RTPIncomingMediaStream) {
+}
+func (arg1 SwigcptrRTPDumpWriter) Close() {
+}
+func DeleteRTPDumpWriter(arg1 RTPDumpWriter) {
+}
+
+type RTPDumpWriter interface {
+	Swigcptr() uintptr
+	SwigIsRTPDumpWriter()
+	Open(arg2 string) (_swig_ret bool)
+	AddIncoming(arg2 RTPIncomingMediaStream)
+	Close()
+}
+type SwigcptrAudioLevelMeter uintptr
+
+func (p SwigcptrAudioLevelMeter) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrAudioLevelMeter) SwigIsAudioLevelMeter() {
+}
+func NewAudioLevelMeter(arg1 RTPIncomingMediaStream) (_swig_ret AudioLevelMeter) {
+	return SwigcptrAudioLevelMeter(stubHandle())
+}
+func (arg1 SwigcptrAudioLevelMeter) ReadLevel() (_swig_ret byte) {
+	return *new(byte)
+}
+func (arg1 SwigcptrAudioLevelMeter) Stop() {
+}
+func DeleteAudioLevelMeter(arg1 AudioLevelMeter) {
+}
+
+type AudioLevelMeter interface {
+	Swigcptr() uintptr
+	SwigIsAudioLevelMeter()
+	ReadLevel() (_swig_ret byte)
+	Stop()
+}
+type SwigcptrPayloadCodecMonitor uintptr
+
+func (p SwigcptrPayloadCodecMonitor) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrPayloadCodecMonitor) SwigIsPayloadCodecMonitor() {
+}
+func NewPayloadCodecMonitor(arg1 RTPIncomingMediaStream) (_swig_ret PayloadCodecMonitor) {
+	return SwigcptrPayloadCodecMonitor(stubHandle())
+}
+func (arg1 SwigcptrPayloadCodecMonitor) GetCodec() (_swig_ret string) {
+	return *new(string)
+}
+func (arg1 SwigcptrPayloadCodecMonitor) Stop() {
+}
+func DeletePayloadCodecMonitor(arg1 PayloadCodecMonitor) {
+}
+
+type PayloadCodecMonitor interface {
+	Swigcptr() uintptr
+	SwigIsPayloadCodecMonitor()
+	GetCodec() (_swig_ret string)
+	Stop()
+}
+type SwigcptrRTPStreamTransponderFacade uintptr
+
+func (p SwigcptrRTPStreamTransponderFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrRTPStreamTransponderFacade) SwigIsRTPStreamTransponderFacade() {
+}
+func NewRTPStreamTransponderFacade(arg1 RTPOutgoingSourceGroup, arg2 RTPSenderFacade) (_swig_ret RTPStreamTransponderFacade) {
+	return SwigcptrRTPStreamTransponderFacade(stubHandle())
+}
+func (arg1 SwigcptrRTPStreamTransponderFacade) SetIncoming__SWIG_0(arg2 RTPIncomingMediaStream, arg3 RTPReceiverFacade) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrRTPStreamTransponderFacade) SetIncoming__SWIG_1(arg2 RTPIncomingMediaStream, arg3 RTPReceiver) (_swig_ret bool) {
+	return *new(bool)
+}
+func (p SwigcptrRTPStreamTransponderFacade) SetIncoming(a ...interface{}) bool {
+	argc := len(a)
+	if argc == 2 {
+		if _, ok := a[1].(RTPReceiverFacade); !ok {
+			goto check_1
+		}
+		return p.SetIncoming__SWIG_0(a[0].(RTPIncomingMediaStream), a[1].(RTPReceiverFacade))
+	}
+check_1:
+	if argc == 2 {
+		return p.SetIncoming__SWIG_1(a[0].(RTPIncomingMediaStream), a[1].(RTPReceiver))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrRTPStreamTransponderFacade) SelectLayer(arg2 int, arg3 int) {
+}
+func (arg1 SwigcptrRTPStreamTransponderFacade) Mute(arg2 bool) {
+}
+func (arg1 SwigcptrRTPStreamTransponderFacade) Close() {
+}
+func (arg1 SwigcptrRTPStreamTransponderFacade) GetTotalPLIRequests() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPStreamTransponderFacade) GetTotalREMBs() (_swig_ret uint) {
+	return *new(uint)
+}
+func (arg1 SwigcptrRTPStreamTransponderFacade) GetLastREMB() (_swig_ret uint) {
+	return *new(uint)
+}
+func DeleteRTPStreamTransponderFacade(arg1 RTPStreamTransponderFacade) {
+}
+
+type RTPStreamTransponderFacade interface {
+	Swigcptr() uintptr
+	SwigIsRTPStreamTransponderFacade()
+	SetIncoming(a ...interface{}) bool
+	SelectLayer(arg2 int, arg3 int)
+	Mute(arg2 bool)
+	Close()
+	GetTotalPLIRequests() (_swig_ret uint)
+	GetTotalREMBs() (_swig_ret uint)
+	GetLastREMB() (_swig_ret uint)
+}
+type SwigcptrMediaFrameListener uintptr
+
+func (p SwigcptrMediaFrameListener) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrMediaFrameListener) SwigIsMediaFrameListener() {
+}
+
+type MediaFrameListener interface {
+	Swigcptr() uintptr
+	SwigIsMediaFrameListener()
+}
+type SwigcptrKeyframeCache uintptr
+
+func (p SwigcptrKeyframeCache) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrKeyframeCache) SwigIsKeyframeCache() {
+}
+func NewKeyframeCache(arg1 RTPIncomingMediaStream) (_swig_ret KeyframeCache) {
+	return SwigcptrKeyframeCache(stubHandle())
+}
+func (arg1 SwigcptrKeyframeCache) Replay(arg2 RTPStreamTransponderFacade, arg3 uint) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrKeyframeCache) Stop() {
+}
+func DeleteKeyframeCache(arg1 KeyframeCache) {
+}
+
+type KeyframeCache interface {
+	Swigcptr() uintptr
+	SwigIsKeyframeCache()
+	Replay(arg2 RTPStreamTransponderFacade, arg3 uint) (_swig_ret bool)
+	Stop()
+}
+type SwigcptrStreamTrackDepacketizer uintptr
+
+func (p SwigcptrStreamTrackDepacketizer) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrStreamTrackDepacketizer) SwigIsStreamTrackDepacketizer() {
+}
+func NewStreamTrackDepacketizer(arg1 RTPIncomingMediaStream) (_swig_ret StreamTrackDepacketizer) {
+	return SwigcptrStreamTrackDepacketizer(stubHandle())
+}
+func (arg1 SwigcptrStreamTrackDepacketizer) AddMediaListener(arg2 MediaFrameListener) {
+}
+func (arg1 SwigcptrStreamTrackDepacketizer) RemoveMediaListener(arg2 MediaFrameListener) {
+}
+func (arg1 SwigcptrStreamTrackDepacketizer) Stop() {
+}
+func DeleteStreamTrackDepacketizer(arg1 StreamTrackDepacketizer) {
+}
+
+type StreamTrackDepacketizer interface {
+	Swigcptr() uintptr
+	SwigIsStreamTrackDepacketizer()
+	AddMediaListener(arg2 MediaFrameListener)
+	RemoveMediaListener(arg2 MediaFrameListener)
+	Stop()
+}
+type SwigcptrMP4RecorderFacade uintptr
+
+func (p SwigcptrMP4RecorderFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrMP4RecorderFacade) SwigIsMP4RecorderFacade() {
+}
+func NewMP4RecorderFacade() (_swig_ret MP4RecorderFacade) {
+	return SwigcptrMP4RecorderFacade(stubHandle())
+}
+func (arg1 SwigcptrMP4RecorderFacade) Create(arg2 string) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrMP4RecorderFacade) Record__SWIG_0() (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrMP4RecorderFacade) Record__SWIG_1(arg2 bool) (_swig_ret bool) {
+	return *new(bool)
+}
+func (p SwigcptrMP4RecorderFacade) Record(a ...interface{}) bool {
+	argc := len(a)
+	if argc == 0 {
+		return p.Record__SWIG_0()
+	}
+	if argc == 1 {
+		return p.Record__SWIG_1(a[0].(bool))
+	}
+	panic("No match for overloaded function call")
+}
+func (arg1 SwigcptrMP4RecorderFacade) Stop() (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrMP4RecorderFacade) Close__SWIG_0() (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrMP4RecorderFacade) SetTimeShiftDuration(arg2 uint) {
+}
+func (arg1 SwigcptrMP4RecorderFacade) Close__SWIG_1(arg2 bool) (_swig_ret bool) {
+	return *new(bool)
+}
+func (arg1 SwigcptrMP4RecorderFacade) GetWriteErrors() (_swig_ret uint) {
+	return *new(uint)
+}
+func (p SwigcptrMP4RecorderFacade) Close(a ...interface{}) bool {
+	argc := len(a)
+	if argc == 0 {
+		return p.Close__SWIG_0()
+	}
+	if argc == 1 {
+		return p.Close__SWIG_1(a[0].(bool))
+	}
+	panic("No match for overloaded function call")
+}
+func DeleteMP4RecorderFacade(arg1 MP4RecorderFacade) {
+}
+func (p SwigcptrMP4RecorderFacade) SwigIsMediaFrameListener() {
+}
+func (p SwigcptrMP4RecorderFacade) SwigGetMediaFrameListener() MediaFrameListener {
+	return SwigcptrMediaFrameListener(p.Swigcptr())
+}
+
+type MP4RecorderFacade interface {
+	Swigcptr() uintptr
+	SwigIsMP4RecorderFacade()
+	Create(arg2 string) (_swig_ret bool)
+	Record(a ...interface{}) bool
+	Stop() (_swig_ret bool)
+	SetTimeShiftDuration(arg2 uint)
+	Close(a ...interface{}) bool
+	GetWriteErrors() (_swig_ret uint)
+	SwigIsMediaFrameListener()
+	SwigGetMediaFrameListener() MediaFrameListener
+}
+type SwigcptrMediaFrameSessionFacade uintptr
+
+func (p SwigcptrMediaFrameSessionFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrMediaFrameSessionFacade) SwigIsMediaFrameSessionFacade() {
+}
+func NewMediaFrameSessionFacade(arg1 MediaFrameType) (_swig_ret MediaFrameSessionFacade) {
+	return SwigcptrMediaFrameSessionFacade(stubHandle())
+}
+func (arg1 SwigcptrMediaFrameSessionFacade) Init(arg2 Properties) (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrMediaFrameSessionFacade) OnRTPPacket(arg2 *byte, arg3 int) {
+}
+func (arg1 SwigcptrMediaFrameSessionFacade) OnRTPData(arg2 *byte, arg3 int, arg4 byte) {
+}
+func (arg1 SwigcptrMediaFrameSessionFacade) GetIncomingSourceGroup() (_swig_ret RTPIncomingSourceGroup) {
+	return SwigcptrRTPIncomingSourceGroup(stubHandle())
+}
+func (arg1 SwigcptrMediaFrameSessionFacade) End() (_swig_ret int) {
+	return *new(int)
+}
+func (arg1 SwigcptrMediaFrameSessionFacade) SendPLI(arg2 uint) (_swig_ret int) {
+	return *new(int)
+}
+func DeleteMediaFrameSessionFacade(arg1 MediaFrameSessionFacade) {
+}
+func (p SwigcptrMediaFrameSessionFacade) SwigIsRTPReceiver() {
+}
+func (p SwigcptrMediaFrameSessionFacade) SwigGetRTPReceiver() RTPReceiver {
+	return SwigcptrRTPReceiver(p.Swigcptr())
+}
+
+type MediaFrameSessionFacade interface {
+	Swigcptr() uintptr
+	SwigIsMediaFrameSessionFacade()
+	Init(arg2 Properties) (_swig_ret int)
+	OnRTPPacket(arg2 *byte, arg3 int)
+	OnRTPData(arg2 *byte, arg3 int, arg4 byte)
+	GetIncomingSourceGroup() (_swig_ret RTPIncomingSourceGroup)
+	End() (_swig_ret int)
+	SendPLI(arg2 uint) (_swig_ret int)
+	SwigIsRTPReceiver()
+	SwigGetRTPReceiver() RTPReceiver
+}
+type _swig_DirectorSenderSideEstimatorListener struct {
+	SwigcptrSenderSideEstimatorListener
+	v interface{}
+}
+
+func (p *_swig_DirectorSenderSideEstimatorListener) Swigcptr() uintptr {
+	return p.SwigcptrSenderSideEstimatorListener.Swigcptr()
+}
+func (p *_swig_DirectorSenderSideEstimatorListener) SwigIsSenderSideEstimatorListener() {
+}
+func (p *_swig_DirectorSenderSideEstimatorListener) DirectorInterface() interface{} {
+	return p.v
+}
+func NewDirectorSenderSideEstimatorListener(v interface{}) SenderSideEstimatorListener {
+	return SwigcptrSenderSideEstimatorListener(stubHandle())
+}
+func DeleteDirectorSenderSideEstimatorListener(arg1 SenderSideEstimatorListener) {
+}
+
+type _swig_DirectorInterfaceSenderSideEstimatorListenerOnTargetBitrateRequested interface{ OnTargetBitrateRequested(uint) }
+
+func (swig_p *_swig_DirectorSenderSideEstimatorListener) OnTargetBitrateRequested(bitrate uint) {
+}
+func DirectorSenderSideEstimatorListenerOnTargetBitrateRequested(p SenderSideEstimatorListener, arg2 uint) {
+}
+
+type SwigcptrSenderSideEstimatorListener uintptr
+
+func (p SwigcptrSenderSideEstimatorListener) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrSenderSideEstimatorListener) SwigIsSenderSideEstimatorListener() {
+}
+func (p SwigcptrSenderSideEstimatorListener) DirectorInterface() interface{} {
+	return nil
+}
+func NewSenderSideEstimatorListener() (_swig_ret SenderSideEstimatorListener) {
+	return SwigcptrSenderSideEstimatorListener(stubHandle())
+}
+func DeleteSenderSideEstimatorListener(arg1 SenderSideEstimatorListener) {
+}
+func (arg1 SwigcptrSenderSideEstimatorListener) OnTargetBitrateRequested(arg2 uint) {
+}
+func (p SwigcptrSenderSideEstimatorListener) SwigIsRemoteRateEstimatorListener() {
+}
+func (p SwigcptrSenderSideEstimatorListener) SwigGetRemoteRateEstimatorListener() RemoteRateEstimatorListener {
+	return SwigcptrRemoteRateEstimatorListener(p.Swigcptr())
+}
+
+type SenderSideEstimatorListener interface {
+	Swigcptr() uintptr
+	SwigIsSenderSideEstimatorListener()
+	DirectorInterface() interface{}
+	OnTargetBitrateRequested(arg2 uint)
+	SwigIsRemoteRateEstimatorListener()
+	SwigGetRemoteRateEstimatorListener() RemoteRateEstimatorListener
+}
+type SwigcptrActiveSpeakerDetectorFacade uintptr
+
+func (p SwigcptrActiveSpeakerDetectorFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrActiveSpeakerDetectorFacade) SwigIsActiveSpeakerDetectorFacade() {
+}
+func NewActiveSpeakerDetectorFacade(arg1 ActiveTrackListener) (_swig_ret ActiveSpeakerDetectorFacade) {
+	return SwigcptrActiveSpeakerDetectorFacade(stubHandle())
+}
+func (arg1 SwigcptrActiveSpeakerDetectorFacade) SetMinChangePeriod(arg2 uint) {
+}
+func (arg1 SwigcptrActiveSpeakerDetectorFacade) SetMaxAccumulatedScore(arg2 uint64) {
+}
+func (arg1 SwigcptrActiveSpeakerDetectorFacade) SetNoiseGatingThreshold(arg2 byte) {
+}
+func (arg1 SwigcptrActiveSpeakerDetectorFacade) SetMinActivationScore(arg2 uint) {
+}
+func (arg1 SwigcptrActiveSpeakerDetectorFacade) AddIncomingSourceGroup(arg2 RTPIncomingMediaStream, arg3 uint) {
+}
+func (arg1 SwigcptrActiveSpeakerDetectorFacade) RemoveIncomingSourceGroup(arg2 RTPIncomingMediaStream) {
+}
+func DeleteActiveSpeakerDetectorFacade(arg1 ActiveSpeakerDetectorFacade) {
+}
+
+type ActiveSpeakerDetectorFacade interface {
+	Swigcptr() uintptr
+	SwigIsActiveSpeakerDetectorFacade()
+	SetMinChangePeriod(arg2 uint)
+	SetMaxAccumulatedScore(arg2 uint64)
+	SetNoiseGatingThreshold(arg2 byte)
+	SetMinActivationScore(arg2 uint)
+	AddIncomingSourceGroup(arg2 RTPIncomingMediaStream, arg3 uint)
+	RemoveIncomingSourceGroup(arg2 RTPIncomingMediaStream)
+}
+type _swig_DirectorMediaFrameListenerFacade struct {
+	SwigcptrMediaFrameListenerFacade
+	v interface{}
+}
+
+func (p *_swig_DirectorMediaFrameListenerFacade) Swigcptr() uintptr {
+	return p.SwigcptrMediaFrameListenerFacade.Swigcptr()
+}
+func (p *_swig_DirectorMediaFrameListenerFacade) SwigIsMediaFrameListenerFacade() {
+}
+func (p *_swig_DirectorMediaFrameListenerFacade) DirectorInterface() interface{} {
+	return p.v
+}
+func NewDirectorMediaFrameListenerFacade(v interface{}) MediaFrameListenerFacade {
+	return SwigcptrMediaFrameListenerFacade(stubHandle())
+}
+func DeleteDirectorMediaFrameListenerFacade(arg1 MediaFrameListenerFacade) {
+}
+
+type _swig_DirectorInterfaceMediaFrameListenerFacadeOnMediaFrame interface{ OnMediaFrame(MediaFrame) }
+
+func (swig_p *_swig_DirectorMediaFrameListenerFacade) OnMediaFrame(frame MediaFrame) {
+}
+func DirectorMediaFrameListenerFacadeOnMediaFrame(p MediaFrameListenerFacade, arg2 MediaFrame) {
+}
+
+type SwigcptrMediaFrameListenerFacade uintptr
+
+func (p SwigcptrMediaFrameListenerFacade) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrMediaFrameListenerFacade) SwigIsMediaFrameListenerFacade() {
+}
+func (p SwigcptrMediaFrameListenerFacade) DirectorInterface() interface{} {
+	return nil
+}
+func NewMediaFrameListenerFacade() (_swig_ret MediaFrameListenerFacade) {
+	return SwigcptrMediaFrameListenerFacade(stubHandle())
+}
+func DeleteMediaFrameListenerFacade(arg1 MediaFrameListenerFacade) {
+}
+func (arg1 SwigcptrMediaFrameListenerFacade) OnMediaFrame(arg2 MediaFrame) {
+}
+
+type MediaFrameListenerFacade interface {
+	Swigcptr() uintptr
+	SwigIsMediaFrameListenerFacade()
+	DirectorInterface() interface{}
+	OnMediaFrame(arg2 MediaFrame)
+}
+type SwigcptrMediaFrameMultiplexer uintptr
+
+func (p SwigcptrMediaFrameMultiplexer) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrMediaFrameMultiplexer) SwigIsMediaFrameMultiplexer() {
+}
+func NewMediaFrameMultiplexer(arg1 RTPIncomingMediaStream) (_swig_ret MediaFrameMultiplexer) {
+	return SwigcptrMediaFrameMultiplexer(stubHandle())
+}
+func (arg1 SwigcptrMediaFrameMultiplexer) AddMediaListener(arg2 MediaFrameListenerFacade) {
+}
+func (arg1 SwigcptrMediaFrameMultiplexer) RemoveMediaListener(arg2 MediaFrameListenerFacade) {
+}
+func (arg1 SwigcptrMediaFrameMultiplexer) Stop() {
+}
+func DeleteMediaFrameMultiplexer(arg1 MediaFrameMultiplexer) {
+}
+
+type MediaFrameMultiplexer interface {
+	Swigcptr() uintptr
+	SwigIsMediaFrameMultiplexer()
+	AddMediaListener(arg2 MediaFrameListenerFacade)
+	RemoveMediaListener(arg2 MediaFrameListenerFacade)
+	Stop()
+}
+type _swig_DirectorActiveTrackListener struct {
+	SwigcptrActiveTrackListener
+	v interface{}
+}
+
+func (p *_swig_DirectorActiveTrackListener) Swigcptr() uintptr {
+	return p.SwigcptrActiveTrackListener.Swigcptr()
+}
+func (p *_swig_DirectorActiveTrackListener) SwigIsActiveTrackListener() {
+}
+func (p *_swig_DirectorActiveTrackListener) DirectorInterface() interface{} {
+	return p.v
+}
+func NewDirectorActiveTrackListener(v interface{}) ActiveTrackListener {
+	return SwigcptrActiveTrackListener(stubHandle())
+}
+func DeleteDirectorActiveTrackListener(arg1 ActiveTrackListener) {
+}
+
+type _swig_DirectorInterfaceActiveTrackListenerOnActiveTrackchanged interface{ OnActiveTrackchanged(uint) }
+
+func (swig_p *_swig_DirectorActiveTrackListener) OnActiveTrackchanged(id uint) {
+}
+func DirectorActiveTrackListenerOnActiveTrackchanged(p ActiveTrackListener, arg2 uint) {
+}
+
+type SwigcptrActiveTrackListener uintptr
+
+func (p SwigcptrActiveTrackListener) Swigcptr() uintptr {
+	return (uintptr)(p)
+}
+func (p SwigcptrActiveTrackListener) SwigIsActiveTrackListener() {
+}
+func (p SwigcptrActiveTrackListener) DirectorInterface() interface{} {
+	return nil
+}
+func NewActiveTrackListener() (_swig_ret ActiveTrackListener) {
+	return SwigcptrActiveTrackListener(stubHandle())
+}
+func DeleteActiveTrackListener(arg1 ActiveTrackListener) {
+}
+func (arg1 SwigcptrActiveTrackListener) OnActiveTrackchanged(arg2 uint) {
+}
+
+type ActiveTrackListener interface {
+	Swigcptr() uintptr
+	SwigIsActiveTrackListener()
+	DirectorInterface() interface{}
+	OnActiveTrackchanged(arg2 uint)
+}
+type SwigcptrSwigDirector_MediaFrameListenerFacade uintptr
+type SwigDirector_MediaFrameListenerFacade interface{ Swigcptr() uintptr }
+
+func (p SwigcptrSwigDirector_MediaFrameListenerFacade) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+type SwigcptrProperties uintptr
+type Properties interface{ Swigcptr() uintptr }
+
+func (p SwigcptrProperties) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+type SwigcptrSwigDirector_DTLSICETransportListener uintptr
+type SwigDirector_DTLSICETransportListener interface{ Swigcptr() uintptr }
+
+func (p SwigcptrSwigDirector_DTLSICETransportListener) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+type SwigcptrSwigDirector_ActiveTrackListener uintptr
+type SwigDirector_ActiveTrackListener interface{ Swigcptr() uintptr }
+
+func (p SwigcptrSwigDirector_ActiveTrackListener) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+type SwigcptrUDPDumper uintptr
+type UDPDumper interface{ Swigcptr() uintptr }
+
+func (p SwigcptrUDPDumper) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+type SwigcptrMediaFrame uintptr
+type MediaFrame interface{ Swigcptr() uintptr }
+
+func (p SwigcptrMediaFrame) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+type SwigcptrLong_SS_double uintptr
+type Long_SS_double interface{ Swigcptr() uintptr }
+
+func (p SwigcptrLong_SS_double) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+type SwigcptrRTPPacket_shared uintptr
+type RTPPacket_shared interface{ Swigcptr() uintptr }
+
+func (p SwigcptrRTPPacket_shared) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+type SwigcptrICERemoteCandidate uintptr
+type ICERemoteCandidate interface{ Swigcptr() uintptr }
+
+func (p SwigcptrICERemoteCandidate) Swigcptr() uintptr {
+	return uintptr(p)
+}
+
+var swigDirectorTrack struct {
+	sync.Mutex
+	m map[int]interface{}
+	c int
+}
+
+func swigDirectorAdd(v interface{}) int {
+	swigDirectorTrack.Lock()
+	defer swigDirectorTrack.Unlock()
+	if swigDirectorTrack.m == nil {
+		swigDirectorTrack.m = make(map[int]interface{})
+	}
+	swigDirectorTrack.c++
+	ret := swigDirectorTrack.c
+	swigDirectorTrack.m[ret] = v
+	return ret
+}
+func swigDirectorLookup(c int) interface{} {
+	swigDirectorTrack.Lock()
+	defer swigDirectorTrack.Unlock()
+	ret := swigDirectorTrack.m[c]
+	if ret == nil {
+		panic("C++ director pointer not found (possible	use-after-free)")
+	}
+	return ret
+}
+func swigDirectorDelete(c int) {
+	swigDirectorTrack.Lock()
+	defer swigDirectorTrack.Unlock()
+	if swigDirectorTrack.m[c] == nil {
+		if c > swigDirectorTrack.c {
+			panic("C++ director pointer invalid (possible memory corruption")
+		} else {
+			panic("C++ director pointer not found (possible use-after-free)")
+		}
+	}
+	delete(swigDirectorTrack.m, c)
+}
@@ -0,0 +1,8 @@
+//go:build !cgo && !stub
+// +build !cgo,!stub
+
+package native
+
+// the native wrapper needs cgo and the native library, ie windows is not supported,
+// build with the stub tag to compile without them
+var _ = cgoIsRequiredUseTheStubBuildTagWithoutIt
@@ -0,0 +1,4 @@
+package native
+
+// Version of the prebuilt native library pinned in native.lock, "dev" when built from the submodules
+const Version = "dev"